@@ -8,6 +8,10 @@ import (
 	"google.golang.org/grpc"
 )
 
+const (
+	importChunkSize = 64 * 1024
+)
+
 type YlccClient struct {
 	addrPort string
 	options  []grpc.DialOption
@@ -54,6 +58,31 @@ func (y *YlccClient) StartCollectionActiveLiveChat(ctx context.Context, videoId
 	return response, err
 }
 
+func (y *YlccClient) StartCollectionActiveLiveChatWithBackend(ctx context.Context, videoId string, backend pb.ActiveLiveChatBackend) (*pb.StartCollectionActiveLiveChatResponse, error) {
+	request := &pb.StartCollectionActiveLiveChatRequest{
+		VideoId: videoId,
+		Backend: backend,
+	}
+	response, err := y.client.StartCollectionActiveLiveChat(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not start collection of active live chat: %w", err)
+	}
+	return response, err
+}
+
+func (y *YlccClient) StartCollectionActiveLiveChatWaitForLive(ctx context.Context, videoId string, backend pb.ActiveLiveChatBackend) (*pb.StartCollectionActiveLiveChatResponse, error) {
+	request := &pb.StartCollectionActiveLiveChatRequest{
+		VideoId:     videoId,
+		Backend:     backend,
+		WaitForLive: true,
+	}
+	response, err := y.client.StartCollectionActiveLiveChat(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not start collection of active live chat: %w", err)
+	}
+	return response, err
+}
+
 func (y *YlccClient) PollActiveLiveChat(ctx context.Context, videoId string, cbFunc func(*pb.PollActiveLiveChatResponse) (bool)) (error) {
 	request := &pb.PollActiveLiveChatRequest{
 		VideoId: videoId,
@@ -83,6 +112,11 @@ func (y *YlccClient) GetCachedActiveLiveChat(ctx context.Context, videoId string
 		Offset:  offset,
 		Count:   count,
 	}
+	return y.GetCachedActiveLiveChatWithCursor(ctx, request)
+}
+
+// GetCachedActiveLiveChatWithCursor gets a page of cache of active live chat after cursor within time range
+func (y *YlccClient) GetCachedActiveLiveChatWithCursor(ctx context.Context, request *pb.GetCachedActiveLiveChatRequest) (*pb.GetCachedActiveLiveChatResponse, error) {
 	response, err := y.client.GetCachedActiveLiveChat(ctx, request)
 	if err != nil {
 		return nil, fmt.Errorf("can not get cache of active live chat: %w", err)
@@ -90,6 +124,42 @@ func (y *YlccClient) GetCachedActiveLiveChat(ctx context.Context, videoId string
 	return response, nil
 }
 
+// FollowActiveLiveChat receives cached active live chat after cursor and then active live chat in real time
+func (y *YlccClient) FollowActiveLiveChat(ctx context.Context, videoId string, cursor string, cbFunc func(*pb.FollowActiveLiveChatResponse) (bool)) (error) {
+	request := &pb.FollowActiveLiveChatRequest{
+		VideoId: videoId,
+		Cursor:  cursor,
+	}
+	followClient, err := y.client.FollowActiveLiveChat(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can not create stream client of active live chat follow: %w", err)
+	}
+	for {
+		response, err := followClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("can not recieve stream of active live chat follow: %w", err)
+		}
+		if cbFunc(response) {
+			break
+		}
+	}
+	return nil
+}
+
+func (y *YlccClient) StopCollectionActiveLiveChat(ctx context.Context, videoId string) (*pb.StopCollectionActiveLiveChatResponse, error) {
+	request := &pb.StopCollectionActiveLiveChatRequest{
+		VideoId: videoId,
+	}
+	response, err := y.client.StopCollectionActiveLiveChat(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not stop collection of active live chat: %w", err)
+	}
+	return response, nil
+}
+
 func (y *YlccClient) StartCollectionArchiveLiveChat(ctx context.Context, videoId string) (*pb.StartCollectionArchiveLiveChatResponse, error) {
 	request := &pb.StartCollectionArchiveLiveChatRequest{
 		VideoId: videoId,
@@ -107,6 +177,11 @@ func (y *YlccClient) GetArchiveLiveChat(ctx context.Context, videoId string, off
 		Offset:  offset,
 		Count:   count,
 	}
+	return y.GetArchiveLiveChatWithCursor(ctx, request)
+}
+
+// GetArchiveLiveChatWithCursor gets a page of archive live chat after cursor within time range
+func (y *YlccClient) GetArchiveLiveChatWithCursor(ctx context.Context, request *pb.GetArchiveLiveChatRequest) (*pb.GetArchiveLiveChatResponse, error) {
 	response, err := y.client.GetArchiveLiveChat(ctx, request)
 	if err != nil {
 		return nil, fmt.Errorf("can not get archive live chat: %w", err)
@@ -114,6 +189,79 @@ func (y *YlccClient) GetArchiveLiveChat(ctx context.Context, videoId string, off
 	return response, nil
 }
 
+func (y *YlccClient) StopCollectionArchiveLiveChat(ctx context.Context, videoId string) (*pb.StopCollectionArchiveLiveChatResponse, error) {
+	request := &pb.StopCollectionArchiveLiveChatRequest{
+		VideoId: videoId,
+	}
+	response, err := y.client.StopCollectionArchiveLiveChat(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not stop collection of archive live chat: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) PollArchiveLiveChatProgress(ctx context.Context, videoId string, cbFunc func(*pb.PollArchiveLiveChatProgressResponse) (bool)) (error) {
+	request := &pb.PollArchiveLiveChatProgressRequest{
+		VideoId: videoId,
+	}
+	pollClient, err := y.client.PollArchiveLiveChatProgress(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can not create stream client of archive live chat progress: %w", err)
+	}
+	for {
+		response, err := pollClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("can not recieve stream of archive live chat progress: %w", err)
+		}
+		if cbFunc(response) {
+			break
+		}
+	}
+	return nil
+}
+
+func (y *YlccClient) ReplayArchiveLiveChat(ctx context.Context, videoId string, startOffsetMsec int64, speed float64, cbFunc func(*pb.ReplayArchiveLiveChatResponse) (bool)) (error) {
+	request := &pb.ReplayArchiveLiveChatRequest{
+		VideoId:         videoId,
+		StartOffsetMsec: startOffsetMsec,
+		Speed:           speed,
+	}
+	replayClient, err := y.client.ReplayArchiveLiveChat(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can not create stream client of archive live chat replay: %w", err)
+	}
+	for {
+		response, err := replayClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("can not recieve stream of archive live chat replay: %w", err)
+		}
+		if cbFunc(response) {
+			break
+		}
+	}
+	return nil
+}
+
+func (y *YlccClient) ControlReplayArchiveLiveChat(ctx context.Context, replayId string, control pb.ReplayControl, offsetMsec int64, speed float64) (*pb.ControlReplayArchiveLiveChatResponse, error) {
+	request := &pb.ControlReplayArchiveLiveChatRequest{
+		ReplayId:   replayId,
+		Control:    control,
+		OffsetMsec: offsetMsec,
+		Speed:      speed,
+	}
+	response, err := y.client.ControlReplayArchiveLiveChat(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not control archive live chat replay: %w", err)
+	}
+	return response, nil
+}
+
 func (y *YlccClient) StartCollectionWordCloudMessages(ctx context.Context, videoId string) (*pb.StartCollectionWordCloudMessagesResponse, error) {
 	request := &pb.StartCollectionWordCloudMessagesRequest{
 		VideoId: videoId,
@@ -125,6 +273,18 @@ func (y *YlccClient) StartCollectionWordCloudMessages(ctx context.Context, video
 	return response, nil
 }
 
+func (y *YlccClient) StartCollectionArchiveWordCloudMessages(ctx context.Context, videoId string) (*pb.StartCollectionWordCloudMessagesResponse, error) {
+	request := &pb.StartCollectionWordCloudMessagesRequest{
+		VideoId: videoId,
+		Source: pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE,
+	}
+	response, err := y.client.StartCollectionWordCloudMessages(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not start collection of archive word cloud message: %w", err)
+	}
+	return response, nil
+}
+
 func (y *YlccClient) BuildRGBAColor(r uint32, g uint32, b uint32, a uint32) (*pb.Color) {
 	return &pb.Color{ R: r, G: g, B: b, A: a }
 }
@@ -162,6 +322,36 @@ func (y *YlccClient) GetWordCloud(
 	return response, nil
 }
 
+func (y *YlccClient) GetArchiveWordCloud(
+	ctx context.Context,
+	videoId string,
+	target pb.Target,
+	messageLimit int32,
+	fontMaxSize int32,
+	fontMinSize int32,
+	width int32,
+	height int32,
+	colors []*pb.Color,
+	backgroundColor *pb.Color) (*pb.GetWordCloudResponse, error) {
+	request := &pb.GetWordCloudRequest{
+		VideoId: videoId,
+		Target: target,
+		MessageLimit: messageLimit,
+		FontMaxSize: fontMaxSize,
+		FontMinSize: fontMinSize,
+		Width: width,
+		Height: height,
+		Colors: colors,
+		BackgroundColor: backgroundColor,
+		Source: pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE,
+	}
+	response, err := y.client.GetWordCloud(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not get archive word cloud: %w", err)
+	}
+	return response, nil
+}
+
 func (y *YlccClient) BuildVoteChoice(label string, choice string) (*pb.VoteChoice) {
 	return &pb.VoteChoice {
 		Label: label,
@@ -183,6 +373,22 @@ func (y *YlccClient) OpenVote(ctx context.Context, videoId string, target pb.Tar
 	return response, nil
 }
 
+func (y *YlccClient) OpenArchiveVote(ctx context.Context, videoId string, target pb.Target, startOffsetMsec int64, duration int32, choices []*pb.VoteChoice) (*pb.OpenVoteResponse, error) {
+	request := &pb.OpenVoteRequest{
+		VideoId: videoId,
+		Target: target,
+		Duration: duration,
+		Choices: choices,
+		Source: pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE,
+		StartOffsetMsec: startOffsetMsec,
+	}
+	response, err := y.client.OpenVote(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not open archive vote: %w", err)
+	}
+	return response, nil
+}
+
 func (y *YlccClient) GetVoteResult(ctx context.Context, voteId string) (*pb.GetVoteResultResponse, error) {
 	request := &pb.GetVoteResultRequest {
 		VoteId: voteId,
@@ -201,7 +407,7 @@ func (y *YlccClient) UpdateVoteDuration(ctx context.Context, voteId string, dura
 	}
 	response, err := y.client.UpdateVoteDuration(ctx, request)
 	if err != nil {
-		return nil,fmt.Errorf("can not update duration", err)
+		return nil,fmt.Errorf("can not update duration: %w", err)
 	}
 	return response, nil
 }
@@ -212,7 +418,7 @@ func (y *YlccClient) CloseVote(ctx context.Context, voteId string) (*pb.CloseVot
 	}
 	response, err := y.client.CloseVote(ctx, request)
 	if err != nil {
-		return nil, fmt.Errorf("can not close vote", err)
+		return nil, fmt.Errorf("can not close vote: %w", err)
 	}
 	return response, nil
 }
@@ -260,6 +466,169 @@ func (y *YlccClient) PollGroupingActiveLiveChat(ctx context.Context, groupingId
 	return nil
 }
 
+func (y *YlccClient) SearchLiveChat(ctx context.Context, source pb.ChatMessageSource, query string, offset int64, count int64) (*pb.SearchLiveChatResponse, error) {
+	request := &pb.SearchLiveChatRequest{
+		Query:  query,
+		Source: source,
+		Offset: offset,
+		Count:  count,
+	}
+	return y.SearchLiveChatWithFilter(ctx, request)
+}
+
+// SearchLiveChatWithFilter searches live chat with filters of channelId, videoId, author, time range, paid and author role
+func (y *YlccClient) SearchLiveChatWithFilter(ctx context.Context, request *pb.SearchLiveChatRequest) (*pb.SearchLiveChatResponse, error) {
+	response, err := y.client.SearchLiveChat(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not search live chat: %w", err)
+	}
+	return response, nil
+}
+
+// ExportLiveChat receives chunks of exported live chat in order, concatenation of chunks is a file of the format
+func (y *YlccClient) ExportLiveChat(ctx context.Context, request *pb.ExportLiveChatRequest, cbFunc func(*pb.ExportLiveChatResponse) (bool)) (error) {
+	exportClient, err := y.client.ExportLiveChat(ctx, request)
+	if err != nil {
+		return fmt.Errorf("can not create stream client of live chat export: %w", err)
+	}
+	for {
+		response, err := exportClient.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("can not recieve stream of live chat export: %w", err)
+		}
+		if cbFunc(response) {
+			break
+		}
+	}
+	return nil
+}
+
+// ImportArchiveLiveChat sends file read from reader in chunks and returns result of import
+func (y *YlccClient) ImportArchiveLiveChat(ctx context.Context, videoId string, channelId string, format pb.ImportFormat, reader io.Reader) (*pb.ImportArchiveLiveChatResponse, error) {
+	importClient, err := y.client.ImportArchiveLiveChat(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("can not create stream client of archive live chat import: %w", err)
+	}
+	request := &pb.ImportArchiveLiveChatRequest{
+		VideoId:   videoId,
+		ChannelId: channelId,
+		Format:    format,
+	}
+	buffer := make([]byte, importChunkSize)
+	for {
+		n, err := reader.Read(buffer)
+		if n > 0 {
+			request.Chunk = buffer[:n]
+			if err := importClient.Send(request); err != nil {
+				return nil, fmt.Errorf("can not send stream of archive live chat import: %w", err)
+			}
+			request = &pb.ImportArchiveLiveChatRequest{}
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("can not read file to import: %w", err)
+		}
+	}
+	if request.VideoId != "" {
+		// empty file
+		if err := importClient.Send(request); err != nil {
+			return nil, fmt.Errorf("can not send stream of archive live chat import: %w", err)
+		}
+	}
+	response, err := importClient.CloseAndRecv()
+	if err != nil {
+		return nil, fmt.Errorf("can not recieve response of archive live chat import: %w", err)
+	}
+	return response, nil
+}
+
+// GetChatStatistics returns statistics of live chat of a video or channel in time range of request
+func (y *YlccClient) GetChatStatistics(ctx context.Context, request *pb.GetChatStatisticsRequest) (*pb.GetChatStatisticsResponse, error) {
+	response, err := y.client.GetChatStatistics(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not get chat statistics: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) ListCollections(ctx context.Context) (*pb.ListCollectionsResponse, error) {
+	request := &pb.ListCollectionsRequest{}
+	response, err := y.client.ListCollections(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not list collections: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) GetApiKeyUsage(ctx context.Context) (*pb.GetApiKeyUsageResponse, error) {
+	request := &pb.GetApiKeyUsageRequest{}
+	response, err := y.client.GetApiKeyUsage(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not get api key usage: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) WatchChannel(ctx context.Context, channelId string, backend pb.ActiveLiveChatBackend) (*pb.WatchChannelResponse, error) {
+	request := &pb.WatchChannelRequest{
+		ChannelId: channelId,
+		Backend:   backend,
+	}
+	response, err := y.client.WatchChannel(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not watch channel: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) UnwatchChannel(ctx context.Context, channelId string) (*pb.WatchChannelResponse, error) {
+	request := &pb.WatchChannelRequest{
+		ChannelId: channelId,
+		Unwatch:   true,
+	}
+	response, err := y.client.WatchChannel(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not unwatch channel: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) PinVideo(ctx context.Context, videoId string) (*pb.PinVideoResponse, error) {
+	request := &pb.PinVideoRequest{
+		VideoId: videoId,
+	}
+	response, err := y.client.PinVideo(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not pin video: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) UnpinVideo(ctx context.Context, videoId string) (*pb.PinVideoResponse, error) {
+	request := &pb.PinVideoRequest{
+		VideoId: videoId,
+		Unpin:   true,
+	}
+	response, err := y.client.PinVideo(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not unpin video: %w", err)
+	}
+	return response, nil
+}
+
+func (y *YlccClient) GetCleanerReports(ctx context.Context) (*pb.GetCleanerReportsResponse, error) {
+	request := &pb.GetCleanerReportsRequest{}
+	response, err := y.client.GetCleanerReports(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("can not get cleaner reports: %w", err)
+	}
+	return response, nil
+}
 
 func NewYlccClient(addrPort string, options ...grpc.DialOption) (*YlccClient) {
 	return &YlccClient{
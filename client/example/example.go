@@ -52,6 +52,9 @@ func startCollectionActiveLiveChat(client *client.YlccClient, videoId string) {
 func pollActiveLiveChat(client *client.YlccClient, videoId string) {
 	ctx := context.Background()
 	err := client.PollActiveLiveChat(ctx, videoId, func(response *pb.PollActiveLiveChatResponse)(bool) {
+		if response.CollectionStatus != nil {
+			fmt.Printf("%+v\n", response.CollectionStatus)
+		}
 		if response.Status.Code != pb.Code_SUCCESS {
 			fmt.Printf("%v", response.Status.Message)
 			return true
@@ -67,42 +70,43 @@ func pollActiveLiveChat(client *client.YlccClient, videoId string) {
 	}
 }
 
-func getCachedActiveLiveChat(client *client.YlccClient, videoId string, offset int64, count int64) (bool, error) {
+func getCachedActiveLiveChat(client *client.YlccClient, videoId string, cursor string, count int64) (string, error) {
 	ctx, cancel := context.WithTimeout(
 		context.Background(),
 		60 * time.Second,
 	)
 	defer cancel()
-	response, err := client.GetCachedActiveLiveChat(ctx, videoId, offset, count)
+	response, err := client.GetCachedActiveLiveChatWithCursor(ctx, &pb.GetCachedActiveLiveChatRequest{
+		VideoId: videoId,
+		Count:   count,
+		Cursor:  cursor,
+	})
 	if err != nil {
 		fmt.Printf("%v", err)
-		return false, err
+		return "", err
 	}
 	if response.Status.Code != pb.Code_SUCCESS {
 		fmt.Printf("%v", response.Status.Message)
-		return false, fmt.Errorf("%v", response.Status.Message)
-	}
-	if len(response.ActiveLiveChatMessages) == 0 {
-		return false, nil
+		return "", fmt.Errorf("%v", response.Status.Message)
 	}
 	for _, activeLiveChatMessage := range response.ActiveLiveChatMessages {
 		fmt.Printf("%+v\n", activeLiveChatMessage)
 	}
-	return true, nil
+	return response.NextCursor, nil
 }
 
 func getCachedActiveLiveChatLoop(client *client.YlccClient, videoId string) {
-	var offset int64 = 0
+	var cursor string = ""
 	var count int64 = 2000
 	for {
-		ok, err := getCachedActiveLiveChat(client, videoId, offset, count)
+		nextCursor, err := getCachedActiveLiveChat(client, videoId, cursor, count)
 		if err != nil {
 			fmt.Printf("%v", err)
 		}
-		if !ok {
+		if nextCursor == "" {
 			break
 		}
-		offset += count
+		cursor = nextCursor
 	}
 }
 
@@ -125,38 +129,69 @@ func startCollectionArchiveLiveChat(client *client.YlccClient, videoId string) {
 	return
 }
 
-func getArchiveLiveChat(client *client.YlccClient, videoId string, offset int64, count int64) (bool, bool, error) {
+func pollArchiveLiveChatProgress(client *client.YlccClient, videoId string) {
+	err := client.PollArchiveLiveChatProgress(context.Background(), videoId, func(response *pb.PollArchiveLiveChatProgressResponse) (bool) {
+		if response.Status.Code != pb.Code_SUCCESS {
+			fmt.Printf("%v\n", response.Status.Message)
+			return true
+		}
+		fmt.Printf("%+v\n", response.Progress)
+		return false
+	})
+	if err != nil {
+		fmt.Printf("%v\n", err)
+	}
+}
+
+func replayArchiveLiveChat(client *client.YlccClient, videoId string) {
+	err := client.ReplayArchiveLiveChat(context.Background(), videoId, 0, 1, func(response *pb.ReplayArchiveLiveChatResponse) (bool) {
+		if response.Status.Code != pb.Code_SUCCESS {
+			fmt.Printf("%v\n", response.Status.Message)
+			return true
+		}
+		for _, archiveLiveChatMessage := range response.ArchiveLiveChatMessages {
+			fmt.Printf("%v: %v: %v\n", archiveLiveChatMessage.VideoOffsetTimeMsec, archiveLiveChatMessage.AuthorName, archiveLiveChatMessage.MessageText)
+		}
+		return response.State == pb.ReplayState_ENDED
+	})
+	if err != nil {
+		fmt.Printf("%v\n", err)
+	}
+}
+
+func getArchiveLiveChat(client *client.YlccClient, videoId string, cursor string, count int64) (string, bool, error) {
 	ctx, cancel := context.WithTimeout(
 		context.Background(),
 		60 * time.Second,
 	)
 	defer cancel()
-	response, err := client.GetArchiveLiveChat(ctx, videoId, offset, count)
+	response, err := client.GetArchiveLiveChatWithCursor(ctx, &pb.GetArchiveLiveChatRequest{
+		VideoId: videoId,
+		Count:   count,
+		Cursor:  cursor,
+	})
 	if err != nil {
 		fmt.Printf("%v", err)
-		return false, false, err
+		return "", false, err
 	}
 	if response.Status.Code == pb.Code_IN_PROGRESS {
-		return false, true, nil
+		return "", true, nil
 	}
 	if response.Status.Code != pb.Code_SUCCESS {
 		fmt.Printf("%v", response.Status.Message)
-		return false, false, fmt.Errorf("%v", response.Status.Message)
-	}
-	if len(response.ArchiveLiveChatMessages) == 0 {
-		return false, false, nil
+		return "", false, fmt.Errorf("%v", response.Status.Message)
 	}
 	for _, archiveLiveChatMessage := range response.ArchiveLiveChatMessages {
 		fmt.Printf("%+v\n", archiveLiveChatMessage)
 	}
-	return true, false, nil
+	return response.NextCursor, false, nil
 }
 
 func getArchiveLiveChatLoop(client *client.YlccClient, videoId string) {
-	var offset int64 = 0
+	var cursor string = ""
 	var count int64 = 2000
 	for {
-		ok, retry, err := getArchiveLiveChat(client, videoId, offset, count)
+		nextCursor, retry, err := getArchiveLiveChat(client, videoId, cursor, count)
 		if err != nil {
 			fmt.Printf("%v", err)
 			return
@@ -165,10 +200,10 @@ func getArchiveLiveChatLoop(client *client.YlccClient, videoId string) {
 			time.Sleep(5 * time.Second)
 			continue
 		}
-		if !ok {
+		if nextCursor == "" {
 			break
 		}
-		offset += count
+		cursor = nextCursor
 	}
 }
 
@@ -244,6 +279,56 @@ func getWordCloudLoop(client *client.YlccClient, videoId string) {
 	}
 }
 
+func startCollectionArchiveWordCloudMessages(client *client.YlccClient, videoId string) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		60 * time.Second,
+	)
+	defer cancel()
+	response, err := client.StartCollectionArchiveWordCloudMessages(ctx, videoId)
+	if err != nil {
+		fmt.Printf("%v", err)
+		return
+	}
+	if response.Status.Code != pb.Code_SUCCESS {
+		fmt.Printf("%v", response.Status.Message)
+		return
+	}
+	fmt.Printf("%+v\n", response.Video)
+	return
+}
+
+func getArchiveWordCloud(client *client.YlccClient, videoId string) (error) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		60 * time.Second,
+	)
+	defer cancel()
+	colors := make([]*pb.Color, 0, 3)
+	colors = append(colors, client.BuildRGBColor(234, 112, 124))
+	colors = append(colors, client.BuildRGBColor(133, 233, 124))
+	colors = append(colors, client.BuildRGBColor(122, 125, 240))
+	bgColor := client.BuildRGBColor(255, 255, 255)
+	response, err := client.GetArchiveWordCloud(ctx, videoId, pb.Target_ALL_USER, 1000, 64, 16, 1024, 512, colors, bgColor)
+	if err != nil {
+		return err
+	}
+	if response.Status.Code != pb.Code_SUCCESS {
+		return fmt.Errorf("%v", response.Status.Message)
+	}
+	file, err := os.Create("./output.png")
+	if err != nil {
+		return fmt.Errorf("can not create file: %v", err)
+	}
+	defer file.Close()
+	_, err = file.Write(response.Data)
+	if err != nil {
+		return fmt.Errorf("can not write data to file: %v", err)
+	}
+	fmt.Printf("minetype = %v, length = %v\n", response.MimeType, len(response.Data))
+	return nil
+}
+
 func openVote(client *client.YlccClient, videoId string) (string, error) {
 	ctx, cancel := context.WithTimeout(
 		context.Background(),
@@ -412,12 +497,31 @@ func grouping(client *client.YlccClient, videoId string) {
 	pollGroupingActiveLiveChat(client, groupingId)
 }
 
+func watchChannel(client *client.YlccClient, channelId string) {
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		60 * time.Second,
+	)
+	defer cancel()
+	response, err := client.WatchChannel(ctx, channelId, pb.ActiveLiveChatBackend_DEFAULT_BACKEND)
+	if err != nil {
+		fmt.Printf("%v", err)
+		return
+	}
+	if response.Status.Code != pb.Code_SUCCESS {
+		fmt.Printf("%v", response.Status.Message)
+		return
+	}
+	fmt.Printf("%+v\n", response.WatchedChannel)
+	return
+}
+
 func main() {
 	var mode string
 	var videoId string
 	var addrPort string
-	flag.StringVar(&mode, "mode", "active", "<active | activeCache | archive | wordCloud | vote | grouping>")
-	flag.StringVar(&videoId, "id", "", "<video id>")
+	flag.StringVar(&mode, "mode", "active", "<active | activeCache | archive | replay | wordCloud | archiveWordCloud | vote | grouping | watch>")
+	flag.StringVar(&videoId, "id", "", "<video id | channel id (watch)>")
 	flag.StringVar(&addrPort, "to", "127.0.0.1:12345", "<video id>")
 	flag.Parse()
 	if videoId == "" {
@@ -437,6 +541,8 @@ func main() {
 	}
 	defer client.Close()
 	switch mode {
+	case "watch":
+		watchChannel(client, videoId)
 	case "active":
 		getVideo(client, videoId)
 		startCollectionActiveLiveChat(client, videoId)
@@ -447,11 +553,22 @@ func main() {
 	case "archive":
 		getVideo(client, videoId)
 		startCollectionArchiveLiveChat(client, videoId)
+		pollArchiveLiveChatProgress(client, videoId)
 		getArchiveLiveChatLoop(client, videoId)
+	case "replay":
+		getVideo(client, videoId)
+		replayArchiveLiveChat(client, videoId)
 	case "wordCloud":
 		getVideo(client, videoId)
 		startCollectionWordCloudMessages(client, videoId)
 		getWordCloudLoop(client, videoId)
+	case "archiveWordCloud":
+		getVideo(client, videoId)
+		startCollectionArchiveWordCloudMessages(client, videoId)
+		pollArchiveLiveChatProgress(client, videoId)
+		if err := getArchiveWordCloud(client, videoId); err != nil {
+			fmt.Printf("%v", err)
+		}
 	case "vote":
 		getVideo(client, videoId)
 		voteLoop(client, videoId)
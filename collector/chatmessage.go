@@ -0,0 +1,156 @@
+package collector
+
+import (
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"strconv"
+	"time"
+)
+
+const (
+	archiveChatMessageBulkMax int64 = 2000
+)
+
+// ChatMessage is normalized message of active live chat and archive live chat
+type ChatMessage struct {
+	Source                 pb.ChatMessageSource
+	MessageId              string
+	VideoId                string
+	AuthorChannelId        string
+	AuthorDisplayName      string
+	AuthorIsChatModerator  bool
+	AuthorIsChatOwner      bool
+	AuthorIsChatSponsor    bool
+	Message                string
+	IsPaid                 bool
+	AmountDisplayString    string
+	PublishedAt            time.Time
+	VideoOffsetTimeMsec    int64
+	IsChat                 bool
+	ActiveLiveChatMessage  *pb.ActiveLiveChatMessage
+	ArchiveLiveChatMessage *pb.ArchiveLiveChatMessage
+}
+
+func NewChatMessageFromActiveLiveChatMessage(activeLiveChatMessage *pb.ActiveLiveChatMessage) *ChatMessage {
+	publishedAt, err := time.Parse(time.RFC3339Nano, activeLiveChatMessage.PublishedAt)
+	if err != nil {
+		publishedAt = time.Time{}
+	}
+	isChat := false
+	if activeLiveChatMessage.DisplayMessage != "" && !activeLiveChatMessage.IsDeleted {
+		switch activeLiveChatMessage.EventType {
+		case pb.ActiveLiveChatEventType_TEXT_MESSAGE_EVENT,
+			pb.ActiveLiveChatEventType_SUPER_CHAT_EVENT,
+			pb.ActiveLiveChatEventType_SUPER_STICKER_EVENT,
+			pb.ActiveLiveChatEventType_FAN_FUNDING_EVENT,
+			pb.ActiveLiveChatEventType_MEMBER_MILESTONE_CHAT_EVENT:
+			isChat = true
+		}
+	}
+	return &ChatMessage{
+		Source:                pb.ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE,
+		MessageId:             activeLiveChatMessage.MessageId,
+		VideoId:               activeLiveChatMessage.VideoId,
+		AuthorChannelId:       activeLiveChatMessage.AuthorChannelId,
+		AuthorDisplayName:     activeLiveChatMessage.AuthorDisplayName,
+		AuthorIsChatModerator: activeLiveChatMessage.AuthorIsChatModerator,
+		AuthorIsChatOwner:     activeLiveChatMessage.AuthorIsChatOwner,
+		AuthorIsChatSponsor:   activeLiveChatMessage.AuthorIsChatSponsor,
+		Message:               activeLiveChatMessage.DisplayMessage,
+		IsPaid: activeLiveChatMessage.IsSuperChat ||
+			activeLiveChatMessage.IsSuperSticker ||
+			activeLiveChatMessage.IsFanFundingEvent,
+		AmountDisplayString:   activeLiveChatMessage.AmountDisplayString,
+		PublishedAt:           publishedAt,
+		VideoOffsetTimeMsec:   -1,
+		IsChat:                isChat,
+		ActiveLiveChatMessage: activeLiveChatMessage,
+	}
+}
+
+func NewChatMessageFromArchiveLiveChatMessage(archiveLiveChatMessage *pb.ArchiveLiveChatMessage) *ChatMessage {
+	publishedAt := time.Time{}
+	timestampUsec, err := strconv.ParseInt(archiveLiveChatMessage.TimestampUsec, 10, 64)
+	if err == nil {
+		publishedAt = time.Unix(0, timestampUsec*int64(time.Microsecond))
+	}
+	videoOffsetTimeMsec, err := strconv.ParseInt(archiveLiveChatMessage.VideoOffsetTimeMsec, 10, 64)
+	if err != nil {
+		videoOffsetTimeMsec = -1
+	}
+	isChat := false
+	if archiveLiveChatMessage.MessageText != "" {
+		switch archiveLiveChatMessage.MessageType {
+		case pb.ArchiveLiveChatMessageType_TEXT_MESSAGE,
+			pb.ArchiveLiveChatMessageType_PAID_MESSAGE,
+			pb.ArchiveLiveChatMessageType_MEMBERSHIP_ITEM:
+			isChat = true
+		}
+	}
+	return &ChatMessage{
+		Source:                 pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE,
+		MessageId:              archiveLiveChatMessage.MessageId,
+		VideoId:                archiveLiveChatMessage.VideoId,
+		AuthorChannelId:        archiveLiveChatMessage.AuthorExternalChannelId,
+		AuthorDisplayName:      archiveLiveChatMessage.AuthorName,
+		AuthorIsChatModerator:  archiveLiveChatMessage.AuthorIsChatModerator,
+		AuthorIsChatOwner:      archiveLiveChatMessage.AuthorIsChatOwner,
+		AuthorIsChatSponsor:    archiveLiveChatMessage.AuthorIsChatSponsor,
+		Message:                archiveLiveChatMessage.MessageText,
+		IsPaid:                 archiveLiveChatMessage.IsPaid,
+		AmountDisplayString:    archiveLiveChatMessage.PurchaseAmountText,
+		PublishedAt:            publishedAt,
+		VideoOffsetTimeMsec:    videoOffsetTimeMsec,
+		IsChat:                 isChat,
+		ArchiveLiveChatMessage: archiveLiveChatMessage,
+	}
+}
+
+func (c *Collector) CheckArchiveLiveChat(videoId string) *pb.Status {
+	status := new(pb.Status)
+	if c.checkRequestedVideoForArchiveLiveChat(videoId) {
+		status.Code = pb.Code_IN_PROGRESS
+		status.Message = fmt.Sprintf("collecting archive live chat is in progress (videoId = %v)", videoId)
+		return status
+	}
+	count, err := c.dbOperator.CountArchiveLiveChatMessagesByVideoId(videoId)
+	if err != nil {
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, videoId)
+		return status
+	}
+	if count == 0 {
+		status.Code = pb.Code_NOT_FOUND
+		status.Message = fmt.Sprintf("not found archive live chat (videoId = %v)", videoId)
+		return status
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (videoId = %v)", videoId)
+	return status
+}
+
+// ReadArchiveChatMessages passes stored archive live chat messages to cbFunc in video offset order.
+// reading is stopped when cbFunc returns true.
+func (c *Collector) ReadArchiveChatMessages(videoId string, startOffsetMsec int64, cbFunc func(chatMessages []*ChatMessage) bool) error {
+	offsetMsec := startOffsetMsec
+	messageId := ""
+	for {
+		archiveLiveChatMessages, err := c.dbOperator.GetArchiveLiveChatMessagesByVideoIdAndVideoOffset(videoId, offsetMsec, messageId, archiveChatMessageBulkMax)
+		if err != nil {
+			return fmt.Errorf("can not get archive live chat messages: %w", err)
+		}
+		if len(archiveLiveChatMessages) == 0 {
+			return nil
+		}
+		chatMessages := make([]*ChatMessage, 0, len(archiveLiveChatMessages))
+		for _, archiveLiveChatMessage := range archiveLiveChatMessages {
+			chatMessages = append(chatMessages, NewChatMessageFromArchiveLiveChatMessage(archiveLiveChatMessage))
+		}
+		if cbFunc(chatMessages) {
+			return nil
+		}
+		last := archiveLiveChatMessages[len(archiveLiveChatMessages)-1]
+		offsetMsec = c.parseVideoOffsetTimeMsec(last.VideoOffsetTimeMsec)
+		messageId = last.MessageId
+	}
+}
@@ -1,43 +1,77 @@
 package collector
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	pb "github.com/potix/ylcc/protocol"
 	"github.com/potix/ylcc/youtubehelper"
 	"google.golang.org/api/youtube/v3"
 	"io"
 	"log"
+	"math/rand"
+	"net/http"
 	"sync"
 	"time"
 	"strconv"
 )
 
 const (
-	bulkMessageMax int64 = 2000
+	bulkMessageMax   int64         = 2000
+	retryMax         int           = 10
+	retryBackoffBase time.Duration = 1 * time.Second
+	retryBackoffMax  time.Duration = 5 * time.Minute
+	// waiting for live checks video at this interval around scheduled start time
+	waitForLiveCheckInterval time.Duration = 30 * time.Second
+	waitForLiveLeadTime      time.Duration = 2 * time.Minute
+	// scheduled start time may be changed while waiting
+	waitForLiveMaxSleep time.Duration = 30 * time.Minute
 )
 
 type Collector struct {
 	verbose                               bool
-	dbOperator                            *DatabaseOperator
+	dbOperator                            Storage
 	requestedVideoForActiveLiveChatMutex  *sync.Mutex
-	requestedVideoForActiveLiveChat       map[string]bool
+	requestedVideoForActiveLiveChat       map[string]*collectionContext
 	requestedVideoForArchiveLiveChatMutex *sync.Mutex
-	requestedVideoForArchiveLiveChat      map[string]bool
+	requestedVideoForArchiveLiveChat      map[string]*collectionContext
 	publishActiveLiveChatCh               chan *publishActiveLiveChatMessagesParams
 	subscribeActiveLiveChatCh             chan *subscribeActiveLiveChatParams
 	unsubscribeActiveLiveChatCh           chan *subscribeActiveLiveChatParams
 	publisherFinishRequestCh              chan int
 	publisherFinishResponseCh             chan int
-	activeLiveChatCollector               *youtubehelper.ActiveLiveChatCollector
-	archiveLiveChatCollector              *youtubehelper.ArchiveLiveChatCollector
+	chatSource                            youtubehelper.ChatSource
+	innertubeSource                       youtubehelper.ActiveLiveChatSource
+	activeLiveChatBackend                 pb.ActiveLiveChatBackend
 	cleanerFinishRequestCh                chan int
 	cleanerFinishResponseCh               chan int
+	autoStopGracePeriod                   time.Duration
+	archiveLiveChatProgressMutex          *sync.Mutex
+	archiveLiveChatProgressSubscribers    map[string]map[chan *pb.PollArchiveLiveChatProgressResponse]bool
+	archiveLiveChatProgressResults        map[string]*archiveLiveChatProgressResult
+	requestedReplayMutex                  *sync.Mutex
+	requestedReplay                       map[string]*replayContext
+	watchedChannelsMutex                  *sync.Mutex
+	watchedChannels                       map[string]*watchedChannel
+	watcherFinishRequestCh                chan int
+	watcherFinishResponseCh               chan int
+	watchChannelIds                       []string
+	watchInterval                         time.Duration
+	discoveryInterval                     time.Duration
+	leadTime                              time.Duration
+	archiveDelay                          time.Duration
+	retentionPolicy                       *RetentionPolicy
+	cleanInterval                         time.Duration
+	cleanerReportsMutex                   *sync.Mutex
+	cleanerReports                        []*pb.CleanerReport
+	instanceId                            string
 }
 
 type publishActiveLiveChatMessagesParams struct {
 	err                    error
 	videoId                string
 	activeLiveChatMessages []*pb.ActiveLiveChatMessage
+	collectionStatus       *pb.ActiveLiveChatCollectionStatus
 }
 
 type subscribeActiveLiveChatParams struct {
@@ -49,18 +83,173 @@ func (s *subscribeActiveLiveChatParams) GetSubscriberCh() chan *pb.PollActiveLiv
 	return s.subscriberCh
 }
 
-func (c *Collector) registerRequestedVideoForActiveLiveChat(videoId string) bool {
+type subscribeArchiveLiveChatProgressParams struct {
+	videoId      string
+	subscriberCh chan *pb.PollArchiveLiveChatProgressResponse
+}
+
+func (s *subscribeArchiveLiveChatProgressParams) GetSubscriberCh() chan *pb.PollArchiveLiveChatProgressResponse {
+	return s.subscriberCh
+}
+
+type archiveLiveChatProgressResult struct {
+	response   *pb.PollArchiveLiveChatProgressResponse
+	finishedAt time.Time
+}
+
+type collectionContext struct {
+	videoId             string
+	ctx                 context.Context
+	cancel              context.CancelFunc
+	autoStopGracePeriod time.Duration
+	autoStopTimer       *time.Timer
+	statsMutex          *sync.Mutex
+	// publishMutex is held while messages are written to database and published
+	publishMutex        *sync.Mutex
+	startedAt           time.Time
+	state               pb.CollectionState
+	messageCount        int64
+	lastPollAt          time.Time
+	lastError           string
+	subscriberCount     int
+	pageToken           string
+	continuation        string
+	videoOffsetTimeMsec int64
+	durationMsec        int64
+	backend             pb.ActiveLiveChatBackend
+}
+
+func newCollectionContext(videoId string, autoStopGracePeriod time.Duration) *collectionContext {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &collectionContext{
+		videoId:             videoId,
+		ctx:                 ctx,
+		cancel:              cancel,
+		autoStopGracePeriod: autoStopGracePeriod,
+		autoStopTimer:       nil,
+		statsMutex:          new(sync.Mutex),
+		publishMutex:        new(sync.Mutex),
+		startedAt:           time.Now(),
+		state:               pb.CollectionState_RUNNING,
+	}
+}
+
+func (c *collectionContext) updatePollStats(messageCount int, pageToken string, continuation string) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.state = pb.CollectionState_RUNNING
+	c.messageCount += int64(messageCount)
+	c.lastPollAt = time.Now()
+	c.pageToken = pageToken
+	c.continuation = continuation
+}
+
+func (c *collectionContext) updateErrorStats(state pb.CollectionState, err error) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.state = state
+	c.lastPollAt = time.Now()
+	c.lastError = err.Error()
+}
+
+func (c *collectionContext) updateVideoOffset(videoOffsetTimeMsec int64) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.videoOffsetTimeMsec = videoOffsetTimeMsec
+}
+
+func (c *collectionContext) updateDuration(duration time.Duration) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.durationMsec = duration.Milliseconds()
+}
+
+func (c *collectionContext) updateState(state pb.CollectionState) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.state = state
+}
+
+func (c *collectionContext) updateBackend(backend pb.ActiveLiveChatBackend) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.backend = backend
+}
+
+func (c *collectionContext) updateSubscriberCount(subscriberCount int) {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	c.subscriberCount = subscriberCount
+}
+
+func (c *collectionContext) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func (c *collectionContext) toCollection(kind pb.CollectionKind) *pb.Collection {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	return &pb.Collection{
+		Kind:            kind,
+		Id:              c.videoId,
+		VideoId:         c.videoId,
+		State:           c.state,
+		StartedAt:       c.formatTime(c.startedAt),
+		MessageCount:    c.messageCount,
+		LastPollAt:      c.formatTime(c.lastPollAt),
+		LastError:       c.lastError,
+		SubscriberCount: int32(c.subscriberCount),
+		PageToken:       c.pageToken,
+		Continuation:    c.continuation,
+		Backend:         c.backend,
+	}
+}
+
+func (c *collectionContext) toArchiveLiveChatProgress() *pb.ArchiveLiveChatProgress {
+	c.statsMutex.Lock()
+	defer c.statsMutex.Unlock()
+	return &pb.ArchiveLiveChatProgress{
+		State:               c.state,
+		VideoOffsetTimeMsec: c.videoOffsetTimeMsec,
+		DurationMsec:        c.durationMsec,
+		MessageCount:        c.messageCount,
+		LastError:           c.lastError,
+	}
+}
+
+func (c *collectionContext) disarmAutoStop() {
+	if c.autoStopTimer == nil {
+		return
+	}
+	c.autoStopTimer.Stop()
+	c.autoStopTimer = nil
+}
+
+func (c *Collector) registerRequestedVideoForActiveLiveChat(videoId string, autoStopGracePeriod time.Duration) (*collectionContext, bool) {
 	c.requestedVideoForActiveLiveChatMutex.Lock()
 	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
 	_, ok := c.requestedVideoForActiveLiveChat[videoId]
 	if ok {
-		return false
+		return nil, false
 	}
 	if c.verbose {
 		log.Printf("register requested video for active live chat (videoId = %v)", videoId)
 	}
-	c.requestedVideoForActiveLiveChat[videoId] = true
-	return true
+	collectionCtx := newCollectionContext(videoId, autoStopGracePeriod)
+	c.requestedVideoForActiveLiveChat[videoId] = collectionCtx
+	// collection is stopped when nobody subscribes it, the timer is disarmed by the first subscriber
+	c.armAutoStop(collectionCtx)
+	return collectionCtx, true
+}
+
+func (c *Collector) getRequestedVideoForActiveLiveChat(videoId string) (*collectionContext, bool) {
+	c.requestedVideoForActiveLiveChatMutex.Lock()
+	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForActiveLiveChat[videoId]
+	return collectionCtx, ok
 }
 
 func (c *Collector) checkRequestedVideoForActiveLiveChat(videoId string) bool {
@@ -76,26 +265,100 @@ func (c *Collector) checkRequestedVideoForActiveLiveChat(videoId string) bool {
 func (c *Collector) unregisterRequestedVideoForActiveLiveChat(videoId string) bool {
 	c.requestedVideoForActiveLiveChatMutex.Lock()
 	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
-	_, ok := c.requestedVideoForActiveLiveChat[videoId]
+	collectionCtx, ok := c.requestedVideoForActiveLiveChat[videoId]
 	if !ok {
 		return false
 	}
 	if c.verbose {
 		log.Printf("unregister requested video fot active live chat (videoId = %v)", videoId)
 	}
+	collectionCtx.disarmAutoStop()
+	collectionCtx.cancel()
 	delete(c.requestedVideoForActiveLiveChat, videoId)
 	return true
 }
 
-func (c *Collector) registerRequestedVideoForArchiveLiveChat(videoId string) bool {
+func (c *Collector) cancelRequestedVideoForActiveLiveChat(videoId string) bool {
+	c.requestedVideoForActiveLiveChatMutex.Lock()
+	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForActiveLiveChat[videoId]
+	if !ok {
+		return false
+	}
+	if c.verbose {
+		log.Printf("cancel requested video for active live chat (videoId = %v)", videoId)
+	}
+	collectionCtx.disarmAutoStop()
+	collectionCtx.cancel()
+	return true
+}
+
+func (c *Collector) updateSubscriberCountForActiveLiveChat(videoId string, subscriberCount int) {
+	c.requestedVideoForActiveLiveChatMutex.Lock()
+	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForActiveLiveChat[videoId]
+	if !ok {
+		return
+	}
+	collectionCtx.updateSubscriberCount(subscriberCount)
+}
+
+func (c *Collector) armAutoStopForActiveLiveChat(videoId string) {
+	c.requestedVideoForActiveLiveChatMutex.Lock()
+	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForActiveLiveChat[videoId]
+	if !ok {
+		return
+	}
+	c.armAutoStop(collectionCtx)
+}
+
+// armAutoStop is called with requestedVideoForActiveLiveChatMutex held
+func (c *Collector) armAutoStop(collectionCtx *collectionContext) {
+	videoId := collectionCtx.videoId
+	if collectionCtx.autoStopGracePeriod <= 0 {
+		return
+	}
+	collectionCtx.disarmAutoStop()
+	if c.verbose {
+		log.Printf("arm auto stop for active live chat (videoId = %v, gracePeriod = %v)", videoId, collectionCtx.autoStopGracePeriod)
+	}
+	collectionCtx.autoStopTimer = time.AfterFunc(collectionCtx.autoStopGracePeriod, func() {
+		if c.verbose {
+			log.Printf("auto stop active live chat because of no subscribers (videoId = %v)", videoId)
+		}
+		c.stopWatchedVideo(videoId)
+		c.cancelRequestedVideoForActiveLiveChat(videoId)
+	})
+}
+
+func (c *Collector) disarmAutoStopForActiveLiveChat(videoId string) {
+	c.requestedVideoForActiveLiveChatMutex.Lock()
+	defer c.requestedVideoForActiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForActiveLiveChat[videoId]
+	if !ok {
+		return
+	}
+	collectionCtx.disarmAutoStop()
+}
+
+func (c *Collector) finishActiveLiveChatCollection(videoId string) {
+	if err := c.dbOperator.DeleteActiveLiveChatCollection(videoId); err != nil {
+		log.Printf("can not delete active live chat collection in database: %v", err)
+	}
+	c.unregisterRequestedVideoForActiveLiveChat(videoId)
+}
+
+func (c *Collector) registerRequestedVideoForArchiveLiveChat(videoId string) (*collectionContext, bool) {
 	c.requestedVideoForArchiveLiveChatMutex.Lock()
 	defer c.requestedVideoForArchiveLiveChatMutex.Unlock()
 	_, ok := c.requestedVideoForArchiveLiveChat[videoId]
 	if ok {
-		return false
+		return nil, false
 	}
-	c.requestedVideoForArchiveLiveChat[videoId] = true
-	return true
+	collectionCtx := newCollectionContext(videoId, 0)
+	c.requestedVideoForArchiveLiveChat[videoId] = collectionCtx
+	return collectionCtx, true
 }
 
 func (c *Collector) checkRequestedVideoForArchiveLiveChat(videoId string) bool {
@@ -111,169 +374,631 @@ func (c *Collector) checkRequestedVideoForArchiveLiveChat(videoId string) bool {
 func (c *Collector) unregisterRequestedVideoForArchiveLiveChat(videoId string) bool {
 	c.requestedVideoForArchiveLiveChatMutex.Lock()
 	defer c.requestedVideoForArchiveLiveChatMutex.Unlock()
-	_, ok := c.requestedVideoForArchiveLiveChat[videoId]
+	collectionCtx, ok := c.requestedVideoForArchiveLiveChat[videoId]
 	if !ok {
 		return false
 	}
+	collectionCtx.cancel()
 	delete(c.requestedVideoForArchiveLiveChat, videoId)
 	return true
 }
 
-func (c *Collector) collectActiveLiveChatFromYoutube(video *youtube.Video, youtubeService *youtube.Service) {
-	params, err := c.activeLiveChatCollector.CreateParams(video)
+func (c *Collector) cancelRequestedVideoForArchiveLiveChat(videoId string) bool {
+	c.requestedVideoForArchiveLiveChatMutex.Lock()
+	defer c.requestedVideoForArchiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForArchiveLiveChat[videoId]
+	if !ok {
+		return false
+	}
+	collectionCtx.cancel()
+	return true
+}
+
+func (c *Collector) getRequestedVideoForArchiveLiveChat(videoId string) (*collectionContext, bool) {
+	c.requestedVideoForArchiveLiveChatMutex.Lock()
+	defer c.requestedVideoForArchiveLiveChatMutex.Unlock()
+	collectionCtx, ok := c.requestedVideoForArchiveLiveChat[videoId]
+	return collectionCtx, ok
+}
+
+func (c *Collector) sendArchiveLiveChatProgress(subscriberCh chan *pb.PollArchiveLiveChatProgressResponse, response *pb.PollArchiveLiveChatProgressResponse, last bool) {
+	// progress is a snapshot, so an older one may be dropped if subscriber is slow
+	select {
+	case subscriberCh <- response:
+		return
+	default:
+	}
+	if !last {
+		return
+	}
+	// make room for last response, only collector sends to subscriberCh
+	select {
+	case <-subscriberCh:
+	default:
+	}
+	subscriberCh <- response
+}
+
+func (c *Collector) publishArchiveLiveChatProgress(collectionCtx *collectionContext) {
+	response := &pb.PollArchiveLiveChatProgressResponse{
+		Status: &pb.Status{
+			Code:    pb.Code_SUCCESS,
+			Message: fmt.Sprintf("success (videoId = %v)", collectionCtx.videoId),
+		},
+		Progress: collectionCtx.toArchiveLiveChatProgress(),
+	}
+	c.archiveLiveChatProgressMutex.Lock()
+	defer c.archiveLiveChatProgressMutex.Unlock()
+	for subscriberCh := range c.archiveLiveChatProgressSubscribers[collectionCtx.videoId] {
+		c.sendArchiveLiveChatProgress(subscriberCh, response, false)
+	}
+}
+
+func (c *Collector) finishArchiveLiveChatCollection(collectionCtx *collectionContext, err error) {
+	videoId := collectionCtx.videoId
+	status := &pb.Status{
+		Code:    pb.Code_SUCCESS,
+		Message: fmt.Sprintf("finished (videoId = %v)", videoId),
+	}
+	if err == nil {
+		collectionCtx.updateState(pb.CollectionState_FINISHED)
+	} else if errors.Is(err, context.Canceled) {
+		collectionCtx.updateState(pb.CollectionState_STOPPED)
+		status.Message = fmt.Sprintf("stopped (videoId = %v)", videoId)
+	} else {
+		log.Printf("can not collect archive live chat (videoId = %v): %v", videoId, err)
+		collectionCtx.updateErrorStats(pb.CollectionState_FAILED, err)
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, videoId)
+	}
+	response := &pb.PollArchiveLiveChatProgressResponse{
+		Status:   status,
+		Progress: collectionCtx.toArchiveLiveChatProgress(),
+	}
+	c.archiveLiveChatProgressMutex.Lock()
+	defer c.archiveLiveChatProgressMutex.Unlock()
+	c.archiveLiveChatProgressResults[videoId] = &archiveLiveChatProgressResult{
+		response:   response,
+		finishedAt: time.Now(),
+	}
+	for subscriberCh := range c.archiveLiveChatProgressSubscribers[videoId] {
+		c.sendArchiveLiveChatProgress(subscriberCh, response, true)
+		close(subscriberCh)
+	}
+	delete(c.archiveLiveChatProgressSubscribers, videoId)
+	// unregister while holding progress lock so that new subscriber can find the result
+	c.unregisterRequestedVideoForArchiveLiveChat(videoId)
+}
+
+func (c *Collector) deleteArchiveLiveChatProgressResultsByFinishedAt(finishedAt time.Time) {
+	c.archiveLiveChatProgressMutex.Lock()
+	defer c.archiveLiveChatProgressMutex.Unlock()
+	for videoId, result := range c.archiveLiveChatProgressResults {
+		if result.finishedAt.Before(finishedAt) {
+			delete(c.archiveLiveChatProgressResults, videoId)
+		}
+	}
+}
+
+func (c *Collector) errorClassToPb(errorClass youtubehelper.ErrorClass) pb.ErrorClass {
+	switch errorClass {
+	case youtubehelper.ErrorClassTransient:
+		return pb.ErrorClass_TRANSIENT
+	case youtubehelper.ErrorClassRateLimited:
+		return pb.ErrorClass_RATE_LIMITED
+	case youtubehelper.ErrorClassQuota:
+		return pb.ErrorClass_QUOTA_EXCEEDED
+	case youtubehelper.ErrorClassChatEnded:
+		return pb.ErrorClass_CHAT_ENDED
+	case youtubehelper.ErrorClassForbidden:
+		return pb.ErrorClass_FORBIDDEN
+	default:
+		return pb.ErrorClass_UNKNOWN_ERROR
+	}
+}
+
+func (c *Collector) retryBackoff(retryCount int) time.Duration {
+	backoff := retryBackoffBase
+	for i := 0; i < retryCount && backoff < retryBackoffMax; i += 1 {
+		backoff *= 2
+	}
+	if backoff > retryBackoffMax {
+		backoff = retryBackoffMax
+	}
+	// equal jitter
+	half := backoff / 2
+	return half + time.Duration(rand.Int63n(int64(half)+1))
+}
+
+func (c *Collector) stopActiveLiveChatCollection(videoId string, pageToken string) {
+	if c.verbose {
+		log.Printf("stop active live chat collection (videoId = %v)", videoId)
+	}
+	c.finishActiveLiveChatCollection(videoId)
+	c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
+		err:                    context.Canceled,
+		videoId:                videoId,
+		activeLiveChatMessages: nil,
+		collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+			State:     pb.CollectionState_STOPPED,
+			PageToken: pageToken,
+		},
+	}
+}
+
+func (c *Collector) createActiveLiveChatMessage(video *youtube.Video, apiEtag string, pageToken string, item *youtube.LiveChatMessage) (*pb.ActiveLiveChatMessage, bool) {
+	if item.Snippet == nil {
+		return nil, false
+	}
+	activeLiveChatMessage := &pb.ActiveLiveChatMessage{
+		MessageId:      item.Id,
+		ChannelId:      video.Snippet.ChannelId,
+		VideoId:        video.Id,
+		ApiEtag:        apiEtag,
+		LiveChatId:     item.Snippet.LiveChatId,
+		DisplayMessage: item.Snippet.DisplayMessage,
+		PublishedAt:    item.Snippet.PublishedAt,
+		PageToken:      pageToken,
+	}
+	if item.AuthorDetails != nil {
+		activeLiveChatMessage.AuthorChannelId = item.AuthorDetails.ChannelId
+		activeLiveChatMessage.AuthorChannelUrl = item.AuthorDetails.ChannelUrl
+		activeLiveChatMessage.AuthorDisplayName = item.AuthorDetails.DisplayName
+		activeLiveChatMessage.AuthorIsChatModerator = item.AuthorDetails.IsChatModerator
+		activeLiveChatMessage.AuthorIsChatOwner = item.AuthorDetails.IsChatOwner
+		activeLiveChatMessage.AuthorIsChatSponsor = item.AuthorDetails.IsChatSponsor
+		activeLiveChatMessage.AuthorIsVerified = item.AuthorDetails.IsVerified
+	}
+	switch {
+	case item.Snippet.SuperChatDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_SUPER_CHAT_EVENT
+		activeLiveChatMessage.DisplayMessage = item.Snippet.SuperChatDetails.UserComment
+		activeLiveChatMessage.IsSuperChat = true
+		activeLiveChatMessage.AmountMicros = strconv.FormatUint(item.Snippet.SuperChatDetails.AmountMicros, 10)
+		activeLiveChatMessage.AmountDisplayString = item.Snippet.SuperChatDetails.AmountDisplayString
+		activeLiveChatMessage.Currency = item.Snippet.SuperChatDetails.Currency
+	case item.Snippet.SuperStickerDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_SUPER_STICKER_EVENT
+		if item.Snippet.SuperStickerDetails.SuperStickerMetadata != nil {
+			activeLiveChatMessage.DisplayMessage = item.Snippet.SuperStickerDetails.SuperStickerMetadata.AltText
+		}
+		activeLiveChatMessage.IsSuperSticker = true
+		activeLiveChatMessage.AmountMicros = strconv.FormatUint(item.Snippet.SuperStickerDetails.AmountMicros, 10)
+		activeLiveChatMessage.AmountDisplayString = item.Snippet.SuperStickerDetails.AmountDisplayString
+		activeLiveChatMessage.Currency = item.Snippet.SuperStickerDetails.Currency
+	case item.Snippet.FanFundingEventDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_FAN_FUNDING_EVENT
+		activeLiveChatMessage.DisplayMessage = item.Snippet.FanFundingEventDetails.UserComment
+		activeLiveChatMessage.IsFanFundingEvent = true
+		activeLiveChatMessage.AmountMicros = strconv.FormatUint(item.Snippet.FanFundingEventDetails.AmountMicros, 10)
+		activeLiveChatMessage.AmountDisplayString = item.Snippet.FanFundingEventDetails.AmountDisplayString
+		activeLiveChatMessage.Currency = item.Snippet.FanFundingEventDetails.Currency
+	case item.Snippet.TextMessageDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_TEXT_MESSAGE_EVENT
+		activeLiveChatMessage.DisplayMessage = item.Snippet.TextMessageDetails.MessageText
+	case item.Snippet.NewSponsorDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_NEW_SPONSOR_EVENT
+		activeLiveChatMessage.MemberLevelName = item.Snippet.NewSponsorDetails.MemberLevelName
+		activeLiveChatMessage.IsUpgrade = item.Snippet.NewSponsorDetails.IsUpgrade
+	case item.Snippet.MemberMilestoneChatDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_MEMBER_MILESTONE_CHAT_EVENT
+		activeLiveChatMessage.DisplayMessage = item.Snippet.MemberMilestoneChatDetails.UserComment
+		activeLiveChatMessage.MemberLevelName = item.Snippet.MemberMilestoneChatDetails.MemberLevelName
+		activeLiveChatMessage.MemberMonth = item.Snippet.MemberMilestoneChatDetails.MemberMonth
+	case item.Snippet.MembershipGiftingDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_MEMBERSHIP_GIFTING_EVENT
+		activeLiveChatMessage.MemberLevelName = item.Snippet.MembershipGiftingDetails.GiftMembershipsLevelName
+		activeLiveChatMessage.GiftMembershipsCount = item.Snippet.MembershipGiftingDetails.GiftMembershipsCount
+	case item.Snippet.GiftMembershipReceivedDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_GIFT_MEMBERSHIP_RECEIVED_EVENT
+		activeLiveChatMessage.MemberLevelName = item.Snippet.GiftMembershipReceivedDetails.MemberLevelName
+		activeLiveChatMessage.GifterChannelId = item.Snippet.GiftMembershipReceivedDetails.GifterChannelId
+		activeLiveChatMessage.AssociatedMembershipGiftingMessageId = item.Snippet.GiftMembershipReceivedDetails.AssociatedMembershipGiftingMessageId
+	case item.Snippet.MessageDeletedDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_MESSAGE_DELETED_EVENT
+		activeLiveChatMessage.TargetMessageId = item.Snippet.MessageDeletedDetails.DeletedMessageId
+	case item.Snippet.MessageRetractedDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_MESSAGE_RETRACTED_EVENT
+		activeLiveChatMessage.TargetMessageId = item.Snippet.MessageRetractedDetails.RetractedMessageId
+	case item.Snippet.UserBannedDetails != nil:
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_USER_BANNED_EVENT
+		activeLiveChatMessage.BanType = item.Snippet.UserBannedDetails.BanType
+		activeLiveChatMessage.BanDurationSeconds = int64(item.Snippet.UserBannedDetails.BanDurationSeconds)
+		if item.Snippet.UserBannedDetails.BannedUserDetails != nil {
+			activeLiveChatMessage.BannedUserChannelId = item.Snippet.UserBannedDetails.BannedUserDetails.ChannelId
+			activeLiveChatMessage.BannedUserDisplayName = item.Snippet.UserBannedDetails.BannedUserDetails.DisplayName
+		}
+	case item.Snippet.Type == "chatEndedEvent":
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_CHAT_ENDED_EVENT
+	case item.Snippet.Type == "sponsorOnlyModeStartedEvent":
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_SPONSOR_ONLY_MODE_STARTED_EVENT
+	case item.Snippet.Type == "sponsorOnlyModeEndedEvent":
+		activeLiveChatMessage.EventType = pb.ActiveLiveChatEventType_SPONSOR_ONLY_MODE_ENDED_EVENT
+	default:
+		return nil, false
+	}
+	return activeLiveChatMessage, true
+}
+
+func (c *Collector) resolveActiveLiveChatBackend(backend pb.ActiveLiveChatBackend) pb.ActiveLiveChatBackend {
+	if backend != pb.ActiveLiveChatBackend_DEFAULT_BACKEND {
+		return backend
+	}
+	if c.activeLiveChatBackend != pb.ActiveLiveChatBackend_DEFAULT_BACKEND {
+		return c.activeLiveChatBackend
+	}
+	return pb.ActiveLiveChatBackend_DATA_API_BACKEND
+}
+
+func (c *Collector) getActiveLiveChatSource(backend pb.ActiveLiveChatBackend) youtubehelper.ActiveLiveChatSource {
+	if backend == pb.ActiveLiveChatBackend_INNERTUBE_BACKEND {
+		return c.innertubeSource
+	}
+	return c.chatSource
+}
+
+func (c *Collector) collectActiveLiveChatFromYoutube(collectionCtx *collectionContext, video *youtube.Video, pageToken string) {
+	activeLiveChatSource := c.getActiveLiveChatSource(collectionCtx.backend)
+	params, err := activeLiveChatSource.CreateActiveLiveChatParams(video, pageToken)
 	if err != nil {
-		c.unregisterRequestedVideoForActiveLiveChat(video.Id)
+		c.finishActiveLiveChatCollection(video.Id)
 		c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
 			err:                    err,
 			videoId:                video.Id,
 			activeLiveChatMessages: nil,
+			collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+				State:      pb.CollectionState_FAILED,
+				ErrorClass: pb.ErrorClass_UNKNOWN_ERROR,
+				LastError:  err.Error(),
+			},
 		}
 		log.Printf("can not create params of active live chat collector: %v\n", err)
 		return
 	}
+	if err := c.dbOperator.UpdateActiveLiveChatCollection(video.Id, params.GetActiveLiveChatId(), params.GetPageToken(), collectionCtx.backend, c.instanceId); err != nil {
+		log.Printf("can not update active live chat collection in database: %v\n", err)
+	}
+	retryCount := 0
 	for {
-		liveChatMessageListResponse, err := c.activeLiveChatCollector.GetActiveLiveChat(params, youtubeService, bulkMessageMax)
+		if collectionCtx.ctx.Err() != nil {
+			c.stopActiveLiveChatCollection(video.Id, params.GetPageToken())
+			return
+		}
+		liveChatMessageListResponse, err := activeLiveChatSource.GetActiveLiveChat(collectionCtx.ctx, params, bulkMessageMax)
 		if err != nil {
+			if collectionCtx.ctx.Err() != nil {
+				c.stopActiveLiveChatCollection(video.Id, params.GetPageToken())
+				return
+			}
+			errorClass := youtubehelper.ClassifyError(err)
+			if errorClass.Retryable() && retryCount < retryMax {
+				collectionCtx.updateErrorStats(pb.CollectionState_RETRYING, err)
+				backoff := c.retryBackoff(retryCount)
+				retryCount += 1
+				if c.verbose {
+					log.Printf("retry to get live chat messages (videoId = %v, errorClass = %v, retryCount = %v, backoff = %v): %v\n", video.Id, errorClass, retryCount, backoff, err)
+				}
+				c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
+					err:                    nil,
+					videoId:                video.Id,
+					activeLiveChatMessages: nil,
+					collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+						State:       pb.CollectionState_RETRYING,
+						ErrorClass:  c.errorClassToPb(errorClass),
+						RetryCount:  int32(retryCount),
+						NextRetryAt: time.Now().Add(backoff).Format(time.RFC3339),
+						LastError:   err.Error(),
+						PageToken:   params.GetPageToken(),
+					},
+				}
+				timer := time.NewTimer(backoff)
+				select {
+				case <-timer.C:
+				case <-collectionCtx.ctx.Done():
+					timer.Stop()
+				}
+				continue
+			}
+			state := pb.CollectionState_FAILED
+			publishErr := err
+			collectionCtx.updateErrorStats(state, err)
+			if errorClass == youtubehelper.ErrorClassChatEnded {
+				state = pb.CollectionState_FINISHED
+				publishErr = io.EOF
+			}
+			c.finishActiveLiveChatCollection(video.Id)
 			c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
-				err:                    err,
+				err:                    publishErr,
 				videoId:                video.Id,
 				activeLiveChatMessages: nil,
+				collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+					State:      state,
+					ErrorClass: c.errorClassToPb(errorClass),
+					RetryCount: int32(retryCount),
+					LastError:  err.Error(),
+					PageToken:  params.GetPageToken(),
+				},
 			}
-			c.unregisterRequestedVideoForActiveLiveChat(video.Id)
 			if c.verbose {
-				log.Printf("can not get live chat messages: %v\n", err)
+				log.Printf("can not get live chat messages (videoId = %v, errorClass = %v): %v\n", video.Id, errorClass, err)
 			}
 			return
 		}
+		if retryCount > 0 {
+			retryCount = 0
+			c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
+				err:                    nil,
+				videoId:                video.Id,
+				activeLiveChatMessages: nil,
+				collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+					State:     pb.CollectionState_RUNNING,
+					PageToken: params.GetPageToken(),
+				},
+			}
+		}
 		activeLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0, bulkMessageMax)
 		for _, item := range liveChatMessageListResponse.Items {
-			if item.Snippet.SuperChatDetails != nil {
-				activeLiveChatMessage := &pb.ActiveLiveChatMessage{
-					MessageId:             item.Id,
-					ChannelId:             video.Snippet.ChannelId,
-					VideoId:               video.Id,
-					ApiEtag:               liveChatMessageListResponse.Etag,
-					AuthorChannelId:       item.AuthorDetails.ChannelId,
-					AuthorChannelUrl:      item.AuthorDetails.ChannelUrl,
-					AuthorDisplayName:     item.AuthorDetails.DisplayName,
-					AuthorIsChatModerator: item.AuthorDetails.IsChatModerator,
-					AuthorIsChatOwner:     item.AuthorDetails.IsChatOwner,
-					AuthorIsChatSponsor:   item.AuthorDetails.IsChatSponsor,
-					AuthorIsVerified:      item.AuthorDetails.IsVerified,
-					LiveChatId:            item.Snippet.LiveChatId,
-					DisplayMessage:        item.Snippet.SuperChatDetails.UserComment,
-					PublishedAt:           item.Snippet.PublishedAt,
-					IsSuperChat:           true,
-					IsSuperSticker:        false,
-					IsFanFundingEvent:     false,
-					AmountMicros:          strconv.FormatUint(item.Snippet.SuperChatDetails.AmountMicros, 10),
-					AmountDisplayString:   item.Snippet.SuperChatDetails.AmountDisplayString,
-					Currency:              item.Snippet.SuperChatDetails.Currency,
-					PageToken:             params.GetPageToken(),
-				}
-				activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
-			} else if item.Snippet.SuperStickerDetails != nil {
-				activeLiveChatMessage := &pb.ActiveLiveChatMessage{
-					MessageId:             item.Id,
-					ChannelId:             video.Snippet.ChannelId,
-					VideoId:               video.Id,
-					ApiEtag:               liveChatMessageListResponse.Etag,
-					AuthorChannelId:       item.AuthorDetails.ChannelId,
-					AuthorChannelUrl:      item.AuthorDetails.ChannelUrl,
-					AuthorDisplayName:     item.AuthorDetails.DisplayName,
-					AuthorIsChatModerator: item.AuthorDetails.IsChatModerator,
-					AuthorIsChatOwner:     item.AuthorDetails.IsChatOwner,
-					AuthorIsChatSponsor:   item.AuthorDetails.IsChatSponsor,
-					AuthorIsVerified:      item.AuthorDetails.IsVerified,
-					LiveChatId:            item.Snippet.LiveChatId,
-					DisplayMessage:        item.Snippet.SuperStickerDetails.SuperStickerMetadata.AltText,
-					PublishedAt:           item.Snippet.PublishedAt,
-					IsSuperChat:           false,
-					IsSuperSticker:        true,
-					IsFanFundingEvent:     false,
-					AmountMicros:          strconv.FormatUint(item.Snippet.SuperStickerDetails.AmountMicros, 10),
-					AmountDisplayString:   item.Snippet.SuperStickerDetails.AmountDisplayString,
-					Currency:              item.Snippet.SuperStickerDetails.Currency,
-					PageToken:             params.GetPageToken(),
-				}
-				activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
-			} else if item.Snippet.FanFundingEventDetails != nil {
-				activeLiveChatMessage := &pb.ActiveLiveChatMessage{
-					MessageId:             item.Id,
-					ChannelId:             video.Snippet.ChannelId,
-					VideoId:               video.Id,
-					ApiEtag:               liveChatMessageListResponse.Etag,
-					AuthorChannelId:       item.AuthorDetails.ChannelId,
-					AuthorChannelUrl:      item.AuthorDetails.ChannelUrl,
-					AuthorDisplayName:     item.AuthorDetails.DisplayName,
-					AuthorIsChatModerator: item.AuthorDetails.IsChatModerator,
-					AuthorIsChatOwner:     item.AuthorDetails.IsChatOwner,
-					AuthorIsChatSponsor:   item.AuthorDetails.IsChatSponsor,
-					AuthorIsVerified:      item.AuthorDetails.IsVerified,
-					LiveChatId:            item.Snippet.LiveChatId,
-					DisplayMessage:        item.Snippet.FanFundingEventDetails.UserComment,
-					PublishedAt:           item.Snippet.PublishedAt,
-					IsSuperChat:           false,
-					IsSuperSticker:        false,
-					IsFanFundingEvent:     true,
-					AmountMicros:          strconv.FormatUint(item.Snippet.FanFundingEventDetails.AmountMicros, 10),
-					AmountDisplayString:   item.Snippet.FanFundingEventDetails.AmountDisplayString,
-					Currency:              item.Snippet.FanFundingEventDetails.Currency,
-					PageToken:             params.GetPageToken(),
-				}
-				activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
-			} else if item.Snippet.TextMessageDetails != nil {
-				activeLiveChatMessage := &pb.ActiveLiveChatMessage{
-					MessageId:             item.Id,
-					ChannelId:             video.Snippet.ChannelId,
-					VideoId:               video.Id,
-					ApiEtag:               liveChatMessageListResponse.Etag,
-					AuthorChannelId:       item.AuthorDetails.ChannelId,
-					AuthorChannelUrl:      item.AuthorDetails.ChannelUrl,
-					AuthorDisplayName:     item.AuthorDetails.DisplayName,
-					AuthorIsChatModerator: item.AuthorDetails.IsChatModerator,
-					AuthorIsChatOwner:     item.AuthorDetails.IsChatOwner,
-					AuthorIsChatSponsor:   item.AuthorDetails.IsChatSponsor,
-					AuthorIsVerified:      item.AuthorDetails.IsVerified,
-					LiveChatId:            item.Snippet.LiveChatId,
-					DisplayMessage:        item.Snippet.TextMessageDetails.MessageText,
-					PublishedAt:           item.Snippet.PublishedAt,
-					IsSuperChat:           false,
-					IsSuperSticker:        false,
-					IsFanFundingEvent:     false,
-					AmountMicros:           "",
-					AmountDisplayString:   "",
-					Currency:              "",
-					PageToken:             params.GetPageToken(),
+			activeLiveChatMessage, ok := c.createActiveLiveChatMessage(video, liveChatMessageListResponse.Etag, params.GetPageToken(), item)
+			if !ok {
+				if c.verbose {
+					log.Printf("skip unsupported live chat message (videoId = %v, messageId = %v, type = %v)", video.Id, item.Id, item.Snippet.Type)
 				}
-				activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
+				continue
 			}
+			activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
 		}
-		if err := c.dbOperator.UpdateActiveLiveChatMessages(activeLiveChatMessages); err != nil {
+		// FollowActiveLiveChat subscribes while holding publishMutex,
+		// so messages are either in database before subscription or published after it
+		collectionCtx.publishMutex.Lock()
+		insertedActiveLiveChatMessages, err := c.dbOperator.UpdateActiveLiveChatMessages(activeLiveChatMessages)
+		if err != nil {
+			collectionCtx.updateErrorStats(pb.CollectionState_FAILED, err)
 			c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
 				err:                    err,
 				videoId:                video.Id,
 				activeLiveChatMessages: nil,
+				collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+					State:      pb.CollectionState_FAILED,
+					ErrorClass: pb.ErrorClass_UNKNOWN_ERROR,
+					LastError:  err.Error(),
+					PageToken:  params.GetPageToken(),
+				},
 			}
-			c.unregisterRequestedVideoForActiveLiveChat(video.Id)
+			c.finishActiveLiveChatCollection(video.Id)
+			collectionCtx.publishMutex.Unlock()
 			log.Printf("can not update active live chat messages in database: %v\n", err)
 			return
 		}
+		collectionCtx.updatePollStats(len(activeLiveChatMessages), params.GetPageToken(), "")
+		// messages of page fetched again (e.g. retry or resume) are already published
 		c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
 			err:                    nil,
 			videoId:                video.Id,
-			activeLiveChatMessages: activeLiveChatMessages,
+			activeLiveChatMessages: insertedActiveLiveChatMessages,
 		}
-		ok := c.activeLiveChatCollector.Next(params, liveChatMessageListResponse)
+		collectionCtx.publishMutex.Unlock()
+		ok := activeLiveChatSource.NextActiveLiveChat(collectionCtx.ctx, params, liveChatMessageListResponse)
 		if !ok {
+			if collectionCtx.ctx.Err() != nil {
+				c.stopActiveLiveChatCollection(video.Id, params.GetPageToken())
+				return
+			}
+			c.finishActiveLiveChatCollection(video.Id)
 			c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
 				err:                    io.EOF,
 				videoId:                video.Id,
 				activeLiveChatMessages: nil,
+				collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+					State:      pb.CollectionState_FINISHED,
+					ErrorClass: pb.ErrorClass_CHAT_ENDED,
+				},
+			}
+			return
+		}
+		if err := c.dbOperator.UpdateActiveLiveChatCollection(video.Id, params.GetActiveLiveChatId(), params.GetPageToken(), collectionCtx.backend, c.instanceId); err != nil {
+			log.Printf("can not update active live chat collection in database: %v\n", err)
+		}
+	}
+}
+
+func (c *Collector) createVideo(youtubeVideo *youtube.Video) *pb.Video {
+	return &pb.Video{
+		VideoId:            youtubeVideo.Id,
+		ChannelId:          youtubeVideo.Snippet.ChannelId,
+		CategoryId:         youtubeVideo.Snippet.CategoryId,
+		Title:              youtubeVideo.Snippet.Title,
+		Description:        youtubeVideo.Snippet.Description,
+		PublishedAt:        youtubeVideo.Snippet.PublishedAt,
+		Duration:           youtubeVideo.ContentDetails.Duration,
+		ActiveLiveChatId:   youtubeVideo.LiveStreamingDetails.ActiveLiveChatId,
+		ActualStartTime:    youtubeVideo.LiveStreamingDetails.ActualStartTime,
+		ActualEndTime:      youtubeVideo.LiveStreamingDetails.ActualEndTime,
+		ScheduledStartTime: youtubeVideo.LiveStreamingDetails.ScheduledStartTime,
+		ScheduledEndTime:   youtubeVideo.LiveStreamingDetails.ScheduledEndTime,
+		PrivacyStatus:      youtubeVideo.Status.PrivacyStatus,
+		UploadStatus:       youtubeVideo.Status.UploadStatus,
+		Embeddable:         youtubeVideo.Status.Embeddable,
+	}
+}
+
+func (c *Collector) nextLiveCheckInterval(video *youtube.Video) time.Duration {
+	scheduledStartTime := c.parseScheduledStartTime(video)
+	if scheduledStartTime.IsZero() {
+		return waitForLiveCheckInterval
+	}
+	untilStart := time.Until(scheduledStartTime) - waitForLiveLeadTime
+	if untilStart <= waitForLiveCheckInterval {
+		return waitForLiveCheckInterval
+	}
+	if untilStart > waitForLiveMaxSleep {
+		return waitForLiveMaxSleep
+	}
+	return untilStart
+}
+
+func (c *Collector) waitForLive(collectionCtx *collectionContext, video *youtube.Video) {
+	videoId := video.Id
+	if err := c.dbOperator.UpdateActiveLiveChatCollection(videoId, "", "", collectionCtx.backend, c.instanceId); err != nil {
+		log.Printf("can not update active live chat collection in database: %v\n", err)
+	}
+	collectionCtx.updateState(pb.CollectionState_WAITING)
+	c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
+		err:                    nil,
+		videoId:                videoId,
+		activeLiveChatMessages: nil,
+		collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+			State: pb.CollectionState_WAITING,
+		},
+	}
+	for {
+		interval := c.nextLiveCheckInterval(video)
+		if c.verbose {
+			log.Printf("wait for live (videoId = %v, scheduledStartTime = %v, interval = %v)", videoId, video.LiveStreamingDetails.ScheduledStartTime, interval)
+		}
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-collectionCtx.ctx.Done():
+			timer.Stop()
+			c.stopActiveLiveChatCollection(videoId, "")
+			return
+		}
+		youtubeVideo, ok, err := c.chatSource.GetVideo(videoId)
+		if err != nil {
+			collectionCtx.updateErrorStats(pb.CollectionState_WAITING, err)
+			log.Printf("can not get video for waiting for live (videoId = %v): %v", videoId, err)
+			continue
+		}
+		scheduledStartTime := c.parseScheduledStartTime(youtubeVideo)
+		expired := !scheduledStartTime.IsZero() && time.Now().After(scheduledStartTime.Add(upcomingVideoExpiration))
+		if !ok || youtubeVideo.LiveStreamingDetails == nil || c.isLiveEnded(youtubeVideo) || expired {
+			err := fmt.Errorf("live was not started")
+			collectionCtx.updateErrorStats(pb.CollectionState_FAILED, err)
+			c.finishActiveLiveChatCollection(videoId)
+			c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
+				err:                    err,
+				videoId:                videoId,
+				activeLiveChatMessages: nil,
+				collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+					State:      pb.CollectionState_FAILED,
+					ErrorClass: pb.ErrorClass_CHAT_ENDED,
+					LastError:  err.Error(),
+				},
 			}
-			c.unregisterRequestedVideoForActiveLiveChat(video.Id)
+			return
+		}
+		video = youtubeVideo
+		if youtubeVideo.LiveStreamingDetails.ActiveLiveChatId == "" {
+			continue
+		}
+		if err := c.dbOperator.UpdateVideo(c.createVideo(youtubeVideo)); err != nil {
+			log.Printf("can not update video for waiting for live (videoId = %v): %v", videoId, err)
+		}
+		if c.verbose {
+			log.Printf("live is started (videoId = %v, activeLiveChatId = %v)", videoId, youtubeVideo.LiveStreamingDetails.ActiveLiveChatId)
+		}
+		collectionCtx.updateState(pb.CollectionState_RUNNING)
+		c.publishActiveLiveChatCh <- &publishActiveLiveChatMessagesParams{
+			err:                    nil,
+			videoId:                videoId,
+			activeLiveChatMessages: nil,
+			collectionStatus: &pb.ActiveLiveChatCollectionStatus{
+				State: pb.CollectionState_RUNNING,
+			},
+		}
+		c.collectActiveLiveChatFromYoutube(collectionCtx, youtubeVideo, "")
+		return
+	}
+}
+
+// getVideoForResume gets video with retry of retryable errors, it returns error when collection is canceled while waiting
+func (c *Collector) getVideoForResume(collectionCtx *collectionContext, videoId string) (*youtube.Video, bool, error) {
+	retryCount := 0
+	for {
+		youtubeVideo, ok, err := c.chatSource.GetVideo(videoId)
+		if err == nil {
+			return youtubeVideo, ok, nil
+		}
+		errorClass := youtubehelper.ClassifyError(err)
+		if !errorClass.Retryable() || retryCount >= retryMax {
+			return nil, false, err
+		}
+		backoff := c.retryBackoff(retryCount)
+		retryCount += 1
+		if c.verbose {
+			log.Printf("retry to get video for resuming active live chat (videoId = %v, errorClass = %v, retryCount = %v, backoff = %v): %v", videoId, errorClass, retryCount, backoff, err)
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-collectionCtx.ctx.Done():
+			timer.Stop()
+			return nil, false, collectionCtx.ctx.Err()
+		}
+	}
+}
+
+func (c *Collector) resumeActiveLiveChat(collectionCtx *collectionContext, activeLiveChatCollection *ActiveLiveChatCollection) {
+	videoId := activeLiveChatCollection.VideoId
+	youtubeVideo, ok, err := c.getVideoForResume(collectionCtx, videoId)
+	if err != nil {
+		if collectionCtx.ctx.Err() != nil {
+			// stopped while waiting for retry
+			return
+		}
+		if youtubehelper.ClassifyError(err) == youtubehelper.ErrorClassChatEnded {
+			log.Printf("can not get video for resuming active live chat, collection is finished (videoId = %v): %v", videoId, err)
+			c.finishActiveLiveChatCollection(videoId)
+			return
 		}
+		// collection is kept in database and resumed at next start
+		log.Printf("can not get video for resuming active live chat, collection is kept for next start (videoId = %v): %v", videoId, err)
+		c.unregisterRequestedVideoForActiveLiveChat(videoId)
+		return
+	}
+	if ok && youtubeVideo.LiveStreamingDetails != nil && youtubeVideo.LiveStreamingDetails.ActiveLiveChatId == "" &&
+		activeLiveChatCollection.ActiveLiveChatId == "" && !c.isLiveEnded(youtubeVideo) {
+		collectionCtx.updateBackend(c.resolveActiveLiveChatBackend(activeLiveChatCollection.Backend))
+		if c.verbose {
+			log.Printf("resume waiting for live (videoId = %v)", videoId)
+		}
+		c.waitForLive(collectionCtx, youtubeVideo)
+		return
+	}
+	if !ok || youtubeVideo.LiveStreamingDetails == nil || youtubeVideo.LiveStreamingDetails.ActiveLiveChatId == "" {
+		if c.verbose {
+			log.Printf("active live chat was already ended (videoId = %v)", videoId)
+		}
+		c.finishActiveLiveChatCollection(videoId)
+		return
+	}
+	if err := c.dbOperator.UpdateVideo(c.createVideo(youtubeVideo)); err != nil {
+		log.Printf("can not update video for resuming active live chat (videoId = %v): %v", videoId, err)
 	}
+	pageToken := activeLiveChatCollection.PageToken
+	if youtubeVideo.LiveStreamingDetails.ActiveLiveChatId != activeLiveChatCollection.ActiveLiveChatId {
+		pageToken = ""
+	}
+	collectionCtx.updateBackend(c.resolveActiveLiveChatBackend(activeLiveChatCollection.Backend))
+	if c.verbose {
+		log.Printf("resume active live chat (videoId = %v, pageToken = %v, backend = %v)", videoId, pageToken, collectionCtx.backend)
+	}
+	c.collectActiveLiveChatFromYoutube(collectionCtx, youtubeVideo, pageToken)
+}
+
+func (c *Collector) resumeActiveLiveChatCollections() error {
+	activeLiveChatCollections, err := c.dbOperator.GetActiveLiveChatCollections(c.instanceId)
+	if err != nil {
+		return fmt.Errorf("can not get active live chat collections: %w", err)
+	}
+	for _, activeLiveChatCollection := range activeLiveChatCollections {
+		collectionCtx, ok := c.registerRequestedVideoForActiveLiveChat(activeLiveChatCollection.VideoId, c.autoStopGracePeriod)
+		if !ok {
+			continue
+		}
+		go c.resumeActiveLiveChat(collectionCtx, activeLiveChatCollection)
+	}
+	return nil
 }
 
 func (c *Collector) GetVideo(request *pb.GetVideoRequest) (*pb.GetVideoResponse, error) {
@@ -305,7 +1030,11 @@ func (c *Collector) GetVideo(request *pb.GetVideoRequest) (*pb.GetVideoResponse,
 
 func (c *Collector) StartCollectionActiveLiveChat(request *pb.StartCollectionActiveLiveChatRequest) (*pb.StartCollectionActiveLiveChatResponse, error) {
 	status := new(pb.Status)
-	ok := c.registerRequestedVideoForActiveLiveChat(request.VideoId)
+	autoStopGracePeriod := c.autoStopGracePeriod
+	if request.AutoStopGracePeriod > 0 {
+		autoStopGracePeriod = time.Duration(request.AutoStopGracePeriod) * time.Second
+	}
+	collectionCtx, ok := c.registerRequestedVideoForActiveLiveChat(request.VideoId, autoStopGracePeriod)
 	if !ok {
 		status.Code = pb.Code_IN_PROGRESS
 		status.Message = fmt.Sprintf("collecting for active live chat is in progress (videoId = %v)", request.VideoId)
@@ -314,17 +1043,7 @@ func (c *Collector) StartCollectionActiveLiveChat(request *pb.StartCollectionAct
 			Video:  nil,
 		}, nil
 	}
-	youtubeService, err := c.activeLiveChatCollector.CreateYoutubeService()
-	if err != nil {
-		status.Code = pb.Code_INTERNAL_ERROR
-		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
-		c.unregisterRequestedVideoForActiveLiveChat(request.VideoId)
-		return &pb.StartCollectionActiveLiveChatResponse{
-			Status: status,
-			Video:  nil,
-		}, nil
-	}
-	youtubeVideo, ok, err := c.activeLiveChatCollector.GetVideo(request.VideoId, youtubeService)
+	youtubeVideo, ok, err := c.chatSource.GetVideo(request.VideoId)
 	if err != nil {
 		status.Code = pb.Code_INTERNAL_ERROR
 		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
@@ -343,23 +1062,7 @@ func (c *Collector) StartCollectionActiveLiveChat(request *pb.StartCollectionAct
 			Video:  nil,
 		}, nil
 	}
-	video := &pb.Video{
-		VideoId:            youtubeVideo.Id,
-		ChannelId:          youtubeVideo.Snippet.ChannelId,
-		CategoryId:         youtubeVideo.Snippet.CategoryId,
-		Title:              youtubeVideo.Snippet.Title,
-		Description:        youtubeVideo.Snippet.Description,
-		PublishedAt:        youtubeVideo.Snippet.PublishedAt,
-		Duration:           youtubeVideo.ContentDetails.Duration,
-		ActiveLiveChatId:   youtubeVideo.LiveStreamingDetails.ActiveLiveChatId,
-		ActualStartTime:    youtubeVideo.LiveStreamingDetails.ActualStartTime,
-		ActualEndTime:      youtubeVideo.LiveStreamingDetails.ActualEndTime,
-		ScheduledStartTime: youtubeVideo.LiveStreamingDetails.ScheduledStartTime,
-		ScheduledEndTime:   youtubeVideo.LiveStreamingDetails.ScheduledEndTime,
-		PrivacyStatus:      youtubeVideo.Status.PrivacyStatus,
-		UploadStatus:       youtubeVideo.Status.UploadStatus,
-		Embeddable:         youtubeVideo.Status.Embeddable,
-	}
+	video := c.createVideo(youtubeVideo)
 	if err := c.dbOperator.UpdateVideo(video); err != nil {
 		status.Code = pb.Code_INTERNAL_ERROR
 		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
@@ -369,6 +1072,16 @@ func (c *Collector) StartCollectionActiveLiveChat(request *pb.StartCollectionAct
 			Video:  video,
 		}, nil
 	}
+	if video.ActiveLiveChatId == "" && request.WaitForLive && !c.isLiveEnded(youtubeVideo) {
+		collectionCtx.updateBackend(c.resolveActiveLiveChatBackend(request.Backend))
+		go c.waitForLive(collectionCtx, youtubeVideo)
+		status.Code = pb.Code_SUCCESS
+		status.Message = fmt.Sprintf("waiting for live (videoId = %v)", request.VideoId)
+		return &pb.StartCollectionActiveLiveChatResponse{
+			Status: status,
+			Video:  video,
+		}, nil
+	}
 	if video.ActiveLiveChatId == "" {
 		status.Code = pb.Code_NOT_FOUND
 		status.Message = fmt.Sprintf("not live video (videoId = %v)", request.VideoId)
@@ -378,7 +1091,8 @@ func (c *Collector) StartCollectionActiveLiveChat(request *pb.StartCollectionAct
 			Video:  video,
 		}, nil
 	}
-	go c.collectActiveLiveChatFromYoutube(youtubeVideo, youtubeService)
+	collectionCtx.updateBackend(c.resolveActiveLiveChatBackend(request.Backend))
+	go c.collectActiveLiveChatFromYoutube(collectionCtx, youtubeVideo, "")
 	status.Code = pb.Code_SUCCESS
 	status.Message = fmt.Sprintf("success (videoId = %v)", request.VideoId)
 	return &pb.StartCollectionActiveLiveChatResponse{
@@ -388,113 +1102,231 @@ func (c *Collector) StartCollectionActiveLiveChat(request *pb.StartCollectionAct
 }
 
 func (c *Collector) GetCachedActiveLiveChat(request *pb.GetCachedActiveLiveChatRequest) (*pb.GetCachedActiveLiveChatResponse, error) {
+	// messages are read even while collecting, they are already stored in database
 	status := new(pb.Status)
-	progress := c.checkRequestedVideoForActiveLiveChat(request.VideoId)
-	if progress {
-		status.Code = pb.Code_IN_PROGRESS
-		status.Message = fmt.Sprintf("collecting for active live chat is in progress (videoId = %v, offset = %v, count = %v)", request.VideoId, request.Offset, request.Count)
+	condition := &LiveChatPageCondition{
+		VideoId: request.VideoId,
+		Since:   request.Since,
+		Until:   request.Until,
+		Offset:  request.Offset,
+		Count:   request.Count,
+	}
+	if err := decodeLiveChatCursor(request.Cursor, condition); err != nil {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
 		return &pb.GetCachedActiveLiveChatResponse{
 			Status:                 status,
 			ActiveLiveChatMessages: nil,
 		}, nil
 	}
-	activeLiveChatMessages, err := c.dbOperator.GetActiveLiveChatMessagesByVideoIdAndToken(request.VideoId, request.Offset, request.Count)
+	if condition.AfterMessageId != "" {
+		// cursor is used instead of offset
+		condition.Offset = 0
+	}
+	if request.Count > 0 {
+		// one more message is read to know whether next page exists
+		condition.Count = request.Count + 1
+	}
+	pagedActiveLiveChatMessages, err := c.dbOperator.GetActiveLiveChatMessagesByPosition(condition)
 	if err != nil {
 		status.Code = pb.Code_INTERNAL_ERROR
 		status.Message = fmt.Sprintf("%v (videoId = %v, offset = %v, count = %v)", err, request.VideoId, request.Offset, request.Count)
-		c.unregisterRequestedVideoForActiveLiveChat(request.VideoId)
 		return &pb.GetCachedActiveLiveChatResponse{
 			Status:                 status,
 			ActiveLiveChatMessages: nil,
 		}, nil
 	}
+	// counting reads all messages in time range, so it is done only when requested
+	var total int64 = -1
+	if request.WithTotal {
+		total, err = c.dbOperator.CountActiveLiveChatMessages(condition)
+		if err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = fmt.Sprintf("%v (videoId = %v, offset = %v, count = %v)", err, request.VideoId, request.Offset, request.Count)
+			return &pb.GetCachedActiveLiveChatResponse{
+				Status:                 status,
+				ActiveLiveChatMessages: nil,
+			}, nil
+		}
+	}
+	nextCursor := ""
+	if request.Count > 0 && int64(len(pagedActiveLiveChatMessages)) > request.Count {
+		pagedActiveLiveChatMessages = pagedActiveLiveChatMessages[:request.Count]
+		lastActiveLiveChatMessage := pagedActiveLiveChatMessages[len(pagedActiveLiveChatMessages)-1]
+		nextCursor = encodeLiveChatCursor(lastActiveLiveChatMessage.Position, lastActiveLiveChatMessage.ActiveLiveChatMessage.MessageId)
+	}
+	activeLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0, len(pagedActiveLiveChatMessages))
+	for _, pagedActiveLiveChatMessage := range pagedActiveLiveChatMessages {
+		activeLiveChatMessages = append(activeLiveChatMessages, pagedActiveLiveChatMessage.ActiveLiveChatMessage)
+	}
 	status.Code = pb.Code_SUCCESS
-	status.Message = fmt.Sprintf("success (videoId = %v, offset = %v, count = %v)", request.VideoId, request.Offset, request.Count)
+	status.Message = fmt.Sprintf("success (videoId = %v, offset = %v, count = %v, total = %v)", request.VideoId, request.Offset, request.Count, total)
 	return &pb.GetCachedActiveLiveChatResponse{
 		Status:                 status,
 		ActiveLiveChatMessages: activeLiveChatMessages,
+		NextCursor:             nextCursor,
+		Total:                  total,
 	}, nil
 }
 
-func (c *Collector) collectArchiveLiveChatFromYoutube(channelId string, videoId string) {
-	params, err := c.archiveLiveChatCollector.GetParams(videoId)
+func setArchiveLiveChatAuthorBadges(archiveLiveChatMessage *pb.ArchiveLiveChatMessage, badges youtubehelper.LiveChatAuthorBadges) {
+	role := badges.Role()
+	archiveLiveChatMessage.AuthorIsChatOwner = role.IsChatOwner
+	archiveLiveChatMessage.AuthorIsChatModerator = role.IsChatModerator
+	archiveLiveChatMessage.AuthorIsChatSponsor = role.IsChatSponsor
+	archiveLiveChatMessage.AuthorIsVerified = role.IsVerified
+	authorBadges := make([]*pb.AuthorBadge, 0, len(badges))
+	for _, badge := range badges {
+		authorBadges = append(authorBadges, &pb.AuthorBadge{
+			IconType:     badge.LiveChatAuthorBadgeRenderer.Icon.IconType,
+			Tooltip:      badge.LiveChatAuthorBadgeRenderer.Tooltip,
+			ThumbnailUrl: badge.ThumbnailURL(),
+		})
+	}
+	archiveLiveChatMessage.AuthorBadges = authorBadges
+}
+
+// newArchiveLiveChatMessages converts actions of response to messages and returns them with the last videoOffsetTimeMsec,
+// it is -1 when no action has videoOffsetTimeMsec
+func newArchiveLiveChatMessages(resp *youtubehelper.GetLiveChatRespose, channelId string, videoId string, continuation string) ([]*pb.ArchiveLiveChatMessage, int64) {
+	var videoOffsetTimeMsec int64 = -1
+	archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0, bulkMessageMax)
+	for _, cact := range resp.ContinuationContents.LiveChatContinuation.Actions {
+		if offset, err := strconv.ParseInt(cact.ReplayChatItemAction.VideoOffsetTimeMsec, 10, 64); err == nil && offset > videoOffsetTimeMsec {
+			videoOffsetTimeMsec = offset
+		}
+		for _, iact := range cact.ReplayChatItemAction.Actions {
+			item := iact.AddChatItemAction.Item
+			archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{
+				ChannelId:           channelId,
+				VideoId:             videoId,
+				ClientId:            iact.AddChatItemAction.ClientID,
+				VideoOffsetTimeMsec: cact.ReplayChatItemAction.VideoOffsetTimeMsec,
+				Continuation:        continuation,
+			}
+			if item.LiveChatPaidMessageRenderer.ID != "" {
+				messageText := ""
+				for _, run := range item.LiveChatPaidMessageRenderer.Message.Runs {
+					messageText += run.Text
+				}
+				archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_PAID_MESSAGE
+				archiveLiveChatMessage.MessageId = item.LiveChatPaidMessageRenderer.ID
+				archiveLiveChatMessage.AuthorName = item.LiveChatPaidMessageRenderer.AuthorName.SimpleText
+				archiveLiveChatMessage.AuthorExternalChannelId = item.LiveChatPaidMessageRenderer.AuthorExternalChannelID
+				archiveLiveChatMessage.MessageText = messageText
+				archiveLiveChatMessage.PurchaseAmountText = item.LiveChatPaidMessageRenderer.PurchaseAmountText.SimpleText
+				archiveLiveChatMessage.IsPaid = true
+				archiveLiveChatMessage.TimestampUsec = item.LiveChatPaidMessageRenderer.TimestampUsec
+				archiveLiveChatMessage.TimestampText = item.LiveChatPaidMessageRenderer.TimestampText.SimpleText
+				setArchiveLiveChatAuthorBadges(archiveLiveChatMessage, item.LiveChatPaidMessageRenderer.AuthorBadges)
+			} else if item.LiveChatTextMessageRenderer.ID != "" {
+				messageText := ""
+				for _, run := range item.LiveChatTextMessageRenderer.Message.Runs {
+					messageText += run.Text
+				}
+				archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_TEXT_MESSAGE
+				archiveLiveChatMessage.MessageId = item.LiveChatTextMessageRenderer.ID
+				archiveLiveChatMessage.AuthorName = item.LiveChatTextMessageRenderer.AuthorName.SimpleText
+				archiveLiveChatMessage.AuthorExternalChannelId = item.LiveChatTextMessageRenderer.AuthorExternalChannelID
+				archiveLiveChatMessage.MessageText = messageText
+				archiveLiveChatMessage.TimestampUsec = item.LiveChatTextMessageRenderer.TimestampUsec
+				archiveLiveChatMessage.TimestampText = item.LiveChatTextMessageRenderer.TimestampText.SimpleText
+				setArchiveLiveChatAuthorBadges(archiveLiveChatMessage, item.LiveChatTextMessageRenderer.AuthorBadges)
+			} else if item.LiveChatPaidStickerRenderer.ID != "" {
+				archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_PAID_STICKER
+				archiveLiveChatMessage.MessageId = item.LiveChatPaidStickerRenderer.ID
+				archiveLiveChatMessage.AuthorName = item.LiveChatPaidStickerRenderer.AuthorName.String()
+				archiveLiveChatMessage.AuthorExternalChannelId = item.LiveChatPaidStickerRenderer.AuthorExternalChannelID
+				archiveLiveChatMessage.MessageText = item.LiveChatPaidStickerRenderer.Sticker.Accessibility.AccessibilityData.Label
+				archiveLiveChatMessage.PurchaseAmountText = item.LiveChatPaidStickerRenderer.PurchaseAmountText.String()
+				archiveLiveChatMessage.IsPaid = true
+				archiveLiveChatMessage.TimestampUsec = item.LiveChatPaidStickerRenderer.TimestampUsec
+				archiveLiveChatMessage.TimestampText = item.LiveChatPaidStickerRenderer.TimestampText.String()
+				setArchiveLiveChatAuthorBadges(archiveLiveChatMessage, item.LiveChatPaidStickerRenderer.AuthorBadges)
+			} else if item.LiveChatMembershipItemRenderer.ID != "" {
+				archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_MEMBERSHIP_ITEM
+				archiveLiveChatMessage.MessageId = item.LiveChatMembershipItemRenderer.ID
+				archiveLiveChatMessage.AuthorName = item.LiveChatMembershipItemRenderer.AuthorName.String()
+				archiveLiveChatMessage.AuthorExternalChannelId = item.LiveChatMembershipItemRenderer.AuthorExternalChannelID
+				archiveLiveChatMessage.MessageText = item.LiveChatMembershipItemRenderer.Message.String()
+				archiveLiveChatMessage.HeaderText = item.LiveChatMembershipItemRenderer.HeaderText()
+				archiveLiveChatMessage.TimestampUsec = item.LiveChatMembershipItemRenderer.TimestampUsec
+				archiveLiveChatMessage.TimestampText = item.LiveChatMembershipItemRenderer.TimestampText.String()
+				setArchiveLiveChatAuthorBadges(archiveLiveChatMessage, item.LiveChatMembershipItemRenderer.AuthorBadges)
+				archiveLiveChatMessage.AuthorIsChatSponsor = true
+			} else if item.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer.ID != "" {
+				header := item.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer.Header.LiveChatSponsorshipsHeaderRenderer
+				archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_PURCHASE
+				archiveLiveChatMessage.MessageId = item.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer.ID
+				archiveLiveChatMessage.AuthorName = header.AuthorName.String()
+				archiveLiveChatMessage.AuthorExternalChannelId = item.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer.AuthorExternalChannelID
+				archiveLiveChatMessage.HeaderText = header.PrimaryText.String()
+				archiveLiveChatMessage.GiftMembershipsCount = item.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer.GiftMembershipsCount()
+				archiveLiveChatMessage.TimestampUsec = item.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer.TimestampUsec
+				setArchiveLiveChatAuthorBadges(archiveLiveChatMessage, header.AuthorBadges)
+			} else if item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.ID != "" {
+				archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_REDEMPTION
+				archiveLiveChatMessage.MessageId = item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.ID
+				archiveLiveChatMessage.AuthorName = item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.AuthorName.String()
+				archiveLiveChatMessage.AuthorExternalChannelId = item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.AuthorExternalChannelID
+				archiveLiveChatMessage.MessageText = item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.Message.String()
+				archiveLiveChatMessage.TimestampUsec = item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.TimestampUsec
+				archiveLiveChatMessage.TimestampText = item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.TimestampText.String()
+				setArchiveLiveChatAuthorBadges(archiveLiveChatMessage, item.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer.AuthorBadges)
+			} else {
+				continue
+			}
+			archiveLiveChatMessages = append(archiveLiveChatMessages, archiveLiveChatMessage)
+		}
+	}
+	return archiveLiveChatMessages, videoOffsetTimeMsec
+}
+
+func (c *Collector) collectArchiveLiveChatFromYoutube(collectionCtx *collectionContext, channelId string, videoId string) {
+	params, err := c.chatSource.GetArchiveLiveChatParams(collectionCtx.ctx, videoId)
 	if err != nil {
-		log.Printf("can not get params of archive live chat: %v", err)
-		c.unregisterRequestedVideoForArchiveLiveChat(videoId)
+		c.finishArchiveLiveChatCollection(collectionCtx, fmt.Errorf("can not get params of archive live chat: %w", err))
 		return
 	}
 	for {
-		resp, err := c.archiveLiveChatCollector.GetArchiveLiveChat(params)
-		if err != nil {
-			log.Printf("can not get archive live chat: %v", err)
-			c.unregisterRequestedVideoForArchiveLiveChat(videoId)
+		if err := collectionCtx.ctx.Err(); err != nil {
+			if c.verbose {
+				log.Printf("stop archive live chat collection (videoId = %v)", videoId)
+			}
+			c.finishArchiveLiveChatCollection(collectionCtx, err)
 			return
 		}
-		archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0, bulkMessageMax)
-		for _, cact := range resp.ContinuationContents.LiveChatContinuation.Actions {
-			for _, iact := range cact.ReplayChatItemAction.Actions {
-				if iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.ID != "" {
-					messageText := ""
-					for _, run := range iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.Message.Runs {
-						messageText += run.Text
-					}
-					archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{
-						MessageId:               iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.ID,
-						ChannelId:               channelId,
-						VideoId:                 videoId,
-						ClientId:                iact.AddChatItemAction.ClientID,
-						AuthorName:              iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.AuthorName.SimpleText,
-						AuthorExternalChannelId: iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.AuthorExternalChannelID,
-						MessageText:             messageText,
-						PurchaseAmountText:      iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.PurchaseAmountText.SimpleText,
-						IsPaid:                  true,
-						TimestampUsec:           iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.TimestampUsec,
-						TimestampText:           iact.AddChatItemAction.Item.LiveChatPaidMessageRenderer.TimestampText.SimpleText,
-						VideoOffsetTimeMsec:     cact.ReplayChatItemAction.VideoOffsetTimeMsec,
-						Continuation:            params.GetContinuation(),
-					}
-					archiveLiveChatMessages = append(archiveLiveChatMessages, archiveLiveChatMessage)
-				}
-				if iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.ID != "" {
-					messageText := ""
-					for _, run := range iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.Message.Runs {
-						messageText += run.Text
-					}
-					archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{
-						MessageId:               iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.ID,
-						ChannelId:               channelId,
-						VideoId:                 videoId,
-						ClientId:                iact.AddChatItemAction.ClientID,
-						AuthorName:              iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.AuthorName.SimpleText,
-						AuthorExternalChannelId: iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.AuthorExternalChannelID,
-						MessageText:             messageText,
-						PurchaseAmountText:      "",
-						IsPaid:                  false,
-						TimestampUsec:           iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.TimestampUsec,
-						TimestampText:           iact.AddChatItemAction.Item.LiveChatTextMessageRenderer.TimestampText.SimpleText,
-						VideoOffsetTimeMsec:     cact.ReplayChatItemAction.VideoOffsetTimeMsec,
-						Continuation:            params.GetContinuation(),
-					}
-					archiveLiveChatMessages = append(archiveLiveChatMessages, archiveLiveChatMessage)
-				}
+		resp, err := c.chatSource.GetArchiveLiveChat(collectionCtx.ctx, params)
+		if err != nil {
+			if ctxErr := collectionCtx.ctx.Err(); ctxErr != nil {
+				err = ctxErr
+			} else {
+				err = fmt.Errorf("can not get archive live chat: %w", err)
 			}
+			c.finishArchiveLiveChatCollection(collectionCtx, err)
+			return
 		}
+		archiveLiveChatMessages, videoOffsetTimeMsec := newArchiveLiveChatMessages(resp, channelId, videoId, params.GetContinuation())
 		if err := c.dbOperator.UpdateArchiveLiveChatMessages(archiveLiveChatMessages); err != nil {
-			c.unregisterRequestedVideoForArchiveLiveChat(videoId)
-			log.Printf("can not update archive live chat messages in database: %v\n", err)
+			c.finishArchiveLiveChatCollection(collectionCtx, fmt.Errorf("can not update archive live chat messages in database: %w", err))
 			return
 		}
-		ok := c.archiveLiveChatCollector.Next(params, resp)
+		collectionCtx.updatePollStats(len(archiveLiveChatMessages), "", params.GetContinuation())
+		if videoOffsetTimeMsec >= 0 {
+			collectionCtx.updateVideoOffset(videoOffsetTimeMsec)
+		}
+		c.publishArchiveLiveChatProgress(collectionCtx)
+		ok := c.chatSource.NextArchiveLiveChat(params, resp)
 		if !ok {
 			break
 		}
 	}
-	c.unregisterRequestedVideoForArchiveLiveChat(videoId)
+	c.finishArchiveLiveChatCollection(collectionCtx, nil)
 }
 
 func (c *Collector) StartCollectionArchiveLiveChat(request *pb.StartCollectionArchiveLiveChatRequest) (*pb.StartCollectionArchiveLiveChatResponse, error) {
 	status := new(pb.Status)
-	ok := c.registerRequestedVideoForArchiveLiveChat(request.VideoId)
+	collectionCtx, ok := c.registerRequestedVideoForArchiveLiveChat(request.VideoId)
 	if !ok {
 		status.Code = pb.Code_IN_PROGRESS
 		status.Message = fmt.Sprintf("collecting archive live chat is in progress (videoId = %v)", request.VideoId)
@@ -503,17 +1335,7 @@ func (c *Collector) StartCollectionArchiveLiveChat(request *pb.StartCollectionAr
 			Video:  nil,
 		}, nil
 	}
-	youtubeService, err := c.activeLiveChatCollector.CreateYoutubeService()
-	if err != nil {
-		status.Code = pb.Code_INTERNAL_ERROR
-		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
-		c.unregisterRequestedVideoForArchiveLiveChat(request.VideoId)
-		return &pb.StartCollectionArchiveLiveChatResponse{
-			Status: status,
-			Video:  nil,
-		}, nil
-	}
-	youtubeVideo, ok, err := c.activeLiveChatCollector.GetVideo(request.VideoId, youtubeService)
+	youtubeVideo, ok, err := c.chatSource.GetVideo(request.VideoId)
 	if err != nil {
 		status.Code = pb.Code_INTERNAL_ERROR
 		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
@@ -532,23 +1354,7 @@ func (c *Collector) StartCollectionArchiveLiveChat(request *pb.StartCollectionAr
 			Video:  nil,
 		}, nil
 	}
-	video := &pb.Video{
-		VideoId:            youtubeVideo.Id,
-		ChannelId:          youtubeVideo.Snippet.ChannelId,
-		CategoryId:         youtubeVideo.Snippet.CategoryId,
-		Title:              youtubeVideo.Snippet.Title,
-		Description:        youtubeVideo.Snippet.Description,
-		PublishedAt:        youtubeVideo.Snippet.PublishedAt,
-		Duration:           youtubeVideo.ContentDetails.Duration,
-		ActiveLiveChatId:   youtubeVideo.LiveStreamingDetails.ActiveLiveChatId,
-		ActualStartTime:    youtubeVideo.LiveStreamingDetails.ActualStartTime,
-		ActualEndTime:      youtubeVideo.LiveStreamingDetails.ActualEndTime,
-		ScheduledStartTime: youtubeVideo.LiveStreamingDetails.ScheduledStartTime,
-		ScheduledEndTime:   youtubeVideo.LiveStreamingDetails.ScheduledEndTime,
-		PrivacyStatus:      youtubeVideo.Status.PrivacyStatus,
-		UploadStatus:       youtubeVideo.Status.UploadStatus,
-		Embeddable:         youtubeVideo.Status.Embeddable,
-	}
+	video := c.createVideo(youtubeVideo)
 	if err := c.dbOperator.UpdateVideo(video); err != nil {
 		status.Code = pb.Code_INTERNAL_ERROR
 		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
@@ -591,7 +1397,12 @@ func (c *Collector) StartCollectionArchiveLiveChat(request *pb.StartCollectionAr
 			}, nil
 		}
 	}
-	go c.collectArchiveLiveChatFromYoutube(video.ChannelId, video.VideoId)
+	if duration, err := youtubehelper.ParseVideoDuration(video.Duration); err == nil {
+		collectionCtx.updateDuration(duration)
+	} else if c.verbose {
+		log.Printf("can not parse duration of video (videoId = %v): %v", request.VideoId, err)
+	}
+	go c.collectArchiveLiveChatFromYoutube(collectionCtx, video.ChannelId, video.VideoId)
 	status.Code = pb.Code_SUCCESS
 	status.Message = fmt.Sprintf("success (videoId = %v)", request.VideoId)
 	return &pb.StartCollectionArchiveLiveChatResponse{
@@ -611,7 +1422,30 @@ func (c *Collector) GetArchiveLiveChat(request *pb.GetArchiveLiveChatRequest) (*
 			ArchiveLiveChatMessages: nil,
 		}, nil
 	}
-	archiveLiveChatMessages, err := c.dbOperator.GetArchiveLiveChatMessagesByVideoIdAndToken(request.VideoId, request.Offset, request.Count)
+	condition := &LiveChatPageCondition{
+		VideoId: request.VideoId,
+		Since:   request.Since,
+		Until:   request.Until,
+		Offset:  request.Offset,
+		Count:   request.Count,
+	}
+	if err := decodeLiveChatCursor(request.Cursor, condition); err != nil {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+		return &pb.GetArchiveLiveChatResponse{
+			Status:                  status,
+			ArchiveLiveChatMessages: nil,
+		}, nil
+	}
+	if condition.AfterMessageId != "" {
+		// cursor is used instead of offset
+		condition.Offset = 0
+	}
+	if request.Count > 0 {
+		// one more message is read to know whether next page exists
+		condition.Count = request.Count + 1
+	}
+	pagedArchiveLiveChatMessages, err := c.dbOperator.GetArchiveLiveChatMessagesByPosition(condition)
 	if err != nil {
 		status.Code = pb.Code_INTERNAL_ERROR
 		status.Message = fmt.Sprintf("%v (videoId = %v, offset = %v, count = %v)", err, request.VideoId, request.Offset, request.Count)
@@ -621,11 +1455,122 @@ func (c *Collector) GetArchiveLiveChat(request *pb.GetArchiveLiveChatRequest) (*
 			ArchiveLiveChatMessages: nil,
 		}, nil
 	}
+	// counting reads all messages in time range, so it is done only when requested
+	var total int64 = -1
+	if request.WithTotal {
+		total, err = c.dbOperator.CountArchiveLiveChatMessages(condition)
+		if err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = fmt.Sprintf("%v (videoId = %v, offset = %v, count = %v)", err, request.VideoId, request.Offset, request.Count)
+			c.unregisterRequestedVideoForArchiveLiveChat(request.VideoId)
+			return &pb.GetArchiveLiveChatResponse{
+				Status:                  status,
+				ArchiveLiveChatMessages: nil,
+			}, nil
+		}
+	}
+	nextCursor := ""
+	if request.Count > 0 && int64(len(pagedArchiveLiveChatMessages)) > request.Count {
+		pagedArchiveLiveChatMessages = pagedArchiveLiveChatMessages[:request.Count]
+		lastArchiveLiveChatMessage := pagedArchiveLiveChatMessages[len(pagedArchiveLiveChatMessages)-1]
+		nextCursor = encodeLiveChatCursor(lastArchiveLiveChatMessage.Position, lastArchiveLiveChatMessage.ArchiveLiveChatMessage.MessageId)
+	}
+	archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0, len(pagedArchiveLiveChatMessages))
+	for _, pagedArchiveLiveChatMessage := range pagedArchiveLiveChatMessages {
+		archiveLiveChatMessages = append(archiveLiveChatMessages, pagedArchiveLiveChatMessage.ArchiveLiveChatMessage)
+	}
 	status.Code = pb.Code_SUCCESS
-	status.Message = fmt.Sprintf("success (videoId = %v, offset = %v, count = %v)", request.VideoId, request.Offset, request.Count)
+	status.Message = fmt.Sprintf("success (videoId = %v, offset = %v, count = %v, total = %v)", request.VideoId, request.Offset, request.Count, total)
 	return &pb.GetArchiveLiveChatResponse{
 		Status:                  status,
 		ArchiveLiveChatMessages: archiveLiveChatMessages,
+		NextCursor:              nextCursor,
+		Total:                   total,
+	}, nil
+}
+
+func (c *Collector) StopCollectionActiveLiveChat(request *pb.StopCollectionActiveLiveChatRequest) (*pb.StopCollectionActiveLiveChatResponse, error) {
+	status := new(pb.Status)
+	ok := c.cancelRequestedVideoForActiveLiveChat(request.VideoId)
+	if !ok {
+		status.Code = pb.Code_NOT_FOUND
+		status.Message = fmt.Sprintf("not found active live chat collection (videoId = %v)", request.VideoId)
+		return &pb.StopCollectionActiveLiveChatResponse{
+			Status: status,
+		}, nil
+	}
+	c.stopWatchedVideo(request.VideoId)
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (videoId = %v)", request.VideoId)
+	return &pb.StopCollectionActiveLiveChatResponse{
+		Status: status,
+	}, nil
+}
+
+func (c *Collector) StopCollectionArchiveLiveChat(request *pb.StopCollectionArchiveLiveChatRequest) (*pb.StopCollectionArchiveLiveChatResponse, error) {
+	status := new(pb.Status)
+	ok := c.cancelRequestedVideoForArchiveLiveChat(request.VideoId)
+	if !ok {
+		status.Code = pb.Code_NOT_FOUND
+		status.Message = fmt.Sprintf("not found archive live chat collection (videoId = %v)", request.VideoId)
+		return &pb.StopCollectionArchiveLiveChatResponse{
+			Status: status,
+		}, nil
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (videoId = %v)", request.VideoId)
+	return &pb.StopCollectionArchiveLiveChatResponse{
+		Status: status,
+	}, nil
+}
+
+func (c *Collector) ListCollections(request *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	status := new(pb.Status)
+	collections := make([]*pb.Collection, 0)
+	c.requestedVideoForActiveLiveChatMutex.Lock()
+	for _, collectionCtx := range c.requestedVideoForActiveLiveChat {
+		collections = append(collections, collectionCtx.toCollection(pb.CollectionKind_ACTIVE_LIVE_CHAT))
+	}
+	c.requestedVideoForActiveLiveChatMutex.Unlock()
+	c.requestedVideoForArchiveLiveChatMutex.Lock()
+	for _, collectionCtx := range c.requestedVideoForArchiveLiveChat {
+		collections = append(collections, collectionCtx.toCollection(pb.CollectionKind_ARCHIVE_LIVE_CHAT))
+	}
+	c.requestedVideoForArchiveLiveChatMutex.Unlock()
+	status.Code = pb.Code_SUCCESS
+	status.Message = "success"
+	return &pb.ListCollectionsResponse{
+		Status:      status,
+		Collections: collections,
+	}, nil
+}
+
+func (c *Collector) GetApiKeyUsage(request *pb.GetApiKeyUsageRequest) (*pb.GetApiKeyUsageResponse, error) {
+	status := new(pb.Status)
+	apiKeyUsages := make([]*pb.ApiKeyUsage, 0)
+	usages := make([]*youtubehelper.ApiKeyUsage, 0)
+	if apiKeyUsageSource, ok := c.chatSource.(youtubehelper.ApiKeyUsageSource); ok {
+		usages = apiKeyUsageSource.GetApiKeyUsage()
+	}
+	for _, usage := range usages {
+		apiKeyUsages = append(apiKeyUsages, &pb.ApiKeyUsage{
+			Index:              int32(usage.Index),
+			MaskedApiKey:       usage.MaskedApiKey,
+			UsedUnits:          usage.UsedUnits,
+			QuotaUnits:         usage.QuotaUnits,
+			Requests:           usage.Requests,
+			QuotaExceededCount: usage.QuotaExceededCount,
+			Exhausted:          usage.Exhausted,
+			Current:            usage.Current,
+			LastError:          usage.LastError,
+			ResetAt:            usage.ResetAt.Format(time.RFC3339),
+		})
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = "success"
+	return &pb.GetApiKeyUsageResponse{
+		Status:       status,
+		ApiKeyUsages: apiKeyUsages,
 	}, nil
 }
 
@@ -647,6 +1592,57 @@ func (c *Collector) UnsubscribeActiveLiveChat(subscribeActiveLiveChatParams *sub
 	c.unsubscribeActiveLiveChatCh <- subscribeActiveLiveChatParams
 }
 
+func (c *Collector) SubscribeArchiveLiveChatProgress(videoId string) (*subscribeArchiveLiveChatProgressParams, error) {
+	subscribeArchiveLiveChatProgressParams := &subscribeArchiveLiveChatProgressParams{
+		videoId:      videoId,
+		subscriberCh: make(chan *pb.PollArchiveLiveChatProgressResponse, 16),
+	}
+	c.archiveLiveChatProgressMutex.Lock()
+	defer c.archiveLiveChatProgressMutex.Unlock()
+	collectionCtx, ok := c.getRequestedVideoForArchiveLiveChat(videoId)
+	if ok {
+		videoSubscribers, ok := c.archiveLiveChatProgressSubscribers[videoId]
+		if !ok {
+			videoSubscribers = make(map[chan *pb.PollArchiveLiveChatProgressResponse]bool)
+			c.archiveLiveChatProgressSubscribers[videoId] = videoSubscribers
+		}
+		videoSubscribers[subscribeArchiveLiveChatProgressParams.subscriberCh] = true
+		subscribeArchiveLiveChatProgressParams.subscriberCh <- &pb.PollArchiveLiveChatProgressResponse{
+			Status: &pb.Status{
+				Code:    pb.Code_SUCCESS,
+				Message: fmt.Sprintf("success (videoId = %v)", videoId),
+			},
+			Progress: collectionCtx.toArchiveLiveChatProgress(),
+		}
+		return subscribeArchiveLiveChatProgressParams, nil
+	}
+	result, ok := c.archiveLiveChatProgressResults[videoId]
+	if !ok {
+		return nil, fmt.Errorf("no requested (videoId = %v)", videoId)
+	}
+	subscribeArchiveLiveChatProgressParams.subscriberCh <- result.response
+	close(subscribeArchiveLiveChatProgressParams.subscriberCh)
+	return subscribeArchiveLiveChatProgressParams, nil
+}
+
+func (c *Collector) UnsubscribeArchiveLiveChatProgress(subscribeArchiveLiveChatProgressParams *subscribeArchiveLiveChatProgressParams) {
+	c.archiveLiveChatProgressMutex.Lock()
+	defer c.archiveLiveChatProgressMutex.Unlock()
+	videoSubscribers, ok := c.archiveLiveChatProgressSubscribers[subscribeArchiveLiveChatProgressParams.videoId]
+	if !ok {
+		return
+	}
+	_, ok = videoSubscribers[subscribeArchiveLiveChatProgressParams.subscriberCh]
+	if !ok {
+		return
+	}
+	delete(videoSubscribers, subscribeArchiveLiveChatProgressParams.subscriberCh)
+	close(subscribeArchiveLiveChatProgressParams.subscriberCh)
+	if len(videoSubscribers) == 0 {
+		delete(c.archiveLiveChatProgressSubscribers, subscribeArchiveLiveChatProgressParams.videoId)
+	}
+}
+
 func (c *Collector) discardActiveLiveChatUntilClosed(subscribeActiveLiveChatParams *subscribeActiveLiveChatParams) {
 	// This is workaround of publisher blocking in case client closing
 	// XXX refactor
@@ -668,6 +1664,7 @@ func (c *Collector) publisher() {
 			err := publishActiveLiveChatMessagesParams.err
 			videoId := publishActiveLiveChatMessagesParams.videoId
 			activeLiveChatMessages := publishActiveLiveChatMessagesParams.activeLiveChatMessages
+			collectionStatus := publishActiveLiveChatMessagesParams.collectionStatus
 			videoSubscribers, ok := activeLiveChatSubscribers[videoId]
 			if !ok {
 				if c.verbose {
@@ -676,7 +1673,23 @@ func (c *Collector) publisher() {
 				break
 			}
 			if err != nil {
+				status := &pb.Status{
+					Code:    pb.Code_SUCCESS,
+					Message: fmt.Sprintf("finished (videoId = %v)", videoId),
+				}
+				if errors.Is(err, context.Canceled) {
+					status.Message = fmt.Sprintf("stopped (videoId = %v)", videoId)
+				} else if err != io.EOF {
+					status.Code = pb.Code_INTERNAL_ERROR
+					status.Message = fmt.Sprintf("%v (videoId = %v)", err, videoId)
+				}
 				for subscriberCh := range videoSubscribers {
+					if collectionStatus != nil {
+						subscriberCh <- &pb.PollActiveLiveChatResponse{
+							Status:           status,
+							CollectionStatus: collectionStatus,
+						}
+					}
 					delete(videoSubscribers, subscriberCh)
 					close(subscriberCh)
 				}
@@ -690,16 +1703,19 @@ func (c *Collector) publisher() {
 						Message: fmt.Sprintf("success (vidoeId = %v)", videoId),
 					},
 					ActiveLiveChatMessages: activeLiveChatMessages,
+					CollectionStatus:       collectionStatus,
 				}
 			}
 		case subscribeActiveLiveChatParams := <-c.subscribeActiveLiveChatCh:
 			videoId := subscribeActiveLiveChatParams.videoId
 			subscriberCh := subscribeActiveLiveChatParams.subscriberCh
+			c.disarmAutoStopForActiveLiveChat(videoId)
 			_, ok := activeLiveChatSubscribers[videoId]
 			if !ok {
 				videoSubscribers := make(map[chan *pb.PollActiveLiveChatResponse]bool)
 				videoSubscribers[subscriberCh] = true
 				activeLiveChatSubscribers[videoId] = videoSubscribers
+				c.updateSubscriberCountForActiveLiveChat(videoId, len(videoSubscribers))
 				break
 			}
 			_, ok = activeLiveChatSubscribers[videoId][subscriberCh]
@@ -710,6 +1726,7 @@ func (c *Collector) publisher() {
 				}
 			}
 			activeLiveChatSubscribers[videoId][subscriberCh] = true
+			c.updateSubscriberCountForActiveLiveChat(videoId, len(activeLiveChatSubscribers[videoId]))
 		case subscribeActiveLiveChatParams := <-c.unsubscribeActiveLiveChatCh:
 			videoId := subscribeActiveLiveChatParams.videoId
 			subscriberCh := subscribeActiveLiveChatParams.subscriberCh
@@ -729,8 +1746,10 @@ func (c *Collector) publisher() {
 			}
 			delete(videoSubscribers, subscriberCh)
 			close(subscriberCh)
+			c.updateSubscriberCountForActiveLiveChat(videoId, len(videoSubscribers))
 			if len(videoSubscribers) == 0 {
 				delete(activeLiveChatSubscribers, videoId)
+				c.armAutoStopForActiveLiveChat(videoId)
 			}
 		case <-c.publisherFinishRequestCh:
 			goto LAST
@@ -743,23 +1762,11 @@ LAST:
 func (c *Collector) cleaner() {
 	for {
 		select {
-		case <-time.After(3600 * time.Second):
-			lastUpdate := time.Now().Unix() - (3600 * 24)
-			if err := c.dbOperator.DeleteVideoByLastUpdate(int(lastUpdate)); err != nil {
-				if c.verbose {
-					log.Printf("can not delete old video.")
-				}
-			}
-			if err := c.dbOperator.DeleteActiveLiveChatMessagesByLastUpdate(int(lastUpdate)); err != nil {
-				if c.verbose {
-					log.Printf("can not delete active live chat messages.")
-				}
-			}
-			if err := c.dbOperator.DeleteArchiveLiveChatMessagesByLastUpdate(int(lastUpdate)); err != nil {
-				if c.verbose {
-					log.Printf("can not delete archive live chat message.")
-				}
-			}
+		case <-time.After(c.cleanInterval):
+			cleanerReport := c.clean()
+			c.logCleanerReport(cleanerReport)
+			c.addCleanerReport(cleanerReport)
+			c.deleteArchiveLiveChatProgressResultsByFinishedAt(time.Now().Add(-defaultRetention))
 		case <-c.cleanerFinishRequestCh:
 			goto LAST
 		}
@@ -774,6 +1781,13 @@ func (c *Collector) Start() error {
 	}
 	go c.publisher()
 	go c.cleaner()
+	if err := c.resumeActiveLiveChatCollections(); err != nil {
+		log.Printf("can not resume active live chat collections: %v", err)
+	}
+	if err := c.loadWatchedChannels(); err != nil {
+		log.Printf("can not load watched channels: %v", err)
+	}
+	go c.watcher()
 	return nil
 }
 
@@ -782,6 +1796,8 @@ func (c *Collector) Stop() {
 	<-c.publisherFinishResponseCh
 	close(c.cleanerFinishRequestCh)
 	<-c.cleanerFinishResponseCh
+	close(c.watcherFinishRequestCh)
+	<-c.watcherFinishResponseCh
 }
 
 func NewCollector(apiKeys []string, databasePath string, opts ...Option) (*Collector, error) {
@@ -792,30 +1808,84 @@ func NewCollector(apiKeys []string, databasePath string, opts ...Option) (*Colle
                 }
 		opt(baseOpts)
 	}
-	if len(apiKeys) < 1 {
+	if len(apiKeys) < 1 && baseOpts.chatSource == nil {
 		return nil, fmt.Errorf("no api key")
 	}
+	if err := baseOpts.retentionPolicy.validate(); err != nil {
+		return nil, fmt.Errorf("invalid retention policy: %w", err)
+	}
 	verboseOpt := Verbose(baseOpts.verbose)
-	databaseOperator, err := NewDatabaseOperator(databasePath, verboseOpt)
-	if err != nil {
-		return nil, fmt.Errorf("can not create database operator: %w", err)
+	storage := baseOpts.storage
+	if storage == nil {
+		newStorage, err := NewStorage(baseOpts.databaseDriver, databasePath, verboseOpt)
+		if err != nil {
+			return nil, fmt.Errorf("can not create database operator: %w", err)
+		}
+		storage = newStorage
 	}
 	ythVerboseOpt := youtubehelper.Verbose(baseOpts.verbose)
+	ythQuotaUnitsPerApiKeyOpt := youtubehelper.QuotaUnitsPerApiKey(baseOpts.quotaUnitsPerApiKey)
+	ythBaseUrlOpt := youtubehelper.BaseUrl(baseOpts.youtubeBaseUrl)
+	ythApiEndpointOpt := youtubehelper.ApiEndpoint(baseOpts.apiEndpoint)
+	var transport http.RoundTripper
+	if baseOpts.httpReplayDir != "" {
+		replayTransport, err := youtubehelper.NewReplayTransport(baseOpts.httpReplayDir, ythVerboseOpt)
+		if err != nil {
+			return nil, fmt.Errorf("can not create replay transport: %w", err)
+		}
+		transport = replayTransport
+	} else if baseOpts.httpRecordDir != "" {
+		recordTransport, err := youtubehelper.NewRecordTransport(baseOpts.httpRecordDir, nil, ythVerboseOpt)
+		if err != nil {
+			return nil, fmt.Errorf("can not create record transport: %w", err)
+		}
+		transport = recordTransport
+	}
+	ythTransportOpt := youtubehelper.Transport(transport)
+	chatSource := baseOpts.chatSource
+	if chatSource == nil {
+		chatSource = youtubehelper.NewYoutubeChatSource(apiKeys, ythVerboseOpt, ythQuotaUnitsPerApiKeyOpt, ythApiEndpointOpt, ythBaseUrlOpt, ythTransportOpt)
+	}
+	innertubeSource := baseOpts.innertubeSource
+	if innertubeSource == nil {
+		innertubeSource = youtubehelper.NewInnertubeLiveChatCollector(ythVerboseOpt, ythBaseUrlOpt, ythTransportOpt)
+	}
 	return &Collector{
 		verbose:                               baseOpts.verbose,
-		dbOperator:                            databaseOperator,
+		dbOperator:                            storage,
 		requestedVideoForActiveLiveChatMutex:  new(sync.Mutex),
-		requestedVideoForActiveLiveChat:       make(map[string]bool),
+		requestedVideoForActiveLiveChat:       make(map[string]*collectionContext),
 		requestedVideoForArchiveLiveChatMutex: new(sync.Mutex),
-		requestedVideoForArchiveLiveChat:      make(map[string]bool),
+		requestedVideoForArchiveLiveChat:      make(map[string]*collectionContext),
 		publishActiveLiveChatCh:               make(chan *publishActiveLiveChatMessagesParams),
 		subscribeActiveLiveChatCh:             make(chan *subscribeActiveLiveChatParams),
 		unsubscribeActiveLiveChatCh:           make(chan *subscribeActiveLiveChatParams),
 		publisherFinishRequestCh:              make(chan int),
 		publisherFinishResponseCh:             make(chan int),
-		activeLiveChatCollector:               youtubehelper.NewActiveLiveChatCollector(apiKeys[0], ythVerboseOpt),
-		archiveLiveChatCollector:              youtubehelper.NewArchiveLiveChatCollector(ythVerboseOpt),
+		chatSource:                            chatSource,
+		innertubeSource:                       innertubeSource,
+		activeLiveChatBackend:                 baseOpts.backend,
 		cleanerFinishRequestCh:                make(chan int),
 		cleanerFinishResponseCh:               make(chan int),
+		autoStopGracePeriod:                   baseOpts.autoStopGracePeriod,
+		archiveLiveChatProgressMutex:          new(sync.Mutex),
+		archiveLiveChatProgressSubscribers:    make(map[string]map[chan *pb.PollArchiveLiveChatProgressResponse]bool),
+		archiveLiveChatProgressResults:        make(map[string]*archiveLiveChatProgressResult),
+		requestedReplayMutex:                  new(sync.Mutex),
+		requestedReplay:                       make(map[string]*replayContext),
+		watchedChannelsMutex:                  new(sync.Mutex),
+		watchedChannels:                       make(map[string]*watchedChannel),
+		watcherFinishRequestCh:                make(chan int),
+		watcherFinishResponseCh:               make(chan int),
+		watchChannelIds:                       baseOpts.watchChannelIds,
+		watchInterval:                         baseOpts.watchInterval,
+		discoveryInterval:                     baseOpts.discoveryInterval,
+		leadTime:                              baseOpts.leadTime,
+		archiveDelay:                          baseOpts.archiveDelay,
+		retentionPolicy:                       baseOpts.retentionPolicy,
+		cleanInterval:                         baseOpts.cleanInterval,
+		cleanerReportsMutex:                   new(sync.Mutex),
+		cleanerReports:                        make([]*pb.CleanerReport, 0),
+		instanceId:                            baseOpts.instanceId,
 	}, nil
 }
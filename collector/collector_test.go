@@ -0,0 +1,269 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package collector
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper/fakeyoutube"
+	"google.golang.org/api/youtube/v3"
+)
+
+const (
+	fakeVideoId          = "fakeVideo01"
+	fakeChannelId        = "UCfakeChannel0000000001"
+	fakeLiveChatId       = "fakeLiveChat01"
+	fakePollingInterval  = 300 * time.Millisecond
+	fakeCollectorTimeout = 10 * time.Second
+)
+
+func newFakeYoutubeCollector(t *testing.T, fakeServer *fakeyoutube.Server) *Collector {
+	t.Helper()
+	c, err := NewCollector(
+		[]string{"fakeApiKey0000000001", "fakeApiKey0000000002"},
+		filepath.Join(t.TempDir(), "ylcc.db"),
+		ApiEndpoint(fakeServer.Endpoint()),
+	)
+	if err != nil {
+		t.Fatalf("can not create collector: %v", err)
+	}
+	if err := c.Start(); err != nil {
+		t.Fatalf("can not start collector: %v", err)
+	}
+	t.Cleanup(c.Stop)
+	return c
+}
+
+type receivedActiveLiveChat struct {
+	receivedAt time.Time
+	response   *pb.PollActiveLiveChatResponse
+}
+
+// TestCollectActiveLiveChatFromFakeYoutube runs StartCollectionActiveLiveChat, publisher and subscriber of PollActiveLiveChat against fakeyoutube
+func TestCollectActiveLiveChatFromFakeYoutube(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	fakeServer.AddVideo(fakeyoutube.NewLiveVideo(fakeVideoId, fakeChannelId, "fake live", fakeLiveChatId))
+	c := newFakeYoutubeCollector(t, fakeServer)
+
+	// quota error of the first api key makes the pool rotate to the second api key
+	fakeServer.InjectError(fakeyoutube.QuotaExceededError(), 1)
+	startResponse, err := c.StartCollectionActiveLiveChat(&pb.StartCollectionActiveLiveChatRequest{
+		VideoId: fakeVideoId,
+	})
+	if err != nil {
+		t.Fatalf("can not start collection: %v", err)
+	}
+	if startResponse.Status.Code != pb.Code_SUCCESS {
+		t.Fatalf("start collection status = %v, want SUCCESS: %v", startResponse.Status.Code, startResponse.Status.Message)
+	}
+	subscribeParams, err := c.SubscribeActiveLiveChat(fakeVideoId)
+	if err != nil {
+		t.Fatalf("can not subscribe: %v", err)
+	}
+	defer c.UnsubscribeActiveLiveChat(subscribeParams)
+
+	// pages are added after subscription, so no message is published before it
+	fakeServer.AddLiveChatPage(fakeLiveChatId, &fakeyoutube.LiveChatPage{
+		Messages: []*youtube.LiveChatMessage{
+			fakeyoutube.NewTextMessage("fakeMessage01", fakeLiveChatId, "UCfakeViewer00000000001", "viewer1", "hello"),
+			fakeyoutube.NewTextMessage("fakeMessage02", fakeLiveChatId, "UCfakeViewer00000000002", "viewer2", "world"),
+		},
+		PollingIntervalMillis: fakePollingInterval.Milliseconds(),
+	})
+	fakeServer.AddLiveChatPage(fakeLiveChatId, &fakeyoutube.LiveChatPage{
+		Messages: []*youtube.LiveChatMessage{
+			fakeyoutube.NewSuperChatMessage("fakeMessage03", fakeLiveChatId, "UCfakeViewer00000000003", "viewer3", "thanks", 5000000, "USD", "$5.00"),
+		},
+		PollingIntervalMillis: fakePollingInterval.Milliseconds(),
+	})
+	fakeServer.AddLiveChatPage(fakeLiveChatId, &fakeyoutube.LiveChatPage{
+		Messages: []*youtube.LiveChatMessage{
+			fakeyoutube.NewChatEndedMessage("fakeMessage04", fakeLiveChatId),
+		},
+	})
+	fakeServer.EndLiveChat(fakeLiveChatId)
+
+	received := make([]*receivedActiveLiveChat, 0)
+	timer := time.NewTimer(fakeCollectorTimeout)
+	defer timer.Stop()
+	for finished := false; !finished; {
+		select {
+		case response, ok := <-subscribeParams.GetSubscriberCh():
+			if !ok {
+				finished = true
+				break
+			}
+			received = append(received, &receivedActiveLiveChat{
+				receivedAt: time.Now(),
+				response:   response,
+			})
+		case <-timer.C:
+			t.Fatalf("collection is not finished in %v", fakeCollectorTimeout)
+		}
+	}
+
+	// pagination
+	messages := make([]*pb.ActiveLiveChatMessage, 0)
+	receivedAtByMessageId := make(map[string]time.Time)
+	for _, r := range received {
+		for _, message := range r.response.ActiveLiveChatMessages {
+			messages = append(messages, message)
+			receivedAtByMessageId[message.MessageId] = r.receivedAt
+		}
+	}
+	wantMessageIds := []string{"fakeMessage01", "fakeMessage02", "fakeMessage03", "fakeMessage04"}
+	if len(messages) != len(wantMessageIds) {
+		t.Fatalf("count of messages = %v, want %v", len(messages), len(wantMessageIds))
+	}
+	for i, message := range messages {
+		if message.MessageId != wantMessageIds[i] {
+			t.Errorf("messages[%v].MessageId = %v, want %v", i, message.MessageId, wantMessageIds[i])
+		}
+		if message.VideoId != fakeVideoId || message.ChannelId != fakeChannelId {
+			t.Errorf("messages[%v] has videoId = %v and channelId = %v", i, message.VideoId, message.ChannelId)
+		}
+	}
+
+	// pollingIntervalMillis of a page delays request of the next page
+	if interval := receivedAtByMessageId["fakeMessage03"].Sub(receivedAtByMessageId["fakeMessage01"]); interval < fakePollingInterval*9/10 {
+		t.Errorf("interval of pages = %v, want >= %v", interval, fakePollingInterval)
+	}
+
+	// superchat
+	superChat := messages[2]
+	if superChat.EventType != pb.ActiveLiveChatEventType_SUPER_CHAT_EVENT || !superChat.IsSuperChat ||
+		superChat.AmountMicros != "5000000" || superChat.Currency != "USD" || superChat.AmountDisplayString != "$5.00" || superChat.DisplayMessage != "thanks" {
+		t.Errorf("unexpected superchat: %+v", superChat)
+	}
+
+	// chat end
+	if messages[3].EventType != pb.ActiveLiveChatEventType_CHAT_ENDED_EVENT {
+		t.Errorf("event type of last message = %v, want CHAT_ENDED_EVENT", messages[3].EventType)
+	}
+	last := received[len(received)-1].response
+	if last.CollectionStatus == nil || last.CollectionStatus.State != pb.CollectionState_FINISHED {
+		t.Errorf("last collection status = %+v, want FINISHED", last.CollectionStatus)
+	}
+	if c.checkRequestedVideoForActiveLiveChat(fakeVideoId) {
+		t.Errorf("collection is still registered after chat end")
+	}
+	activeLiveChatCollections, err := c.dbOperator.GetActiveLiveChatCollections(c.instanceId)
+	if err != nil {
+		t.Fatalf("can not get active live chat collections: %v", err)
+	}
+	if len(activeLiveChatCollections) != 0 {
+		t.Errorf("active live chat collections are left after chat end: %+v", activeLiveChatCollections)
+	}
+	total, err := c.dbOperator.CountActiveLiveChatMessages(&LiveChatPageCondition{VideoId: fakeVideoId})
+	if err != nil {
+		t.Fatalf("can not count stored messages: %v", err)
+	}
+	if total != int64(len(wantMessageIds)) {
+		t.Errorf("count of stored messages = %v, want %v", total, len(wantMessageIds))
+	}
+
+	// quota error rotation
+	usageResponse, err := c.GetApiKeyUsage(&pb.GetApiKeyUsageRequest{})
+	if err != nil {
+		t.Fatalf("can not get api key usage: %v", err)
+	}
+	if len(usageResponse.ApiKeyUsages) != 2 {
+		t.Fatalf("count of api key usages = %v, want 2", len(usageResponse.ApiKeyUsages))
+	}
+	exhausted := usageResponse.ApiKeyUsages[0]
+	if !exhausted.Exhausted || exhausted.QuotaExceededCount != 1 || exhausted.Current {
+		t.Errorf("first api key is not exhausted by quota error: %+v", exhausted)
+	}
+	current := usageResponse.ApiKeyUsages[1]
+	if current.Exhausted || !current.Current || current.Requests == 0 {
+		t.Errorf("second api key is not used after rotation: %+v", current)
+	}
+}
+
+func TestGetCachedActiveLiveChatCountsTotalOnlyWhenRequested(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	c := newFakeYoutubeCollector(t, fakeServer)
+	activeLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0)
+	for _, messageId := range []string{"fakeMessage01", "fakeMessage02", "fakeMessage03"} {
+		activeLiveChatMessages = append(activeLiveChatMessages, &pb.ActiveLiveChatMessage{
+			MessageId:   messageId,
+			ChannelId:   fakeChannelId,
+			VideoId:     fakeVideoId,
+			PublishedAt: "2022-01-01T00:00:00.5Z",
+		})
+	}
+	if _, err := c.dbOperator.UpdateActiveLiveChatMessages(activeLiveChatMessages); err != nil {
+		t.Fatalf("can not store messages: %v", err)
+	}
+
+	response, err := c.GetCachedActiveLiveChat(&pb.GetCachedActiveLiveChatRequest{
+		VideoId: fakeVideoId,
+		Count:   2,
+	})
+	if err != nil {
+		t.Fatalf("can not get cached active live chat: %v", err)
+	}
+	if response.Status.Code != pb.Code_SUCCESS || len(response.ActiveLiveChatMessages) != 2 || response.Total != -1 || response.NextCursor == "" {
+		t.Fatalf("unexpected first page: %+v", response)
+	}
+	response, err = c.GetCachedActiveLiveChat(&pb.GetCachedActiveLiveChatRequest{
+		VideoId:   fakeVideoId,
+		Count:     2,
+		Cursor:    response.NextCursor,
+		WithTotal: true,
+	})
+	if err != nil {
+		t.Fatalf("can not get cached active live chat: %v", err)
+	}
+	if response.Status.Code != pb.Code_SUCCESS || len(response.ActiveLiveChatMessages) != 1 || response.ActiveLiveChatMessages[0].MessageId != "fakeMessage03" ||
+		response.Total != 3 || response.NextCursor != "" {
+		t.Errorf("unexpected last page: %+v", response)
+	}
+}
+
+func TestAutoStopActiveLiveChatWithoutSubscriber(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	fakeServer.AddVideo(fakeyoutube.NewLiveVideo(fakeVideoId, fakeChannelId, "fake live", fakeLiveChatId))
+	fakeServer.AddVideo(fakeyoutube.NewLiveVideo("fakeVideo02", fakeChannelId, "fake live", "fakeLiveChat02"))
+	c := newFakeYoutubeCollector(t, fakeServer)
+	for _, videoId := range []string{fakeVideoId, "fakeVideo02"} {
+		startResponse, err := c.StartCollectionActiveLiveChat(&pb.StartCollectionActiveLiveChatRequest{
+			VideoId:             videoId,
+			AutoStopGracePeriod: 1,
+		})
+		if err != nil || startResponse.Status.Code != pb.Code_SUCCESS {
+			t.Fatalf("can not start collection: %v, %+v", err, startResponse)
+		}
+	}
+	subscribeParams, err := c.SubscribeActiveLiveChat("fakeVideo02")
+	if err != nil {
+		t.Fatalf("can not subscribe: %v", err)
+	}
+	defer c.UnsubscribeActiveLiveChat(subscribeParams)
+	go func() {
+		for range subscribeParams.GetSubscriberCh() {
+		}
+	}()
+
+	// collection which is never subscribed is stopped after grace period
+	deadline := time.Now().Add(fakeCollectorTimeout)
+	for c.checkRequestedVideoForActiveLiveChat(fakeVideoId) {
+		if time.Now().After(deadline) {
+			t.Fatalf("collection without subscriber is not stopped in %v", fakeCollectorTimeout)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !c.checkRequestedVideoForActiveLiveChat("fakeVideo02") {
+		t.Errorf("subscribed collection is stopped")
+	}
+}
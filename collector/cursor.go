@@ -0,0 +1,36 @@
+package collector
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// encodeLiveChatCursor makes opaque cursor which points after the message
+func encodeLiveChatCursor(position int64, messageId string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(position, 10) + ":" + messageId))
+}
+
+// decodeLiveChatCursor sets position and messageId of cursor to condition, empty cursor is ignored
+func decodeLiveChatCursor(cursor string, condition *LiveChatPageCondition) error {
+	if cursor == "" {
+		return nil
+	}
+	decoded, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return fmt.Errorf("invalid cursor (cursor = %v): %w", cursor, err)
+	}
+	// messageId may contain colon, position does not
+	elems := strings.SplitN(string(decoded), ":", 2)
+	if len(elems) != 2 || elems[1] == "" {
+		return fmt.Errorf("invalid cursor (cursor = %v)", cursor)
+	}
+	position, err := strconv.ParseInt(elems[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid position of cursor (cursor = %v): %w", cursor, err)
+	}
+	condition.AfterPosition = position
+	condition.AfterMessageId = elems[1]
+	return nil
+}
@@ -2,23 +2,298 @@ package collector
 
 import (
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	_ "github.com/mattn/go-sqlite3"
 	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+const (
+	trigramLength = 3
+	// position of live chat message is unix time in microseconds
+	positionsPerSecond int64 = 1000000
+	// placeholders of message ids in a query are limited because of limit of variables of sqlite
+	moderationMessageIdsMax = 500
+)
+
+type ActiveLiveChatCollection struct {
+	VideoId          string
+	ActiveLiveChatId string
+	PageToken        string
+	StartedAt        int64
+	Backend          pb.ActiveLiveChatBackend
+}
+
+type WatchedChannel struct {
+	ChannelId string
+	Backend   pb.ActiveLiveChatBackend
+}
+
+type RetentionTarget struct {
+	VideoId   string
+	ChannelId string
+}
+
+// LiveChatSearchCondition is condition of searching live chat messages, empty field is not used
+type LiveChatSearchCondition struct {
+	// message has to contain all terms
+	Terms     []string
+	ChannelId string
+	VideoId   string
+	// channelId or display name of author
+	Author string
+	// unix time in seconds
+	Since    int64
+	Until    int64
+	PaidOnly bool
+	Target   pb.Target
+	Offset   int64
+	Count    int64
+}
+
+type SearchedActiveLiveChatMessage struct {
+	ActiveLiveChatMessage *pb.ActiveLiveChatMessage
+	// higher is more relevant
+	Rank float64
+}
+
+type SearchedArchiveLiveChatMessage struct {
+	ArchiveLiveChatMessage *pb.ArchiveLiveChatMessage
+	// higher is more relevant
+	Rank float64
+}
+
+// LiveChatPageCondition is condition of paging live chat messages in order of position and messageId, empty field is not used
+type LiveChatPageCondition struct {
+	ChannelId string
+	VideoId   string
+	// unix time in seconds
+	Since int64
+	Until int64
+	// messages after the pair of position and messageId are returned, it is not used when AfterMessageId is empty
+	AfterPosition  int64
+	AfterMessageId string
+	Offset         int64
+	Count          int64
+}
+
+type PagedActiveLiveChatMessage struct {
+	ActiveLiveChatMessage *pb.ActiveLiveChatMessage
+	// unix time in microseconds of publishedAt
+	Position int64
+}
+
+type PagedArchiveLiveChatMessage struct {
+	ArchiveLiveChatMessage *pb.ArchiveLiveChatMessage
+	// timestampUsec
+	Position int64
+}
+
+// LiveChatStatisticsCondition is condition of statistics of live chat messages, empty field is not used
+type LiveChatStatisticsCondition struct {
+	ChannelId string
+	VideoId   string
+	// unix time in seconds
+	Since           int64
+	Until           int64
+	TopAuthorsCount int64
+}
+
+// queryer is *sql.DB or *sql.Tx
+type queryer interface {
+	Query(query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// dialect absorbs differences of sql between database drivers
+type dialect interface {
+	driverName() string
+	// rebind converts ? placeholders of query to placeholders of the driver
+	rebind(query string) string
+	convertArgs(args []interface{}) []interface{}
+	columnExists(q queryer, table string, column string) (bool, error)
+	// lockTableQuery returns query which locks table until end of transaction, empty when the database locks itself
+	lockTableQuery(table string) string
+	// unixTime converts rfc3339 expression to unix time in seconds
+	unixTime(expression string) string
+	fullTextSearchIndexQueries(table string, column string) []string
+	// fullTextSearch searches terms in column of table which is aliased as m
+	fullTextSearch(table string, column string, terms []string) *fullTextSearch
+}
+
+// fullTextSearch is from clause, condition and rank expression of full text search
+type fullTextSearch struct {
+	from          string
+	condition     string
+	conditionArgs []interface{}
+	// higher is more relevant
+	rank     string
+	rankArgs []interface{}
+}
+
+func escapeLike(term string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(term)
+}
+
+// likeSearch is full text search without index, it is used when index can not match terms
+func likeSearch(table string, column string, terms []string, operator string) *fullTextSearch {
+	conditions := make([]string, 0, len(terms))
+	conditionArgs := make([]interface{}, 0, len(terms))
+	for _, term := range terms {
+		conditions = append(conditions, fmt.Sprintf(`m.%v %v ? ESCAPE '\'`, column, operator))
+		conditionArgs = append(conditionArgs, "%"+escapeLike(term)+"%")
+	}
+	return &fullTextSearch{
+		from:          table + " m",
+		condition:     strings.Join(conditions, " AND "),
+		conditionArgs: conditionArgs,
+		rank:          "0",
+		rankArgs:      nil,
+	}
+}
+
+type sqliteDialect struct{}
+
+func (s *sqliteDialect) driverName() string {
+	return "sqlite3"
+}
+
+func (s *sqliteDialect) rebind(query string) string {
+	return query
+}
+
+func (s *sqliteDialect) convertArgs(args []interface{}) []interface{} {
+	return args
+}
+
+func (s *sqliteDialect) columnExists(q queryer, table string, column string) (bool, error) {
+	rows, err := q.Query(fmt.Sprintf(`PRAGMA table_info(%v)`, table))
+	if err != nil {
+		return false, fmt.Errorf("can not get table info of %v: %w", table, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var cid int
+		var name string
+		var columnType string
+		var notNull int
+		var defaultValue sql.NullString
+		var primaryKey int
+		if err := rows.Scan(&cid, &name, &columnType, &notNull, &defaultValue, &primaryKey); err != nil {
+			return false, fmt.Errorf("can not scan table info of %v: %w", table, err)
+		}
+		if name == column {
+			return true, nil
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return false, fmt.Errorf("can not iterate table info of %v: %w", table, err)
+	}
+	return false, nil
+}
+
+// lockTableQuery returns no query, because sqlite locks whole database while transaction writes
+func (s *sqliteDialect) lockTableQuery(table string) string {
+	return ""
+}
+
+func (s *sqliteDialect) unixTime(expression string) string {
+	return fmt.Sprintf(`CAST(strftime('%%s', %v) AS BIGINT)`, expression)
+}
+
+// fullTextSearchIndexQueries creates fts5 table which is synchronized with table by triggers,
+// trigram tokenizer is used because words of japanese are not separated by spaces
+func (s *sqliteDialect) fullTextSearchIndexQueries(table string, column string) []string {
+	ftsTable := table + "Fts"
+	return []string{
+		fmt.Sprintf(`CREATE VIRTUAL TABLE IF NOT EXISTS %v USING fts5(%v, content='%v', content_rowid='rowid', tokenize='trigram')`,
+			ftsTable, column, table),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %vInsert AFTER INSERT ON %v BEGIN
+		    INSERT INTO %v(rowid, %v) VALUES (new.rowid, new.%v);
+		END`, ftsTable, table, ftsTable, column, column),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %vDelete AFTER DELETE ON %v BEGIN
+		    INSERT INTO %v(%v, rowid, %v) VALUES ('delete', old.rowid, old.%v);
+		END`, ftsTable, table, ftsTable, ftsTable, column, column),
+		fmt.Sprintf(`CREATE TRIGGER IF NOT EXISTS %vUpdate AFTER UPDATE OF %v ON %v BEGIN
+		    INSERT INTO %v(%v, rowid, %v) VALUES ('delete', old.rowid, old.%v);
+		    INSERT INTO %v(rowid, %v) VALUES (new.rowid, new.%v);
+		END`, ftsTable, column, table, ftsTable, ftsTable, column, column, ftsTable, column, column),
+		// index rows stored before fts table is created
+		fmt.Sprintf(`INSERT INTO %v(%v) VALUES ('rebuild')`, ftsTable, ftsTable),
+	}
+}
+
+func (s *sqliteDialect) fullTextSearch(table string, column string, terms []string) *fullTextSearch {
+	for _, term := range terms {
+		if utf8.RuneCountInString(term) < trigramLength {
+			// trigram tokenizer can not match term shorter than trigram
+			return likeSearch(table, column, terms, "LIKE")
+		}
+	}
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		phrases = append(phrases, `"`+strings.ReplaceAll(term, `"`, `""`)+`"`)
+	}
+	ftsTable := table + "Fts"
+	return &fullTextSearch{
+		from:          fmt.Sprintf(`%v JOIN %v m ON m.rowid = %v.rowid`, ftsTable, table, ftsTable),
+		condition:     ftsTable + ` MATCH ?`,
+		conditionArgs: []interface{}{strings.Join(phrases, " ")},
+		// bm25 is lower when it is more relevant
+		rank:     fmt.Sprintf(`-bm25(%v)`, ftsTable),
+		rankArgs: nil,
+	}
+}
+
+// DatabaseOperator is Storage of sql database, it is used for both sqlite and postgres
 type DatabaseOperator struct {
-	verbose      bool
-	databasePath string
-	db           *sql.DB
+	verbose        bool
+	dialect        dialect
+	dataSourceName string
+	db             *sql.DB
+}
+
+func (d *DatabaseOperator) exec(query string, args ...interface{}) (sql.Result, error) {
+	return d.db.Exec(d.dialect.rebind(query), d.dialect.convertArgs(args)...)
 }
 
+func (d *DatabaseOperator) txExec(tx *sql.Tx, query string, args ...interface{}) (sql.Result, error) {
+	return tx.Exec(d.dialect.rebind(query), d.dialect.convertArgs(args)...)
+}
+
+func (d *DatabaseOperator) query(query string, args ...interface{}) (*sql.Rows, error) {
+	return d.db.Query(d.dialect.rebind(query), d.dialect.convertArgs(args)...)
+}
+
+// columns are listed explicitly because columns added by migrations are placed after lastUpdate
+const videoColumns = `
+		videoId,
+		channelId,
+		categoryId,
+		title,
+		description,
+		publishedAt,
+		duration,
+		activeLiveChatId,
+		actualStartTime,
+		actualEndTime,
+		scheduledStartTime,
+		scheduledEndTime,
+		privacyStatus,
+		uploadStatus,
+		embeddable,
+		lastUpdate
+	`
+
 func (d *DatabaseOperator) GetVideoByVideoId(videoId string) (*pb.Video, bool, error) {
-	rows, err := d.db.Query(`SELECT * FROM video WHERE videoId = ?`, videoId)
+	rows, err := d.query(`SELECT `+videoColumns+` FROM video WHERE videoId = ?`, videoId)
 	if err != nil {
 		return nil, false, fmt.Errorf("can not get video by videoId: %w", err)
 	}
@@ -51,9 +326,44 @@ func (d *DatabaseOperator) GetVideoByVideoId(videoId string) (*pb.Video, bool, e
 	return nil, false, nil
 }
 
+func (d *DatabaseOperator) GetVideosByChannelId(channelId string) ([]*pb.Video, error) {
+	videos := make([]*pb.Video, 0)
+	rows, err := d.query(`SELECT `+videoColumns+` FROM video WHERE channelId = ? ORDER BY publishedAt, videoId`, channelId)
+	if err != nil {
+		return nil, fmt.Errorf("can not get videos by channelId: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var lastUpdate int
+		video := &pb.Video{}
+		if err := rows.Scan(
+			&video.VideoId,
+			&video.ChannelId,
+			&video.CategoryId,
+			&video.Title,
+			&video.Description,
+			&video.PublishedAt,
+			&video.Duration,
+			&video.ActiveLiveChatId,
+			&video.ActualStartTime,
+			&video.ActualEndTime,
+			&video.ScheduledStartTime,
+			&video.ScheduledEndTime,
+			&video.PrivacyStatus,
+			&video.UploadStatus,
+			&video.Embeddable,
+			&lastUpdate,
+		); err != nil {
+			return nil, fmt.Errorf("can not scan videos by channelId: %w", err)
+		}
+		videos = append(videos, video)
+	}
+	return videos, nil
+}
+
 func (d *DatabaseOperator) UpdateVideo(video *pb.Video) error {
-	res, err := d.db.Exec(
-		`INSERT OR REPLACE INTO video (
+	res, err := d.exec(
+		`INSERT INTO video (
                 videoId,
                 channelId,
 		categoryId,
@@ -73,7 +383,22 @@ func (d *DatabaseOperator) UpdateVideo(video *pb.Video) error {
             ) VALUES (
                 ?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
                 ?, ?, ?, ?, ?, ?
-            )`,
+            ) ON CONFLICT(videoId) DO UPDATE SET
+                channelId = excluded.channelId,
+                categoryId = excluded.categoryId,
+                title = excluded.title,
+                description = excluded.description,
+                publishedAt = excluded.publishedAt,
+                duration = excluded.duration,
+                activeLiveChatId = excluded.activeLiveChatId,
+                actualStartTime = excluded.actualStartTime,
+                actualEndTime = excluded.actualEndTime,
+                scheduledStartTime = excluded.scheduledStartTime,
+                scheduledEndTime = excluded.scheduledEndTime,
+                privacyStatus = excluded.privacyStatus,
+                uploadStatus = excluded.uploadStatus,
+                embeddable = excluded.embeddable,
+                lastUpdate = excluded.lastUpdate`,
 		video.VideoId,
 		video.ChannelId,
 		video.CategoryId,
@@ -94,18 +419,18 @@ func (d *DatabaseOperator) UpdateVideo(video *pb.Video) error {
 	if err != nil {
 		return fmt.Errorf("can not insert video: %w", err)
 	}
-	id, err := res.LastInsertId()
+	rowsAffected, err := res.RowsAffected()
 	if err != nil {
-		return fmt.Errorf("can not get insert id of video: %w", err)
+		return fmt.Errorf("can not get rowsAffected of video: %w", err)
 	}
 	if d.verbose {
-		log.Printf("update video (videoId = %v, insert id = %v)", video.VideoId, id)
+		log.Printf("update video (videoId = %v, rowsAffected = %v)", video.VideoId, rowsAffected)
 	}
 	return nil
 }
 
 func (d *DatabaseOperator) DeleteVideoByLastUpdate(lastUpdate int) error {
-	res, err := d.db.Exec(`DELETE FROM video WHERE lastUpdate < ?`, lastUpdate)
+	res, err := d.exec(`DELETE FROM video WHERE lastUpdate < ?`, lastUpdate)
 	if err != nil {
 		return fmt.Errorf("can not delete video: %w", err)
 	}
@@ -119,64 +444,213 @@ func (d *DatabaseOperator) DeleteVideoByLastUpdate(lastUpdate int) error {
 	return nil
 }
 
-func (d *DatabaseOperator) GetActiveLiveChatMessagesByVideoIdAndToken(videoId string, offset int64, count int64) ([]*pb.ActiveLiveChatMessage, error) {
-	activeLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0)
-	activeLiveChatMessageRows, err := d.db.Query(`SELECT * FROM activeLiveChatMessage WHERE videoId = ? LIMIT ? OFFSET ?`, videoId, count, offset)
-	if err != nil {
-		return nil, fmt.Errorf("can not get activeLiveChatMessage by videoId and token: %w", err)
+// columns are listed explicitly because columns added by migrations are placed after lastUpdate
+const activeLiveChatMessageColumns = `
+		messageId,
+		channelId,
+		videoId,
+		apiEtag,
+		authorChannelId,
+		authorChannelUrl,
+		authorDisplayName,
+		authorIsChatModerator,
+		authorIsChatOwner,
+		authorIsChatSponsor,
+		authorIsVerified,
+		liveChatId,
+		displayMessage,
+		publishedAt,
+		isSuperChat,
+		isSuperSticker,
+		isFanFundingEvent,
+		amountMicros,
+		amountDisplayString,
+		currency,
+		pageToken,
+		eventType,
+		memberLevelName,
+		memberMonth,
+		isUpgrade,
+		giftMembershipsCount,
+		gifterChannelId,
+		associatedMembershipGiftingMessageId,
+		targetMessageId,
+		bannedUserChannelId,
+		bannedUserDisplayName,
+		banType,
+		banDurationSeconds,
+		isDeleted,
+		isAuthorBanned,
+		lastUpdate
+	`
+
+func (d *DatabaseOperator) scanActiveLiveChatMessage(rows *sql.Rows, dest ...interface{}) (*pb.ActiveLiveChatMessage, error) {
+	var lastUpdate int
+	activeLiveChatMessage := &pb.ActiveLiveChatMessage{}
+	dest = append([]interface{}{
+		&activeLiveChatMessage.MessageId,
+		&activeLiveChatMessage.ChannelId,
+		&activeLiveChatMessage.VideoId,
+		&activeLiveChatMessage.ApiEtag,
+		&activeLiveChatMessage.AuthorChannelId,
+		&activeLiveChatMessage.AuthorChannelUrl,
+		&activeLiveChatMessage.AuthorDisplayName,
+		&activeLiveChatMessage.AuthorIsChatModerator,
+		&activeLiveChatMessage.AuthorIsChatOwner,
+		&activeLiveChatMessage.AuthorIsChatSponsor,
+		&activeLiveChatMessage.AuthorIsVerified,
+		&activeLiveChatMessage.LiveChatId,
+		&activeLiveChatMessage.DisplayMessage,
+		&activeLiveChatMessage.PublishedAt,
+		&activeLiveChatMessage.IsSuperChat,
+		&activeLiveChatMessage.IsSuperSticker,
+		&activeLiveChatMessage.IsFanFundingEvent,
+		&activeLiveChatMessage.AmountMicros,
+		&activeLiveChatMessage.AmountDisplayString,
+		&activeLiveChatMessage.Currency,
+		&activeLiveChatMessage.PageToken,
+		&activeLiveChatMessage.EventType,
+		&activeLiveChatMessage.MemberLevelName,
+		&activeLiveChatMessage.MemberMonth,
+		&activeLiveChatMessage.IsUpgrade,
+		&activeLiveChatMessage.GiftMembershipsCount,
+		&activeLiveChatMessage.GifterChannelId,
+		&activeLiveChatMessage.AssociatedMembershipGiftingMessageId,
+		&activeLiveChatMessage.TargetMessageId,
+		&activeLiveChatMessage.BannedUserChannelId,
+		&activeLiveChatMessage.BannedUserDisplayName,
+		&activeLiveChatMessage.BanType,
+		&activeLiveChatMessage.BanDurationSeconds,
+		&activeLiveChatMessage.IsDeleted,
+		&activeLiveChatMessage.IsAuthorBanned,
+		&lastUpdate,
+	}, dest...)
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
 	}
-	defer activeLiveChatMessageRows.Close()
-	for activeLiveChatMessageRows.Next() {
-		var lastUpdate int
-		activeLiveChatMessage := &pb.ActiveLiveChatMessage{}
-		if err := activeLiveChatMessageRows.Scan(
-			&activeLiveChatMessage.MessageId,
-			&activeLiveChatMessage.ChannelId,
-			&activeLiveChatMessage.VideoId,
-			&activeLiveChatMessage.ApiEtag,
-			&activeLiveChatMessage.AuthorChannelId,
-			&activeLiveChatMessage.AuthorChannelUrl,
-			&activeLiveChatMessage.AuthorDisplayName,
-			&activeLiveChatMessage.AuthorIsChatModerator,
-			&activeLiveChatMessage.AuthorIsChatOwner,
-			&activeLiveChatMessage.AuthorIsChatSponsor,
-			&activeLiveChatMessage.AuthorIsVerified,
-			&activeLiveChatMessage.LiveChatId,
-			&activeLiveChatMessage.DisplayMessage,
-			&activeLiveChatMessage.PublishedAt,
-			&activeLiveChatMessage.IsSuperChat,
-			&activeLiveChatMessage.IsSuperSticker,
-			&activeLiveChatMessage.IsFanFundingEvent,
-			&activeLiveChatMessage.AmountMicros,
-			&activeLiveChatMessage.AmountDisplayString,
-			&activeLiveChatMessage.Currency,
-			&activeLiveChatMessage.PageToken,
-			&lastUpdate,
-		); err != nil {
-			return nil, fmt.Errorf("can not scan activeLiveChatMessage by videoId and token: %w", err)
+	return activeLiveChatMessage, nil
+}
+
+func (d *DatabaseOperator) applyActiveLiveChatModeration(tx *sql.Tx, activeLiveChatMessage *pb.ActiveLiveChatMessage, nowUnix int64) error {
+	switch activeLiveChatMessage.EventType {
+	case pb.ActiveLiveChatEventType_MESSAGE_DELETED_EVENT, pb.ActiveLiveChatEventType_MESSAGE_RETRACTED_EVENT:
+		res, err := d.txExec(tx,
+			`UPDATE activeLiveChatMessage SET isDeleted = 1, lastUpdate = ? WHERE messageId = ?`,
+			nowUnix,
+			activeLiveChatMessage.TargetMessageId,
+		)
+		if err != nil {
+			return fmt.Errorf("can not flag deleted activeLiveChatMessage: %w", err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("can not get rowsAffected of activeLiveChatMessage: %w", err)
+		}
+		if d.verbose {
+			log.Printf("flag deleted activeLiveChatMessage (messageId = %v, rowsAffected = %v)", activeLiveChatMessage.TargetMessageId, rowsAffected)
+		}
+	case pb.ActiveLiveChatEventType_USER_BANNED_EVENT:
+		res, err := d.txExec(tx,
+			`UPDATE activeLiveChatMessage SET isAuthorBanned = 1, lastUpdate = ? WHERE videoId = ? AND authorChannelId = ?`,
+			nowUnix,
+			activeLiveChatMessage.VideoId,
+			activeLiveChatMessage.BannedUserChannelId,
+		)
+		if err != nil {
+			return fmt.Errorf("can not flag banned author of activeLiveChatMessage: %w", err)
+		}
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return fmt.Errorf("can not get rowsAffected of activeLiveChatMessage: %w", err)
+		}
+		if d.verbose {
+			log.Printf("flag banned author of activeLiveChatMessage (authorChannelId = %v, rowsAffected = %v)", activeLiveChatMessage.BannedUserChannelId, rowsAffected)
+		}
+	}
+	return nil
+}
+
+// applyStoredActiveLiveChatModeration flags messages of the batch which were deleted or whose author was banned by stored events,
+// messages can be stored after the events (e.g. later in the same batch, retry or resume)
+func (d *DatabaseOperator) applyStoredActiveLiveChatModeration(tx *sql.Tx, activeLiveChatMessages []*pb.ActiveLiveChatMessage, nowUnix int64) error {
+	messageIdsByVideoId := make(map[string][]interface{})
+	for _, activeLiveChatMessage := range activeLiveChatMessages {
+		messageIdsByVideoId[activeLiveChatMessage.VideoId] = append(messageIdsByVideoId[activeLiveChatMessage.VideoId], activeLiveChatMessage.MessageId)
+	}
+	for videoId, messageIds := range messageIdsByVideoId {
+		for start := 0; start < len(messageIds); start += moderationMessageIdsMax {
+			end := start + moderationMessageIdsMax
+			if end > len(messageIds) {
+				end = len(messageIds)
+			}
+			placeholders := strings.TrimSuffix(strings.Repeat("?, ", end-start), ", ")
+			args := append([]interface{}{nowUnix}, messageIds[start:end]...)
+			args = append(args, videoId, pb.ActiveLiveChatEventType_MESSAGE_DELETED_EVENT, pb.ActiveLiveChatEventType_MESSAGE_RETRACTED_EVENT)
+			if _, err := d.txExec(tx,
+				`UPDATE activeLiveChatMessage SET isDeleted = 1, lastUpdate = ? WHERE messageId IN (`+placeholders+`) AND isDeleted = 0 AND messageId IN (
+			SELECT targetMessageId FROM activeLiveChatMessage WHERE videoId = ? AND eventType IN (?, ?))`,
+				args...,
+			); err != nil {
+				return fmt.Errorf("can not flag deleted activeLiveChatMessage by stored events: %w", err)
+			}
+			args = append([]interface{}{nowUnix}, messageIds[start:end]...)
+			args = append(args, videoId, pb.ActiveLiveChatEventType_USER_BANNED_EVENT)
+			if _, err := d.txExec(tx,
+				`UPDATE activeLiveChatMessage SET isAuthorBanned = 1, lastUpdate = ? WHERE messageId IN (`+placeholders+`) AND isAuthorBanned = 0 AND authorChannelId IN (
+			SELECT bannedUserChannelId FROM activeLiveChatMessage WHERE videoId = ? AND eventType = ?)`,
+				args...,
+			); err != nil {
+				return fmt.Errorf("can not flag banned author of activeLiveChatMessage by stored events: %w", err)
+			}
 		}
-		activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
 	}
-	return activeLiveChatMessages, nil
+	return nil
 }
 
-func (d *DatabaseOperator) UpdateActiveLiveChatMessages(activeLiveChatMessages []*pb.ActiveLiveChatMessage) error {
+func (d *DatabaseOperator) UpdateActiveLiveChatMessages(activeLiveChatMessages []*pb.ActiveLiveChatMessage) ([]*pb.ActiveLiveChatMessage, error) {
 	tx, err := d.db.Begin()
 	if err != nil {
-		return fmt.Errorf("can not start transaction in UpdateActiveLiveChatMessages: %w", err)
+		return nil, fmt.Errorf("can not start transaction in UpdateActiveLiveChatMessages: %w", err)
 	}
 	defer func() {
 		if p := recover(); p != nil {
 			if err := tx.Rollback(); err != nil {
-				log.Fatal("can not rollback of activeLiveChatMessage: %v", err)
+				log.Fatalf("can not rollback of activeLiveChatMessage: %v", err)
 			}
 			panic(p)
 		}
 	}()
 	nowUnix := time.Now().Unix()
+	// position is assigned only when message is inserted, so messages stored again keep their order
+	storedMessageIds, err := d.storedActiveLiveChatMessageIds(tx, activeLiveChatMessages)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
+		}
+		return nil, err
+	}
+	lastPositions := make(map[string]int64)
+	insertedActiveLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0, len(activeLiveChatMessages))
 	for _, activeLiveChatMessage := range activeLiveChatMessages {
-		res, err := tx.Exec(
-			`INSERT OR REPLACE INTO activeLiveChatMessage (
+		lastPosition, ok := lastPositions[activeLiveChatMessage.VideoId]
+		if !ok {
+			lastPosition, err = d.lastActiveLiveChatMessagePosition(tx, activeLiveChatMessage.VideoId)
+			if err != nil {
+				if err := tx.Rollback(); err != nil {
+					return nil, fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
+				}
+				return nil, err
+			}
+		}
+		position := lastPosition
+		if !storedMessageIds[activeLiveChatMessage.MessageId] {
+			position = nextActiveLiveChatMessagePosition(lastPosition, activeLiveChatMessage)
+			storedMessageIds[activeLiveChatMessage.MessageId] = true
+			insertedActiveLiveChatMessages = append(insertedActiveLiveChatMessages, activeLiveChatMessage)
+		}
+		lastPositions[activeLiveChatMessage.VideoId] = position
+		res, err := d.txExec(tx,
+			`INSERT INTO activeLiveChatMessage (
 			messageId,
 			channelId,
 			videoId,
@@ -198,12 +672,61 @@ func (d *DatabaseOperator) UpdateActiveLiveChatMessages(activeLiveChatMessages [
 			amountDisplayString,
 			currency,
 			pageToken,
+			eventType,
+			memberLevelName,
+			memberMonth,
+			isUpgrade,
+			giftMembershipsCount,
+			gifterChannelId,
+			associatedMembershipGiftingMessageId,
+			targetMessageId,
+			bannedUserChannelId,
+			bannedUserDisplayName,
+			banType,
+			banDurationSeconds,
+			isDeleted,
+			isAuthorBanned,
+			position,
 			lastUpdate
 		    ) VALUES (
 			?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
 			?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
-			?, ?
-		    )`,
+			?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+			?, ?, ?, ?, ?, ?, ?
+		    ) ON CONFLICT(messageId) DO UPDATE SET
+			channelId = excluded.channelId,
+			videoId = excluded.videoId,
+			apiEtag = excluded.apiEtag,
+			authorChannelId = excluded.authorChannelId,
+			authorChannelUrl = excluded.authorChannelUrl,
+			authorDisplayName = excluded.authorDisplayName,
+			authorIsChatModerator = excluded.authorIsChatModerator,
+			authorIsChatOwner = excluded.authorIsChatOwner,
+			authorIsChatSponsor = excluded.authorIsChatSponsor,
+			authorIsVerified = excluded.authorIsVerified,
+			liveChatId = excluded.liveChatId,
+			displayMessage = excluded.displayMessage,
+			publishedAt = excluded.publishedAt,
+			isSuperChat = excluded.isSuperChat,
+			isSuperSticker = excluded.isSuperSticker,
+			isFanFundingEvent = excluded.isFanFundingEvent,
+			amountMicros = excluded.amountMicros,
+			amountDisplayString = excluded.amountDisplayString,
+			currency = excluded.currency,
+			pageToken = excluded.pageToken,
+			eventType = excluded.eventType,
+			memberLevelName = excluded.memberLevelName,
+			memberMonth = excluded.memberMonth,
+			isUpgrade = excluded.isUpgrade,
+			giftMembershipsCount = excluded.giftMembershipsCount,
+			gifterChannelId = excluded.gifterChannelId,
+			associatedMembershipGiftingMessageId = excluded.associatedMembershipGiftingMessageId,
+			targetMessageId = excluded.targetMessageId,
+			bannedUserChannelId = excluded.bannedUserChannelId,
+			bannedUserDisplayName = excluded.bannedUserDisplayName,
+			banType = excluded.banType,
+			banDurationSeconds = excluded.banDurationSeconds,
+			lastUpdate = excluded.lastUpdate`,
 			activeLiveChatMessage.MessageId,
 			activeLiveChatMessage.ChannelId,
 			activeLiveChatMessage.VideoId,
@@ -225,33 +748,60 @@ func (d *DatabaseOperator) UpdateActiveLiveChatMessages(activeLiveChatMessages [
 			activeLiveChatMessage.AmountDisplayString,
 			activeLiveChatMessage.Currency,
 			activeLiveChatMessage.PageToken,
+			activeLiveChatMessage.EventType,
+			activeLiveChatMessage.MemberLevelName,
+			activeLiveChatMessage.MemberMonth,
+			activeLiveChatMessage.IsUpgrade,
+			activeLiveChatMessage.GiftMembershipsCount,
+			activeLiveChatMessage.GifterChannelId,
+			activeLiveChatMessage.AssociatedMembershipGiftingMessageId,
+			activeLiveChatMessage.TargetMessageId,
+			activeLiveChatMessage.BannedUserChannelId,
+			activeLiveChatMessage.BannedUserDisplayName,
+			activeLiveChatMessage.BanType,
+			activeLiveChatMessage.BanDurationSeconds,
+			activeLiveChatMessage.IsDeleted,
+			activeLiveChatMessage.IsAuthorBanned,
+			position,
 			nowUnix,
 		)
 		if err != nil {
 			if err := tx.Rollback(); err != nil {
-				return fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
+				return nil, fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
 			}
-			return fmt.Errorf("can not insert activeLiveChatMessage: %w", err)
+			return nil, fmt.Errorf("can not insert activeLiveChatMessage: %w", err)
 		}
-		id, err := res.LastInsertId()
+		rowsAffected, err := res.RowsAffected()
 		if err != nil {
 			if err := tx.Rollback(); err != nil {
-				return fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
+				return nil, fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
 			}
-			return fmt.Errorf("can not get insert id of activeLiveChatMessage: %w", err)
+			return nil, fmt.Errorf("can not get rowsAffected of activeLiveChatMessage: %w", err)
 		}
 		if d.verbose {
-			log.Printf("update activeLiveChatMessage (messageId = %v, insert id = %v)", activeLiveChatMessage.MessageId, id)
+			log.Printf("update activeLiveChatMessage (messageId = %v, rowsAffected = %v)", activeLiveChatMessage.MessageId, rowsAffected)
 		}
+		if err := d.applyActiveLiveChatModeration(tx, activeLiveChatMessage, nowUnix); err != nil {
+			if err := tx.Rollback(); err != nil {
+				return nil, fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
+			}
+			return nil, fmt.Errorf("can not apply moderation to activeLiveChatMessage: %w", err)
+		}
+	}
+	if err := d.applyStoredActiveLiveChatModeration(tx, activeLiveChatMessages, nowUnix); err != nil {
+		if err := tx.Rollback(); err != nil {
+			return nil, fmt.Errorf("can not rollback of activeLiveChatMessage: %w", err)
+		}
+		return nil, fmt.Errorf("can not apply stored moderation to activeLiveChatMessage: %w", err)
 	}
 	if err := tx.Commit(); err != nil {
-		return fmt.Errorf("can not commit of activeLiveChatMessage: %w", err)
+		return nil, fmt.Errorf("can not commit of activeLiveChatMessage: %w", err)
 	}
-	return nil
+	return insertedActiveLiveChatMessages, nil
 }
 
 func (d *DatabaseOperator) DeleteActiveLiveChatMessagesByLastUpdate(lastUpdate int) error {
-	res, err := d.db.Exec(`DELETE FROM activeLiveChatMessage WHERE lastUpdate < ?`, lastUpdate)
+	res, err := d.exec(`DELETE FROM activeLiveChatMessage WHERE lastUpdate < ?`, lastUpdate)
 	if err != nil {
 		return fmt.Errorf("can not delete activeLiveChatMessages: %w", err)
 	}
@@ -266,33 +816,87 @@ func (d *DatabaseOperator) DeleteActiveLiveChatMessagesByLastUpdate(lastUpdate i
 	return nil
 }
 
-func (d *DatabaseOperator) GetArchiveLiveChatMessagesByVideoIdAndToken(videoId string, offset int64, count int64) ([]*pb.ArchiveLiveChatMessage, error) {
+// columns are listed explicitly because columns added by migrations are placed after lastUpdate
+const archiveLiveChatMessageColumns = `
+		messageId,
+		channelId,
+		videoId,
+		clientId,
+		authorName,
+		authorExternalChannelId,
+		messageText,
+		purchaseAmountText,
+		isPaid,
+		timestampUsec,
+		timestampText,
+		videoOffsetTimeMsec,
+		continuation,
+		messageType,
+		authorIsChatModerator,
+		authorIsChatOwner,
+		authorIsChatSponsor,
+		authorIsVerified,
+		authorBadges,
+		headerText,
+		giftMembershipsCount,
+		lastUpdate
+	`
+
+func (d *DatabaseOperator) scanArchiveLiveChatMessage(rows *sql.Rows, dest ...interface{}) (*pb.ArchiveLiveChatMessage, error) {
+	var authorBadges string
+	var lastUpdate int
+	archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{}
+	dest = append([]interface{}{
+		&archiveLiveChatMessage.MessageId,
+		&archiveLiveChatMessage.ChannelId,
+		&archiveLiveChatMessage.VideoId,
+		&archiveLiveChatMessage.ClientId,
+		&archiveLiveChatMessage.AuthorName,
+		&archiveLiveChatMessage.AuthorExternalChannelId,
+		&archiveLiveChatMessage.MessageText,
+		&archiveLiveChatMessage.PurchaseAmountText,
+		&archiveLiveChatMessage.IsPaid,
+		&archiveLiveChatMessage.TimestampUsec,
+		&archiveLiveChatMessage.TimestampText,
+		&archiveLiveChatMessage.VideoOffsetTimeMsec,
+		&archiveLiveChatMessage.Continuation,
+		&archiveLiveChatMessage.MessageType,
+		&archiveLiveChatMessage.AuthorIsChatModerator,
+		&archiveLiveChatMessage.AuthorIsChatOwner,
+		&archiveLiveChatMessage.AuthorIsChatSponsor,
+		&archiveLiveChatMessage.AuthorIsVerified,
+		&authorBadges,
+		&archiveLiveChatMessage.HeaderText,
+		&archiveLiveChatMessage.GiftMembershipsCount,
+		&lastUpdate,
+	}, dest...)
+	if err := rows.Scan(dest...); err != nil {
+		return nil, err
+	}
+	if authorBadges != "" {
+		if err := json.Unmarshal([]byte(authorBadges), &archiveLiveChatMessage.AuthorBadges); err != nil {
+			return nil, fmt.Errorf("can not decode authorBadges: %w", err)
+		}
+	}
+	return archiveLiveChatMessage, nil
+}
+
+func (d *DatabaseOperator) GetArchiveLiveChatMessagesByVideoIdAndVideoOffset(videoId string, videoOffsetTimeMsec int64, messageId string, count int64) ([]*pb.ArchiveLiveChatMessage, error) {
+	// order by (videoOffsetTimeMsec, messageId) and start after the given pair
 	archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0)
-	archiveLiveChatMessageRows, err := d.db.Query(`SELECT * FROM archiveLiveChatMessage WHERE videoId = ? LIMIT ? OFFSET ?`, videoId, count, offset)
+	archiveLiveChatMessageRows, err := d.query(
+		`SELECT `+archiveLiveChatMessageColumns+` FROM archiveLiveChatMessage
+		WHERE videoId = ? AND (CAST(videoOffsetTimeMsec AS BIGINT) > ? OR (CAST(videoOffsetTimeMsec AS BIGINT) = ? AND messageId > ?))
+		ORDER BY CAST(videoOffsetTimeMsec AS BIGINT), messageId LIMIT ?`,
+		videoId, videoOffsetTimeMsec, videoOffsetTimeMsec, messageId, count)
 	if err != nil {
-		return nil, fmt.Errorf("can not get archiveLiveChatMessage by videoId and token: %w", err)
+		return nil, fmt.Errorf("can not get archiveLiveChatMessage by videoId and videoOffset: %w", err)
 	}
 	defer archiveLiveChatMessageRows.Close()
 	for archiveLiveChatMessageRows.Next() {
-		var lastUpdate int
-		archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{}
-		if err := archiveLiveChatMessageRows.Scan(
-			&archiveLiveChatMessage.MessageId,
-			&archiveLiveChatMessage.ChannelId,
-			&archiveLiveChatMessage.VideoId,
-			&archiveLiveChatMessage.ClientId,
-			&archiveLiveChatMessage.AuthorName,
-			&archiveLiveChatMessage.AuthorExternalChannelId,
-			&archiveLiveChatMessage.MessageText,
-			&archiveLiveChatMessage.PurchaseAmountText,
-			&archiveLiveChatMessage.IsPaid,
-			&archiveLiveChatMessage.TimestampUsec,
-			&archiveLiveChatMessage.TimestampText,
-			&archiveLiveChatMessage.VideoOffsetTimeMsec,
-			&archiveLiveChatMessage.Continuation,
-			&lastUpdate,
-		); err != nil {
-			return nil, fmt.Errorf("can not scan archiveLiveChatMessage by videoId and token: %w", err)
+		archiveLiveChatMessage, err := d.scanArchiveLiveChatMessage(archiveLiveChatMessageRows)
+		if err != nil {
+			return nil, fmt.Errorf("can not scan archiveLiveChatMessage by videoId and videoOffset: %w", err)
 		}
 		archiveLiveChatMessages = append(archiveLiveChatMessages, archiveLiveChatMessage)
 	}
@@ -300,7 +904,7 @@ func (d *DatabaseOperator) GetArchiveLiveChatMessagesByVideoIdAndToken(videoId s
 }
 
 func (d *DatabaseOperator) CountArchiveLiveChatMessagesByVideoId(videoId string) (int, error) {
-	archiveLiveChatMessageRows, err := d.db.Query(`SELECT count(*) FROM archiveLiveChatMessage WHERE videoId = ?`, videoId)
+	archiveLiveChatMessageRows, err := d.query(`SELECT count(*) FROM archiveLiveChatMessage WHERE videoId = ?`, videoId)
 	if err != nil {
 		return -1, fmt.Errorf("can not get archiveLiveChatMessage by videoId: %w", err)
 	}
@@ -323,15 +927,26 @@ func (d *DatabaseOperator) UpdateArchiveLiveChatMessages(archiveLiveChatMessages
 	defer func() {
 		if p := recover(); p != nil {
 			if err := tx.Rollback(); err != nil {
-				log.Fatal("can not rollback of archiveLiveChatMessage: %v", err)
+				log.Fatalf("can not rollback of archiveLiveChatMessage: %v", err)
 			}
 			panic(p)
 		}
 	}()
 	nowUnix := time.Now().Unix()
 	for _, archiveLiveChatMessage := range archiveLiveChatMessages {
-		res, err := tx.Exec(
-			`INSERT OR REPLACE INTO archiveLiveChatMessage (
+		authorBadges, err := json.Marshal(archiveLiveChatMessage.AuthorBadges)
+		if err != nil {
+			if err := tx.Rollback(); err != nil {
+				return fmt.Errorf("can not rollback of archiveLiveChatMessage: %w", err)
+			}
+			return fmt.Errorf("can not encode authorBadges of archiveLiveChatMessage: %w", err)
+		}
+		currency, amountMicros := archiveLiveChatMessageAmount(archiveLiveChatMessage)
+		if d.verbose && currency == "" && archiveLiveChatMessage.PurchaseAmountText != "" {
+			log.Printf("can not parse purchaseAmountText (messageId = %v, purchaseAmountText = %v)", archiveLiveChatMessage.MessageId, archiveLiveChatMessage.PurchaseAmountText)
+		}
+		res, err := d.txExec(tx,
+			`INSERT INTO archiveLiveChatMessage (
 			messageId,
 			channelId,
 			videoId,
@@ -345,11 +960,47 @@ func (d *DatabaseOperator) UpdateArchiveLiveChatMessages(archiveLiveChatMessages
 			timestampText,
 			videoOffsetTimeMsec,
 			continuation,
+			messageType,
+			authorIsChatModerator,
+			authorIsChatOwner,
+			authorIsChatSponsor,
+			authorIsVerified,
+			authorBadges,
+			headerText,
+			giftMembershipsCount,
+			position,
+			amountMicros,
+			currency,
 			lastUpdate
 		    ) VALUES (
 			?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
-			?, ?, ?, ?
-		    )`,
+			?, ?, ?, ?, ?, ?, ?, ?, ?, ?,
+			?, ?, ?, ?, ?
+		    ) ON CONFLICT(messageId) DO UPDATE SET
+			channelId = excluded.channelId,
+			videoId = excluded.videoId,
+			clientId = excluded.clientId,
+			authorName = excluded.authorName,
+			authorExternalChannelId = excluded.authorExternalChannelId,
+			messageText = excluded.messageText,
+			purchaseAmountText = excluded.purchaseAmountText,
+			isPaid = excluded.isPaid,
+			timestampUsec = excluded.timestampUsec,
+			timestampText = excluded.timestampText,
+			videoOffsetTimeMsec = excluded.videoOffsetTimeMsec,
+			continuation = excluded.continuation,
+			messageType = excluded.messageType,
+			authorIsChatModerator = excluded.authorIsChatModerator,
+			authorIsChatOwner = excluded.authorIsChatOwner,
+			authorIsChatSponsor = excluded.authorIsChatSponsor,
+			authorIsVerified = excluded.authorIsVerified,
+			authorBadges = excluded.authorBadges,
+			headerText = excluded.headerText,
+			giftMembershipsCount = excluded.giftMembershipsCount,
+			position = excluded.position,
+			amountMicros = excluded.amountMicros,
+			currency = excluded.currency,
+			lastUpdate = excluded.lastUpdate`,
 			archiveLiveChatMessage.MessageId,
 			archiveLiveChatMessage.ChannelId,
 			archiveLiveChatMessage.VideoId,
@@ -363,6 +1014,17 @@ func (d *DatabaseOperator) UpdateArchiveLiveChatMessages(archiveLiveChatMessages
 			archiveLiveChatMessage.TimestampText,
 			archiveLiveChatMessage.VideoOffsetTimeMsec,
 			archiveLiveChatMessage.Continuation,
+			archiveLiveChatMessage.MessageType,
+			archiveLiveChatMessage.AuthorIsChatModerator,
+			archiveLiveChatMessage.AuthorIsChatOwner,
+			archiveLiveChatMessage.AuthorIsChatSponsor,
+			archiveLiveChatMessage.AuthorIsVerified,
+			string(authorBadges),
+			archiveLiveChatMessage.HeaderText,
+			archiveLiveChatMessage.GiftMembershipsCount,
+			archiveLiveChatMessagePosition(archiveLiveChatMessage),
+			amountMicros,
+			currency,
 			nowUnix,
 		)
 		if err != nil {
@@ -371,15 +1033,15 @@ func (d *DatabaseOperator) UpdateArchiveLiveChatMessages(archiveLiveChatMessages
 			}
 			return fmt.Errorf("can not insert archiveLiveChatMessage: %w", err)
 		}
-		id, err := res.LastInsertId()
+		rowsAffected, err := res.RowsAffected()
 		if err != nil {
 			if err := tx.Rollback(); err != nil {
 				return fmt.Errorf("can not rollback of archiveLiveChatMessage: %v", err)
 			}
-			return fmt.Errorf("can not get insert id of archiveLiveChatMessage: %w", err)
+			return fmt.Errorf("can not get rowsAffected of archiveLiveChatMessage: %w", err)
 		}
 		if d.verbose {
-			log.Printf("update archiveLiveChatMessage (messageId = %v, insert id = %v)", archiveLiveChatMessage.MessageId, id)
+			log.Printf("update archiveLiveChatMessage (messageId = %v, rowsAffected = %v)", archiveLiveChatMessage.MessageId, rowsAffected)
 		}
 	}
 	if err := tx.Commit(); err != nil {
@@ -389,7 +1051,7 @@ func (d *DatabaseOperator) UpdateArchiveLiveChatMessages(archiveLiveChatMessages
 }
 
 func (d *DatabaseOperator) DeleteArchiveLiveChatMessagesByLastUpdate(lastUpdate int) error {
-	res, err := d.db.Exec(`DELETE FROM archiveLiveChatMessage WHERE lastUpdate < ?`, lastUpdate)
+	res, err := d.exec(`DELETE FROM archiveLiveChatMessage WHERE lastUpdate < ?`, lastUpdate)
 	if err != nil {
 		return fmt.Errorf("can not delete archiveLiveChatMessages: %w", err)
 	}
@@ -403,130 +1065,850 @@ func (d *DatabaseOperator) DeleteArchiveLiveChatMessagesByLastUpdate(lastUpdate
 	return nil
 }
 
-func (d *DatabaseOperator) createTables() error {
-	videoTableCreateQuery := `
-            CREATE TABLE IF NOT EXISTS video (
-                videoId            TEXT PRIMARY KEY,
-                channelId          TEXT NOT NULL,
-		categoryId         TEXT NOT NULL,
-                title              TEXT NOT NULL,
-                description        TEXT NOT NULL,
-		publishedAt        TEXT NOT NULL,
-		duration           TEXT NOT NULL,
-		activeLiveChatId   TEXT NOT NULL,
-		actualStartTime    TEXT NOT NULL,
-		actualEndtime      TEXT NOT NULL,
-		scheduledStartTime TEXT NOT NULL,
-		scheduledEndTime   TEXT NOT NULL,
-		privacyStatus      TEXT NOT NULL,
-		uploadStatus       TEXT NOT NULL,
-		embeddable         TEXT NOT NULL,
-		lastUpdate         INTEGER NOT NULL
-	)`
-	_, err := d.db.Exec(videoTableCreateQuery)
-	if err != nil {
-		return fmt.Errorf("can not create video table: %w", err)
-	}
-	videoLastUpdateIndexQuery := `CREATE INDEX IF NOT EXISTS videoLastUpdateIndex ON video(lastUpdate)`
-	_, err = d.db.Exec(videoLastUpdateIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create lastUpdate index of video: %w", err)
-	}
-
-	activeLiveChatMessageTableCreateQuery := `
-            CREATE TABLE IF NOT EXISTS activeLiveChatMessage (
-                messageId             TEXT PRIMARY KEY,
-		channelId             TEXT NOT NULL,
-		videoId               TEXT NOT NULL,
-		apiEtag               TEXT NOT NULL,
-		authorChannelId       TEXT NOT NULL,
-		authorChannelUrl      TEXT NOT NULL,
-		authorDisplayName     TEXT NOT NULL,
-		authorIsChatModerator TEXT NOT NULL,
-		authorIsChatOwner     TEXT NOT NULL,
-		authorIsChatSponsor   TEXT NOT NULL,
-		authorIsVerified      TEXT NOT NULL,
-		liveChatId            TEXT NOT NULL,
-		displayMessage        TEXT NOT NULL,
-		publishedAt           TEXT NOT NULL,
-		isSuperChat           INTEGER NOT NULL,
-		isSuperSticker        INTEGER NOT NULL,
-		isFanFundingEvent     INTEGER NOT NULL,
-		amountMicros          TEXT NOT NULL,
-		amountDisplayString   TEXT NOT NULL,
-		currency              TEXT NOT NULL,
-		pageToken             TEXT NOT NULL,
-		lastUpdate            INTEGER NOT NULL
-	)`
-	_, err = d.db.Exec(activeLiveChatMessageTableCreateQuery)
-	if err != nil {
-		return fmt.Errorf("can not create activeLiveChatMessage table: %w", err)
-	}
-	activeLiveChatMessageVideoIdIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageVideoIdIndex ON activeLiveChatMessage(videoId)`
-	_, err = d.db.Exec(activeLiveChatMessageVideoIdIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create videoId index of avtiveLiveChatMessage: %w", err)
-	}
-	activeLiveChatMessageChannelIdIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageChannelIdIndex ON activeLiveChatMessage(channelId)`
-	_, err = d.db.Exec(activeLiveChatMessageChannelIdIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create channelId index of activeLiveChatMessage: %w", err)
-	}
-	activeLiveChatMessageLastUpdateIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageLastUPdateIndex ON activeLiveChatMessage(lastUpdate)`
-	_, err = d.db.Exec(activeLiveChatMessageLastUpdateIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create lastUpdate index of activeLiveChatMessage: %w", err)
-	}
-
-	archiveLiveChatMessageTableCreateQuery := `
-            CREATE TABLE IF NOT EXISTS archiveLiveChatMessage (
-		messageId               TEXT PRIMARY KEY,
-		channelId               TEXT NOT NULL,
-		videoId                 TEXT NOT NULL,
-		clientId                TEXT NOT NULL,
-		authorName              TEXT NOT NULL,
-		authorExternalChannelId TEXT NOT NULL,
-		messageText             TEXT NOT NULL,
-		purchaseAmountText      TEXT NOT NULL,
-		isPaid                  INTEGER NOT NULL,
-		timestampUsec           TEXT NOT NULL,
-		timestampText           TEXT NOT NULL,
-		videoOffsetTimeMsec     TEXT NOT NULL,
-		continuation            TEXT NOT NULL,
-		lastUpdate              INTEGER NOT NULL
-	)`
-	_, err = d.db.Exec(archiveLiveChatMessageTableCreateQuery)
-	if err != nil {
-		return fmt.Errorf("can not create archiveLiveChatMessage table: %w", err)
-	}
-	archiveLiveChatMessageVideoIdIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageVideoIdIndex ON archiveLiveChatMessage(videoId)`
-	_, err = d.db.Exec(archiveLiveChatMessageVideoIdIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create vodeoId index of archiveLiveChatMessage: %w", err)
-	}
-	archiveLiveChatMessageChannelIdIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageChannelIdIndex ON archiveLiveChatMessage(channelId)`
-	_, err = d.db.Exec(archiveLiveChatMessageChannelIdIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create channelId index archiveLiveChatMessage: %w", err)
-	}
-	archiveLiveChatMessageLastUpdateIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageLastUPdateIndex ON archiveLiveChatMessage(lastUpdate)`
-	_, err = d.db.Exec(archiveLiveChatMessageLastUpdateIndexQuery)
-	if err != nil {
-		return fmt.Errorf("can not create lastUPdate of archiveLiveChatMessage: %w", err)
+// activeLiveChatMessagePosition is unix time in microseconds of publishedAt, it is 0 when publishedAt is invalid
+func activeLiveChatMessagePosition(activeLiveChatMessage *pb.ActiveLiveChatMessage) int64 {
+	publishedAt, err := time.Parse(time.RFC3339Nano, activeLiveChatMessage.PublishedAt)
+	if err != nil {
+		return 0
+	}
+	return publishedAt.UnixNano() / int64(time.Microsecond)
+}
+
+// nextActiveLiveChatMessagePosition is position of message stored after the message at lastPosition,
+// it is publishedAt unless the message is published before stored messages, so positions follow the order of storing
+// and messages stored after a cursor are always after the cursor
+func nextActiveLiveChatMessagePosition(lastPosition int64, activeLiveChatMessage *pb.ActiveLiveChatMessage) int64 {
+	position := activeLiveChatMessagePosition(activeLiveChatMessage)
+	if position <= lastPosition {
+		return lastPosition + 1
+	}
+	return position
+}
+
+// storedActiveLiveChatMessageIds returns messageIds of the batch which are already stored
+func (d *DatabaseOperator) storedActiveLiveChatMessageIds(tx *sql.Tx, activeLiveChatMessages []*pb.ActiveLiveChatMessage) (map[string]bool, error) {
+	storedMessageIds := make(map[string]bool)
+	for start := 0; start < len(activeLiveChatMessages); start += moderationMessageIdsMax {
+		end := start + moderationMessageIdsMax
+		if end > len(activeLiveChatMessages) {
+			end = len(activeLiveChatMessages)
+		}
+		messageIds := make([]interface{}, 0, end-start)
+		for _, activeLiveChatMessage := range activeLiveChatMessages[start:end] {
+			messageIds = append(messageIds, activeLiveChatMessage.MessageId)
+		}
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", end-start), ", ")
+		rows, err := tx.Query(d.dialect.rebind(`SELECT messageId FROM activeLiveChatMessage WHERE messageId IN (`+placeholders+`)`), messageIds...)
+		if err != nil {
+			return nil, fmt.Errorf("can not get stored messageId of activeLiveChatMessage: %w", err)
+		}
+		for rows.Next() {
+			var messageId string
+			if err := rows.Scan(&messageId); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("can not scan stored messageId of activeLiveChatMessage: %w", err)
+			}
+			storedMessageIds[messageId] = true
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("can not iterate stored messageId of activeLiveChatMessage: %w", err)
+		}
+		rows.Close()
+	}
+	return storedMessageIds, nil
+}
+
+func (d *DatabaseOperator) lastActiveLiveChatMessagePosition(tx *sql.Tx, videoId string) (int64, error) {
+	var lastPosition int64
+	row := tx.QueryRow(d.dialect.rebind(`SELECT COALESCE(MAX(position), 0) FROM activeLiveChatMessage WHERE videoId = ?`), videoId)
+	if err := row.Scan(&lastPosition); err != nil {
+		return 0, fmt.Errorf("can not get last position of activeLiveChatMessage: %w", err)
+	}
+	return lastPosition, nil
+}
+
+// archiveLiveChatMessagePosition is timestampUsec, it is 0 when timestampUsec is invalid
+func archiveLiveChatMessagePosition(archiveLiveChatMessage *pb.ArchiveLiveChatMessage) int64 {
+	position, err := strconv.ParseInt(archiveLiveChatMessage.TimestampUsec, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return position
+}
+
+// archiveLiveChatMessageAmount is currency and amount in micros parsed from purchaseAmountText,
+// currency is empty when purchaseAmountText is empty or can not be parsed
+func archiveLiveChatMessageAmount(archiveLiveChatMessage *pb.ArchiveLiveChatMessage) (string, int64) {
+	currency, amountMicros, ok := youtubehelper.ParsePurchaseAmountText(archiveLiveChatMessage.PurchaseAmountText)
+	if !ok {
+		return "", 0
+	}
+	return currency, amountMicros
+}
+
+// liveChatTable describes columns of message table used by search and paging
+type liveChatTable struct {
+	table                 string
+	columns               string
+	messageColumn         string
+	authorChannelIdColumn string
+	authorNameColumn      string
+	publishedAtExpression string
+	positionExpression    string
+	paidCondition         string
+	ownerCondition        string
+	moderatorCondition    string
+	sponsorCondition      string
+	// messageCondition excludes rows which are not posted by authors (e.g. moderation events), it is empty when all rows are messages
+	messageCondition string
+}
+
+func (d *DatabaseOperator) activeLiveChatTable() *liveChatTable {
+	// author flags of activeLiveChatMessage are stored in TEXT columns
+	return &liveChatTable{
+		table:                 "activeLiveChatMessage",
+		columns:               activeLiveChatMessageColumns,
+		messageColumn:         "displayMessage",
+		authorChannelIdColumn: "authorChannelId",
+		authorNameColumn:      "authorDisplayName",
+		publishedAtExpression: fmt.Sprintf(`(m.position / %d)`, positionsPerSecond),
+		positionExpression:    `m.position`,
+		paidCondition:         `(m.isSuperChat = 1 OR m.isSuperSticker = 1 OR m.isFanFundingEvent = 1)`,
+		ownerCondition:        `m.authorIsChatOwner = '1'`,
+		moderatorCondition:    `m.authorIsChatModerator = '1'`,
+		sponsorCondition:      `m.authorIsChatSponsor = '1'`,
+		messageCondition: fmt.Sprintf(`m.eventType NOT IN (%d, %d, %d, %d, %d, %d)`,
+			pb.ActiveLiveChatEventType_MESSAGE_DELETED_EVENT,
+			pb.ActiveLiveChatEventType_MESSAGE_RETRACTED_EVENT,
+			pb.ActiveLiveChatEventType_USER_BANNED_EVENT,
+			pb.ActiveLiveChatEventType_CHAT_ENDED_EVENT,
+			pb.ActiveLiveChatEventType_SPONSOR_ONLY_MODE_STARTED_EVENT,
+			pb.ActiveLiveChatEventType_SPONSOR_ONLY_MODE_ENDED_EVENT),
+	}
+}
+
+func (d *DatabaseOperator) archiveLiveChatTable() *liveChatTable {
+	return &liveChatTable{
+		table:                 "archiveLiveChatMessage",
+		columns:               archiveLiveChatMessageColumns,
+		messageColumn:         "messageText",
+		authorChannelIdColumn: "authorExternalChannelId",
+		authorNameColumn:      "authorName",
+		publishedAtExpression: fmt.Sprintf(`(m.position / %d)`, positionsPerSecond),
+		positionExpression:    `m.position`,
+		paidCondition:         `m.isPaid = 1`,
+		ownerCondition:        `m.authorIsChatOwner = 1`,
+		moderatorCondition:    `m.authorIsChatModerator = 1`,
+		sponsorCondition:      `m.authorIsChatSponsor = 1`,
+	}
+}
+
+// prefixColumns qualifies comma separated columns with alias
+func prefixColumns(columns string, alias string) string {
+	prefixedColumns := make([]string, 0)
+	for _, column := range strings.Split(columns, ",") {
+		prefixedColumns = append(prefixedColumns, alias+"."+strings.TrimSpace(column))
+	}
+	return strings.Join(prefixedColumns, ", ")
+}
+
+func (d *DatabaseOperator) buildLiveChatSearchQuery(searchTable *liveChatTable, condition *LiveChatSearchCondition) (string, []interface{}) {
+	search := &fullTextSearch{
+		from:          searchTable.table + " m",
+		condition:     "",
+		conditionArgs: nil,
+		rank:          "0",
+		rankArgs:      nil,
+	}
+	if len(condition.Terms) > 0 {
+		search = d.dialect.fullTextSearch(searchTable.table, searchTable.messageColumn, condition.Terms)
+	}
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	args = append(args, search.rankArgs...)
+	if search.condition != "" {
+		conditions = append(conditions, search.condition)
+		args = append(args, search.conditionArgs...)
+	}
+	if condition.ChannelId != "" {
+		conditions = append(conditions, `m.channelId = ?`)
+		args = append(args, condition.ChannelId)
+	}
+	if condition.VideoId != "" {
+		conditions = append(conditions, `m.videoId = ?`)
+		args = append(args, condition.VideoId)
+	}
+	if condition.Author != "" {
+		conditions = append(conditions, fmt.Sprintf(`(m.%v = ? OR m.%v = ?)`, searchTable.authorChannelIdColumn, searchTable.authorNameColumn))
+		args = append(args, condition.Author, condition.Author)
+	}
+	// time range is converted to range of position, so index of position is used
+	if condition.Since > 0 {
+		conditions = append(conditions, searchTable.positionExpression+` >= ?`)
+		args = append(args, condition.Since*positionsPerSecond)
+	}
+	if condition.Until > 0 {
+		conditions = append(conditions, searchTable.positionExpression+` < ?`)
+		args = append(args, condition.Until*positionsPerSecond)
+	}
+	if searchTable.messageCondition != "" {
+		conditions = append(conditions, searchTable.messageCondition)
+	}
+	if condition.PaidOnly {
+		conditions = append(conditions, searchTable.paidCondition)
+	}
+	switch condition.Target {
+	case pb.Target_OWNER_MODERATOR:
+		conditions = append(conditions, fmt.Sprintf(`(%v OR %v)`, searchTable.ownerCondition, searchTable.moderatorCondition))
+	case pb.Target_OWNER_MODERATOR_SPONSOR:
+		conditions = append(conditions, fmt.Sprintf(`(%v OR %v OR %v)`, searchTable.ownerCondition, searchTable.moderatorCondition, searchTable.sponsorCondition))
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query := `SELECT ` + prefixColumns(searchTable.columns, "m") + `, ` + search.rank + ` AS searchRank FROM ` + search.from + where +
+		` ORDER BY searchRank DESC, ` + searchTable.publishedAtExpression + ` DESC, m.messageId LIMIT ? OFFSET ?`
+	args = append(args, condition.Count, condition.Offset)
+	return query, args
+}
+
+func (d *DatabaseOperator) SearchActiveLiveChatMessages(condition *LiveChatSearchCondition) ([]*SearchedActiveLiveChatMessage, error) {
+	query, args := d.buildLiveChatSearchQuery(d.activeLiveChatTable(), condition)
+	searchedActiveLiveChatMessages := make([]*SearchedActiveLiveChatMessage, 0)
+	activeLiveChatMessageRows, err := d.query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can not search activeLiveChatMessage: %w", err)
+	}
+	defer activeLiveChatMessageRows.Close()
+	for activeLiveChatMessageRows.Next() {
+		var rank float64
+		activeLiveChatMessage, err := d.scanActiveLiveChatMessage(activeLiveChatMessageRows, &rank)
+		if err != nil {
+			return nil, fmt.Errorf("can not scan searched activeLiveChatMessage: %w", err)
+		}
+		searchedActiveLiveChatMessages = append(searchedActiveLiveChatMessages, &SearchedActiveLiveChatMessage{
+			ActiveLiveChatMessage: activeLiveChatMessage,
+			Rank:                  rank,
+		})
+	}
+	return searchedActiveLiveChatMessages, nil
+}
+
+func (d *DatabaseOperator) SearchArchiveLiveChatMessages(condition *LiveChatSearchCondition) ([]*SearchedArchiveLiveChatMessage, error) {
+	query, args := d.buildLiveChatSearchQuery(d.archiveLiveChatTable(), condition)
+	searchedArchiveLiveChatMessages := make([]*SearchedArchiveLiveChatMessage, 0)
+	archiveLiveChatMessageRows, err := d.query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can not search archiveLiveChatMessage: %w", err)
+	}
+	defer archiveLiveChatMessageRows.Close()
+	for archiveLiveChatMessageRows.Next() {
+		var rank float64
+		archiveLiveChatMessage, err := d.scanArchiveLiveChatMessage(archiveLiveChatMessageRows, &rank)
+		if err != nil {
+			return nil, fmt.Errorf("can not scan searched archiveLiveChatMessage: %w", err)
+		}
+		searchedArchiveLiveChatMessages = append(searchedArchiveLiveChatMessages, &SearchedArchiveLiveChatMessage{
+			ArchiveLiveChatMessage: archiveLiveChatMessage,
+			Rank:                   rank,
+		})
+	}
+	return searchedArchiveLiveChatMessages, nil
+}
+
+// buildLiveChatPageConditions builds where clause of condition except for position
+func (d *DatabaseOperator) buildLiveChatPageConditions(pageTable *liveChatTable, condition *LiveChatPageCondition) ([]string, []interface{}) {
+	conditions := make([]string, 0)
+	args := make([]interface{}, 0)
+	if condition.ChannelId != "" {
+		conditions = append(conditions, `m.channelId = ?`)
+		args = append(args, condition.ChannelId)
+	}
+	if condition.VideoId != "" {
+		conditions = append(conditions, `m.videoId = ?`)
+		args = append(args, condition.VideoId)
+	}
+	// time range is converted to range of position, so index of position is used
+	if condition.Since > 0 {
+		conditions = append(conditions, pageTable.positionExpression+` >= ?`)
+		args = append(args, condition.Since*positionsPerSecond)
+	}
+	if condition.Until > 0 {
+		conditions = append(conditions, pageTable.positionExpression+` < ?`)
+		args = append(args, condition.Until*positionsPerSecond)
+	}
+	return conditions, args
+}
+
+func (d *DatabaseOperator) buildLiveChatPageQuery(pageTable *liveChatTable, condition *LiveChatPageCondition) (string, []interface{}) {
+	conditions, args := d.buildLiveChatPageConditions(pageTable, condition)
+	if condition.AfterMessageId != "" {
+		conditions = append(conditions, fmt.Sprintf(`(%v > ? OR (%v = ? AND m.messageId > ?))`, pageTable.positionExpression, pageTable.positionExpression))
+		args = append(args, condition.AfterPosition, condition.AfterPosition, condition.AfterMessageId)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	query := `SELECT ` + prefixColumns(pageTable.columns, "m") + `, ` + pageTable.positionExpression + ` FROM ` + pageTable.table + ` m` + where +
+		` ORDER BY ` + pageTable.positionExpression + `, m.messageId LIMIT ? OFFSET ?`
+	args = append(args, condition.Count, condition.Offset)
+	return query, args
+}
+
+// countLiveChatMessages counts messages matching condition, position, offset and count are not used
+func (d *DatabaseOperator) countLiveChatMessages(pageTable *liveChatTable, condition *LiveChatPageCondition) (int64, error) {
+	conditions, args := d.buildLiveChatPageConditions(pageTable, condition)
+	where := ""
+	if len(conditions) > 0 {
+		where = ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	countRows, err := d.query(`SELECT count(*) FROM `+pageTable.table+` m`+where, args...)
+	if err != nil {
+		return -1, fmt.Errorf("can not count %v: %w", pageTable.table, err)
+	}
+	defer countRows.Close()
+	for countRows.Next() {
+		var count int64
+		if err := countRows.Scan(&count); err != nil {
+			return 0, fmt.Errorf("can not scan count of %v: %w", pageTable.table, err)
+		}
+		return count, nil
+	}
+	return 0, nil
+}
+
+func (d *DatabaseOperator) CountActiveLiveChatMessages(condition *LiveChatPageCondition) (int64, error) {
+	return d.countLiveChatMessages(d.activeLiveChatTable(), condition)
+}
+
+func (d *DatabaseOperator) CountArchiveLiveChatMessages(condition *LiveChatPageCondition) (int64, error) {
+	return d.countLiveChatMessages(d.archiveLiveChatTable(), condition)
+}
+
+func (d *DatabaseOperator) GetActiveLiveChatMessagesByPosition(condition *LiveChatPageCondition) ([]*PagedActiveLiveChatMessage, error) {
+	query, args := d.buildLiveChatPageQuery(d.activeLiveChatTable(), condition)
+	pagedActiveLiveChatMessages := make([]*PagedActiveLiveChatMessage, 0)
+	activeLiveChatMessageRows, err := d.query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can not get activeLiveChatMessage by position: %w", err)
+	}
+	defer activeLiveChatMessageRows.Close()
+	for activeLiveChatMessageRows.Next() {
+		var position int64
+		activeLiveChatMessage, err := d.scanActiveLiveChatMessage(activeLiveChatMessageRows, &position)
+		if err != nil {
+			return nil, fmt.Errorf("can not scan paged activeLiveChatMessage: %w", err)
+		}
+		pagedActiveLiveChatMessages = append(pagedActiveLiveChatMessages, &PagedActiveLiveChatMessage{
+			ActiveLiveChatMessage: activeLiveChatMessage,
+			Position:              position,
+		})
+	}
+	return pagedActiveLiveChatMessages, nil
+}
+
+func (d *DatabaseOperator) GetArchiveLiveChatMessagesByPosition(condition *LiveChatPageCondition) ([]*PagedArchiveLiveChatMessage, error) {
+	query, args := d.buildLiveChatPageQuery(d.archiveLiveChatTable(), condition)
+	pagedArchiveLiveChatMessages := make([]*PagedArchiveLiveChatMessage, 0)
+	archiveLiveChatMessageRows, err := d.query(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can not get archiveLiveChatMessage by position: %w", err)
+	}
+	defer archiveLiveChatMessageRows.Close()
+	for archiveLiveChatMessageRows.Next() {
+		var position int64
+		archiveLiveChatMessage, err := d.scanArchiveLiveChatMessage(archiveLiveChatMessageRows, &position)
+		if err != nil {
+			return nil, fmt.Errorf("can not scan paged archiveLiveChatMessage: %w", err)
+		}
+		pagedArchiveLiveChatMessages = append(pagedArchiveLiveChatMessages, &PagedArchiveLiveChatMessage{
+			ArchiveLiveChatMessage: archiveLiveChatMessage,
+			Position:               position,
+		})
+	}
+	return pagedArchiveLiveChatMessages, nil
+}
+
+// buildLiveChatStatisticsWhere builds where clause of messages matching condition
+func (d *DatabaseOperator) buildLiveChatStatisticsWhere(statisticsTable *liveChatTable, condition *LiveChatStatisticsCondition) (string, []interface{}) {
+	conditions, args := d.buildLiveChatPageConditions(statisticsTable, &LiveChatPageCondition{
+		ChannelId: condition.ChannelId,
+		VideoId:   condition.VideoId,
+		Since:     condition.Since,
+		Until:     condition.Until,
+	})
+	if statisticsTable.messageCondition != "" {
+		conditions = append(conditions, statisticsTable.messageCondition)
+	}
+	where := ""
+	if len(conditions) > 0 {
+		where = ` WHERE ` + strings.Join(conditions, " AND ")
+	}
+	return where, args
+}
+
+// appendWhere adds condition to where clause
+func appendWhere(where string, condition string) string {
+	if where == "" {
+		return ` WHERE ` + condition
+	}
+	return where + ` AND ` + condition
+}
+
+func (d *DatabaseOperator) getLiveChatMessageCounts(statisticsTable *liveChatTable, where string, args []interface{}, chatStatistics *pb.ChatStatistics) error {
+	query := fmt.Sprintf(`SELECT count(*), COALESCE(SUM(CASE WHEN %v THEN 1 ELSE 0 END), 0), COALESCE(SUM(CASE WHEN %v THEN 1 ELSE 0 END), 0) FROM %v m%v`,
+		statisticsTable.sponsorCondition, statisticsTable.moderatorCondition, statisticsTable.table, where)
+	countRows, err := d.query(query, args...)
+	if err != nil {
+		return fmt.Errorf("can not count messages of %v: %w", statisticsTable.table, err)
+	}
+	defer countRows.Close()
+	for countRows.Next() {
+		if err := countRows.Scan(&chatStatistics.MessageCount, &chatStatistics.MemberMessageCount, &chatStatistics.ModeratorMessageCount); err != nil {
+			return fmt.Errorf("can not scan count of messages of %v: %w", statisticsTable.table, err)
+		}
+	}
+	if chatStatistics.MessageCount > 0 {
+		chatStatistics.MemberMessageShare = float64(chatStatistics.MemberMessageCount) / float64(chatStatistics.MessageCount)
+		chatStatistics.ModeratorMessageShare = float64(chatStatistics.ModeratorMessageCount) / float64(chatStatistics.MessageCount)
+	}
+	return nil
+}
+
+func (d *DatabaseOperator) getLiveChatMessagesPerMinute(statisticsTable *liveChatTable, where string, args []interface{}, chatStatistics *pb.ChatStatistics) error {
+	query := fmt.Sprintf(`SELECT (COALESCE(%v, 0) / 60) * 60 AS statisticsMinute, count(*) FROM %v m%v GROUP BY statisticsMinute ORDER BY statisticsMinute`,
+		statisticsTable.publishedAtExpression, statisticsTable.table, where)
+	minuteRows, err := d.query(query, args...)
+	if err != nil {
+		return fmt.Errorf("can not count messages per minute of %v: %w", statisticsTable.table, err)
+	}
+	defer minuteRows.Close()
+	messagesPerMinute := make([]*pb.MessagesPerMinute, 0)
+	for minuteRows.Next() {
+		minute := &pb.MessagesPerMinute{}
+		if err := minuteRows.Scan(&minute.Minute, &minute.Count); err != nil {
+			return fmt.Errorf("can not scan messages per minute of %v: %w", statisticsTable.table, err)
+		}
+		messagesPerMinute = append(messagesPerMinute, minute)
 	}
+	chatStatistics.MessagesPerMinute = messagesPerMinute
+	return nil
+}
 
+func (d *DatabaseOperator) getLiveChatTopAuthors(statisticsTable *liveChatTable, where string, args []interface{}, topAuthorsCount int64, chatStatistics *pb.ChatStatistics) error {
+	where = appendWhere(where, fmt.Sprintf(`m.%v <> ''`, statisticsTable.authorChannelIdColumn))
+	query := fmt.Sprintf(`SELECT m.%v, MAX(m.%v), count(*) AS authorMessageCount, COALESCE(SUM(CASE WHEN %v THEN 1 ELSE 0 END), 0) FROM %v m%v`+
+		` GROUP BY m.%v ORDER BY authorMessageCount DESC, m.%v LIMIT ?`,
+		statisticsTable.authorChannelIdColumn, statisticsTable.authorNameColumn, statisticsTable.paidCondition, statisticsTable.table, where,
+		statisticsTable.authorChannelIdColumn, statisticsTable.authorChannelIdColumn)
+	authorRows, err := d.query(query, append(args, topAuthorsCount)...)
+	if err != nil {
+		return fmt.Errorf("can not get top authors of %v: %w", statisticsTable.table, err)
+	}
+	defer authorRows.Close()
+	topAuthors := make([]*pb.AuthorStatistics, 0)
+	for authorRows.Next() {
+		author := &pb.AuthorStatistics{}
+		if err := authorRows.Scan(&author.AuthorChannelId, &author.AuthorDisplayName, &author.MessageCount, &author.PaidMessageCount); err != nil {
+			return fmt.Errorf("can not scan top authors of %v: %w", statisticsTable.table, err)
+		}
+		topAuthors = append(topAuthors, author)
+	}
+	chatStatistics.TopAuthors = topAuthors
 	return nil
 }
 
-func (d *DatabaseOperator) Open() error {
-	db, err := sql.Open("sqlite3", d.databasePath)
+// getLiveChatChatters counts authors of messages, author is returning chatter when author posted in the channel before the first message in condition
+func (d *DatabaseOperator) getLiveChatChatters(statisticsTable *liveChatTable, where string, args []interface{}, chatStatistics *pb.ChatStatistics) error {
+	where = appendWhere(where, fmt.Sprintf(`m.%v <> ''`, statisticsTable.authorChannelIdColumn))
+	// m of subquery in EXISTS is previous message of author
+	previousCondition := fmt.Sprintf(`m.channelId = f.channelId AND m.%v = f.author AND %v < f.firstPosition`,
+		statisticsTable.authorChannelIdColumn, statisticsTable.positionExpression)
+	if statisticsTable.messageCondition != "" {
+		previousCondition += ` AND ` + statisticsTable.messageCondition
+	}
+	query := fmt.Sprintf(`SELECT count(*), COALESCE(SUM(CASE WHEN EXISTS (SELECT 1 FROM %v m WHERE %v) THEN 1 ELSE 0 END), 0) FROM (`+
+		`SELECT m.%v AS author, m.channelId AS channelId, MIN(%v) AS firstPosition FROM %v m%v GROUP BY m.%v, m.channelId) f`,
+		statisticsTable.table, previousCondition,
+		statisticsTable.authorChannelIdColumn, statisticsTable.positionExpression, statisticsTable.table, where, statisticsTable.authorChannelIdColumn)
+	chatterRows, err := d.query(query, args...)
+	if err != nil {
+		return fmt.Errorf("can not count chatters of %v: %w", statisticsTable.table, err)
+	}
+	defer chatterRows.Close()
+	for chatterRows.Next() {
+		if err := chatterRows.Scan(&chatStatistics.UniqueChatters, &chatStatistics.ReturningChatters); err != nil {
+			return fmt.Errorf("can not scan count of chatters of %v: %w", statisticsTable.table, err)
+		}
+	}
+	chatStatistics.NewChatters = chatStatistics.UniqueChatters - chatStatistics.ReturningChatters
+	return nil
+}
+
+// getLiveChatStatistics computes statistics except for total of super chat
+func (d *DatabaseOperator) getLiveChatStatistics(statisticsTable *liveChatTable, condition *LiveChatStatisticsCondition) (*pb.ChatStatistics, error) {
+	where, args := d.buildLiveChatStatisticsWhere(statisticsTable, condition)
+	chatStatistics := &pb.ChatStatistics{}
+	if err := d.getLiveChatMessageCounts(statisticsTable, where, args, chatStatistics); err != nil {
+		return nil, err
+	}
+	if err := d.getLiveChatMessagesPerMinute(statisticsTable, where, args, chatStatistics); err != nil {
+		return nil, err
+	}
+	if err := d.getLiveChatTopAuthors(statisticsTable, where, args, condition.TopAuthorsCount, chatStatistics); err != nil {
+		return nil, err
+	}
+	if err := d.getLiveChatChatters(statisticsTable, where, args, chatStatistics); err != nil {
+		return nil, err
+	}
+	return chatStatistics, nil
+}
+
+func (d *DatabaseOperator) GetActiveLiveChatStatistics(condition *LiveChatStatisticsCondition) (*pb.ChatStatistics, error) {
+	statisticsTable := d.activeLiveChatTable()
+	chatStatistics, err := d.getLiveChatStatistics(statisticsTable, condition)
+	if err != nil {
+		return nil, err
+	}
+	where, args := d.buildLiveChatStatisticsWhere(statisticsTable, condition)
+	where = appendWhere(where, statisticsTable.paidCondition+` AND m.currency <> ''`)
+	totalRows, err := d.query(`SELECT m.currency, COALESCE(SUM(CAST(NULLIF(m.amountMicros, '') AS BIGINT)), 0), count(*) FROM activeLiveChatMessage m`+where+
+		` GROUP BY m.currency ORDER BY m.currency`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can not get super chat totals of activeLiveChatMessage: %w", err)
+	}
+	defer totalRows.Close()
+	superChatTotals := make([]*pb.SuperChatTotal, 0)
+	for totalRows.Next() {
+		superChatTotal := &pb.SuperChatTotal{}
+		if err := totalRows.Scan(&superChatTotal.Currency, &superChatTotal.AmountMicros, &superChatTotal.Count); err != nil {
+			return nil, fmt.Errorf("can not scan super chat totals of activeLiveChatMessage: %w", err)
+		}
+		superChatTotals = append(superChatTotals, superChatTotal)
+	}
+	chatStatistics.SuperChatTotals = superChatTotals
+	return chatStatistics, nil
+}
+
+func (d *DatabaseOperator) GetArchiveLiveChatStatistics(condition *LiveChatStatisticsCondition) (*pb.ChatStatistics, error) {
+	statisticsTable := d.archiveLiveChatTable()
+	chatStatistics, err := d.getLiveChatStatistics(statisticsTable, condition)
+	if err != nil {
+		return nil, err
+	}
+	// amount and currency are parsed from purchaseAmountText when message is stored
+	where, args := d.buildLiveChatStatisticsWhere(statisticsTable, condition)
+	where = appendWhere(where, statisticsTable.paidCondition+` AND m.currency <> ''`)
+	totalRows, err := d.query(`SELECT m.currency, COALESCE(SUM(m.amountMicros), 0), count(*) FROM archiveLiveChatMessage m`+where+
+		` GROUP BY m.currency ORDER BY m.currency`, args...)
+	if err != nil {
+		return nil, fmt.Errorf("can not get super chat totals of archiveLiveChatMessage: %w", err)
+	}
+	defer totalRows.Close()
+	superChatTotals := make([]*pb.SuperChatTotal, 0)
+	for totalRows.Next() {
+		superChatTotal := &pb.SuperChatTotal{}
+		if err := totalRows.Scan(&superChatTotal.Currency, &superChatTotal.AmountMicros, &superChatTotal.Count); err != nil {
+			return nil, fmt.Errorf("can not scan super chat totals of archiveLiveChatMessage: %w", err)
+		}
+		superChatTotals = append(superChatTotals, superChatTotal)
+	}
+	chatStatistics.SuperChatTotals = superChatTotals
+	return chatStatistics, nil
+}
+
+// claimUnownedRows makes owner own rows which have no owner (e.g. stored before owner column is added),
+// each row is claimed by only one instance because update of a row is atomic
+func (d *DatabaseOperator) claimUnownedRows(table string, owner string) error {
+	if owner == "" {
+		return nil
+	}
+	res, err := d.exec(fmt.Sprintf(`UPDATE %v SET owner = ? WHERE owner = ''`, table), owner)
+	if err != nil {
+		return fmt.Errorf("can not claim %v: %w", table, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("can not get rowsAffected of %v: %w", table, err)
+	}
+	if d.verbose && rowsAffected > 0 {
+		log.Printf("claim %v (owner = %v, rowsAffected = %v)", table, owner, rowsAffected)
+	}
+	return nil
+}
+
+func (d *DatabaseOperator) GetActiveLiveChatCollections(owner string) ([]*ActiveLiveChatCollection, error) {
+	if err := d.claimUnownedRows("activeLiveChatCollection", owner); err != nil {
+		return nil, err
+	}
+	activeLiveChatCollections := make([]*ActiveLiveChatCollection, 0)
+	activeLiveChatCollectionRows, err := d.query(`SELECT videoId, activeLiveChatId, pageToken, startedAt, backend FROM activeLiveChatCollection WHERE owner = ?`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("can not get activeLiveChatCollection: %w", err)
+	}
+	defer activeLiveChatCollectionRows.Close()
+	for activeLiveChatCollectionRows.Next() {
+		activeLiveChatCollection := &ActiveLiveChatCollection{}
+		if err := activeLiveChatCollectionRows.Scan(
+			&activeLiveChatCollection.VideoId,
+			&activeLiveChatCollection.ActiveLiveChatId,
+			&activeLiveChatCollection.PageToken,
+			&activeLiveChatCollection.StartedAt,
+			&activeLiveChatCollection.Backend,
+		); err != nil {
+			return nil, fmt.Errorf("can not scan activeLiveChatCollection: %w", err)
+		}
+		activeLiveChatCollections = append(activeLiveChatCollections, activeLiveChatCollection)
+	}
+	return activeLiveChatCollections, nil
+}
+
+func (d *DatabaseOperator) UpdateActiveLiveChatCollection(videoId string, activeLiveChatId string, pageToken string, backend pb.ActiveLiveChatBackend, owner string) error {
+	nowUnix := time.Now().Unix()
+	_, err := d.exec(
+		`INSERT INTO activeLiveChatCollection (
+                videoId,
+                activeLiveChatId,
+                pageToken,
+                startedAt,
+                backend,
+                owner,
+                lastUpdate
+            ) VALUES (
+                ?, ?, ?, ?, ?, ?, ?
+            ) ON CONFLICT(videoId) DO UPDATE SET
+                activeLiveChatId = excluded.activeLiveChatId,
+                pageToken = excluded.pageToken,
+                backend = excluded.backend,
+                owner = excluded.owner,
+                lastUpdate = excluded.lastUpdate`,
+		videoId,
+		activeLiveChatId,
+		pageToken,
+		nowUnix,
+		backend,
+		owner,
+		nowUnix,
+	)
+	if err != nil {
+		return fmt.Errorf("can not update activeLiveChatCollection: %w", err)
+	}
+	if d.verbose {
+		log.Printf("update activeLiveChatCollection (videoId = %v, pageToken = %v)", videoId, pageToken)
+	}
+	return nil
+}
+
+func (d *DatabaseOperator) DeleteActiveLiveChatCollection(videoId string) error {
+	res, err := d.exec(`DELETE FROM activeLiveChatCollection WHERE videoId = ?`, videoId)
+	if err != nil {
+		return fmt.Errorf("can not delete activeLiveChatCollection: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("can not get rowsAffected of activeLiveChatCollection: %w", err)
+	}
+	if d.verbose {
+		log.Printf("delete activeLiveChatCollection (videoId = %v, rowsAffected = %v)", videoId, rowsAffected)
+	}
+	return nil
+}
+
+func (d *DatabaseOperator) GetWatchedChannels(owner string) ([]*WatchedChannel, error) {
+	if err := d.claimUnownedRows("watchedChannel", owner); err != nil {
+		return nil, err
+	}
+	watchedChannels := make([]*WatchedChannel, 0)
+	watchedChannelRows, err := d.query(`SELECT channelId, backend FROM watchedChannel WHERE owner = ?`, owner)
+	if err != nil {
+		return nil, fmt.Errorf("can not get watchedChannel: %w", err)
+	}
+	defer watchedChannelRows.Close()
+	for watchedChannelRows.Next() {
+		watchedChannel := &WatchedChannel{}
+		if err := watchedChannelRows.Scan(
+			&watchedChannel.ChannelId,
+			&watchedChannel.Backend,
+		); err != nil {
+			return nil, fmt.Errorf("can not scan watchedChannel: %w", err)
+		}
+		watchedChannels = append(watchedChannels, watchedChannel)
+	}
+	return watchedChannels, nil
+}
+
+func (d *DatabaseOperator) UpdateWatchedChannel(channelId string, backend pb.ActiveLiveChatBackend, owner string) error {
+	_, err := d.exec(
+		`INSERT INTO watchedChannel (
+                channelId,
+                backend,
+                owner,
+                lastUpdate
+            ) VALUES (
+                ?, ?, ?, ?
+            ) ON CONFLICT(channelId) DO UPDATE SET
+                backend = excluded.backend,
+                owner = excluded.owner,
+                lastUpdate = excluded.lastUpdate`,
+		channelId,
+		backend,
+		owner,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return fmt.Errorf("can not update watchedChannel: %w", err)
+	}
+	if d.verbose {
+		log.Printf("update watchedChannel (channelId = %v, backend = %v)", channelId, backend)
+	}
+	return nil
+}
+
+func (d *DatabaseOperator) DeleteWatchedChannel(channelId string) error {
+	res, err := d.exec(`DELETE FROM watchedChannel WHERE channelId = ?`, channelId)
+	if err != nil {
+		return fmt.Errorf("can not delete watchedChannel: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("can not get rowsAffected of watchedChannel: %w", err)
+	}
+	if d.verbose {
+		log.Printf("delete watchedChannel (channelId = %v, rowsAffected = %v)", channelId, rowsAffected)
+	}
+	return nil
+}
+
+// GetRetentionTargets returns videos which have rows in the table
+func (d *DatabaseOperator) GetRetentionTargets(table string) ([]*RetentionTarget, error) {
+	retentionTargets := make([]*RetentionTarget, 0)
+	retentionTargetRows, err := d.query(fmt.Sprintf(`SELECT videoId, MAX(channelId) FROM %v GROUP BY videoId`, table))
+	if err != nil {
+		return nil, fmt.Errorf("can not get retention targets of %v: %w", table, err)
+	}
+	defer retentionTargetRows.Close()
+	for retentionTargetRows.Next() {
+		retentionTarget := &RetentionTarget{}
+		if err := retentionTargetRows.Scan(
+			&retentionTarget.VideoId,
+			&retentionTarget.ChannelId,
+		); err != nil {
+			return nil, fmt.Errorf("can not scan retention target of %v: %w", table, err)
+		}
+		retentionTargets = append(retentionTargets, retentionTarget)
+	}
+	return retentionTargets, nil
+}
+
+func (d *DatabaseOperator) DeleteByVideoIdAndLastUpdate(table string, videoId string, lastUpdate int64) (int64, error) {
+	res, err := d.exec(fmt.Sprintf(`DELETE FROM %v WHERE videoId = ? AND lastUpdate < ?`, table), videoId, lastUpdate)
+	if err != nil {
+		return 0, fmt.Errorf("can not delete %v: %w", table, err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf("can not get rowsAffected of %v: %w", table, err)
+	}
+	if d.verbose {
+		log.Printf("delete %v (videoId = %v, lastUpdate = %v, rowsAffected = %v)", table, videoId, lastUpdate, rowsAffected)
+	}
+	return rowsAffected, nil
+}
+
+func (d *DatabaseOperator) GetPinnedVideoIds() ([]string, error) {
+	pinnedVideoIds := make([]string, 0)
+	pinnedVideoRows, err := d.query(`SELECT videoId FROM pinnedVideo ORDER BY pinnedAt`)
+	if err != nil {
+		return nil, fmt.Errorf("can not get pinnedVideo: %w", err)
+	}
+	defer pinnedVideoRows.Close()
+	for pinnedVideoRows.Next() {
+		var videoId string
+		if err := pinnedVideoRows.Scan(&videoId); err != nil {
+			return nil, fmt.Errorf("can not scan pinnedVideo: %w", err)
+		}
+		pinnedVideoIds = append(pinnedVideoIds, videoId)
+	}
+	return pinnedVideoIds, nil
+}
+
+func (d *DatabaseOperator) UpdatePinnedVideo(videoId string) error {
+	nowUnix := time.Now().Unix()
+	_, err := d.exec(
+		`INSERT INTO pinnedVideo (
+                videoId,
+                pinnedAt,
+                lastUpdate
+            ) VALUES (
+                ?, ?, ?
+            ) ON CONFLICT(videoId) DO UPDATE SET
+                lastUpdate = excluded.lastUpdate`,
+		videoId,
+		nowUnix,
+		nowUnix,
+	)
+	if err != nil {
+		return fmt.Errorf("can not update pinnedVideo: %w", err)
+	}
+	if d.verbose {
+		log.Printf("update pinnedVideo (videoId = %v)", videoId)
+	}
+	return nil
+}
+
+func (d *DatabaseOperator) DeletePinnedVideo(videoId string) (bool, error) {
+	res, err := d.exec(`DELETE FROM pinnedVideo WHERE videoId = ?`, videoId)
+	if err != nil {
+		return false, fmt.Errorf("can not delete pinnedVideo: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("can not get rowsAffected of pinnedVideo: %w", err)
+	}
+	if d.verbose {
+		log.Printf("delete pinnedVideo (videoId = %v, rowsAffected = %v)", videoId, rowsAffected)
+	}
+	return rowsAffected > 0, nil
+}
+
+func (d *DatabaseOperator) addColumnIfNotExists(tx *sql.Tx, table string, column string, definition string) error {
+	exists, err := d.dialect.columnExists(tx, table, column)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+	_, err = d.txExec(tx, fmt.Sprintf(`ALTER TABLE %v ADD COLUMN %v %v`, table, column, definition))
+	if err != nil {
+		return fmt.Errorf("can not add column %v to %v: %w", column, table, err)
+	}
+	if d.verbose {
+		log.Printf("add column (table = %v, column = %v)", table, column)
+	}
+	return nil
+}
+
+// Connect opens database without applying migrations
+func (d *DatabaseOperator) Connect() error {
+	db, err := sql.Open(d.dialect.driverName(), d.dataSourceName)
 	if err != nil {
 		return fmt.Errorf("can not open database: %w", err)
 	}
 	d.db = db
-	err = d.createTables()
-	if err != nil {
-		return fmt.Errorf("can not create table of database: %w", err)
+	return nil
+}
+
+// Open opens database and applies pending migrations
+func (d *DatabaseOperator) Open() error {
+	if err := d.Connect(); err != nil {
+		return err
+	}
+	if _, err := d.Migrate(); err != nil {
+		return fmt.Errorf("can not migrate database: %w", err)
 	}
 	return nil
 }
@@ -555,8 +1937,9 @@ func NewDatabaseOperator(databasePath string, opts ...Option) (*DatabaseOperator
 		}
 	}
 	return &DatabaseOperator{
-		verbose:      baseOpts.verbose,
-		databasePath: databasePath,
-		db:           nil,
+		verbose:        baseOpts.verbose,
+		dialect:        &sqliteDialect{},
+		dataSourceName: databasePath,
+		db:             nil,
 	}, nil
 }
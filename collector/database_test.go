@@ -0,0 +1,21 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package collector
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSqliteStorage(t *testing.T) {
+	databaseOperator, err := NewDatabaseOperator(filepath.Join(t.TempDir(), "ylcc.db"))
+	if err != nil {
+		t.Fatalf("can not create database operator: %v", err)
+	}
+	if err := databaseOperator.Open(); err != nil {
+		t.Fatalf("can not open database: %v", err)
+	}
+	defer databaseOperator.Close()
+	testStorage(t, databaseOperator)
+}
@@ -0,0 +1,356 @@
+package collector
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/potix/ylcc/exporter"
+	pb "github.com/potix/ylcc/protocol"
+	"log"
+	"strconv"
+)
+
+const (
+	exportChunkSizeDefault int64 = 1000
+	exportChunkSizeMax     int64 = 10000
+	exportVideosMetadata         = "ylcc.videos"
+)
+
+type activeLiveChatExportField struct {
+	name       string
+	columnType exporter.ColumnType
+	value      func(activeLiveChatMessage *pb.ActiveLiveChatMessage) interface{}
+}
+
+type archiveLiveChatExportField struct {
+	name       string
+	columnType exporter.ColumnType
+	value      func(archiveLiveChatMessage *pb.ArchiveLiveChatMessage) interface{}
+}
+
+// parseExportInt64 converts numeric string field to int64, invalid string becomes 0
+func parseExportInt64(s string) int64 {
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return i
+}
+
+var activeLiveChatExportFields = []*activeLiveChatExportField{
+	{"messageId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.MessageId }},
+	{"channelId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.ChannelId }},
+	{"videoId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.VideoId }},
+	{"apiEtag", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.ApiEtag }},
+	{"authorChannelId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorChannelId }},
+	{"authorChannelUrl", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorChannelUrl }},
+	{"authorDisplayName", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorDisplayName }},
+	{"authorIsChatModerator", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorIsChatModerator }},
+	{"authorIsChatOwner", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorIsChatOwner }},
+	{"authorIsChatSponsor", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorIsChatSponsor }},
+	{"authorIsVerified", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AuthorIsVerified }},
+	{"liveChatId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.LiveChatId }},
+	{"displayMessage", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.DisplayMessage }},
+	{"publishedAt", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.PublishedAt }},
+	{"isSuperChat", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.IsSuperChat }},
+	{"isSuperSticker", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.IsSuperSticker }},
+	{"isFanFundingEvent", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.IsFanFundingEvent }},
+	{"amountMicros", exporter.Int64Column, func(m *pb.ActiveLiveChatMessage) interface{} { return parseExportInt64(m.AmountMicros) }},
+	{"amountDisplayString", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AmountDisplayString }},
+	{"currency", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.Currency }},
+	{"pageToken", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.PageToken }},
+	{"eventType", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.EventType.String() }},
+	{"memberLevelName", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.MemberLevelName }},
+	{"memberMonth", exporter.Int64Column, func(m *pb.ActiveLiveChatMessage) interface{} { return m.MemberMonth }},
+	{"isUpgrade", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.IsUpgrade }},
+	{"giftMembershipsCount", exporter.Int64Column, func(m *pb.ActiveLiveChatMessage) interface{} { return m.GiftMembershipsCount }},
+	{"gifterChannelId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.GifterChannelId }},
+	{"associatedMembershipGiftingMessageId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.AssociatedMembershipGiftingMessageId }},
+	{"targetMessageId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.TargetMessageId }},
+	{"bannedUserChannelId", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.BannedUserChannelId }},
+	{"bannedUserDisplayName", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.BannedUserDisplayName }},
+	{"banType", exporter.StringColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.BanType }},
+	{"banDurationSeconds", exporter.Int64Column, func(m *pb.ActiveLiveChatMessage) interface{} { return m.BanDurationSeconds }},
+	{"isDeleted", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.IsDeleted }},
+	{"isAuthorBanned", exporter.BoolColumn, func(m *pb.ActiveLiveChatMessage) interface{} { return m.IsAuthorBanned }},
+}
+
+var archiveLiveChatExportFields = []*archiveLiveChatExportField{
+	{"messageId", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.MessageId }},
+	{"channelId", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.ChannelId }},
+	{"videoId", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.VideoId }},
+	{"clientId", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.ClientId }},
+	{"authorName", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.AuthorName }},
+	{"authorExternalChannelId", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.AuthorExternalChannelId }},
+	{"messageText", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.MessageText }},
+	{"purchaseAmountText", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.PurchaseAmountText }},
+	{"isPaid", exporter.BoolColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.IsPaid }},
+	{"timestampUsec", exporter.Int64Column, func(m *pb.ArchiveLiveChatMessage) interface{} { return parseExportInt64(m.TimestampUsec) }},
+	{"timestampText", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.TimestampText }},
+	{"videoOffsetTimeMsec", exporter.Int64Column, func(m *pb.ArchiveLiveChatMessage) interface{} { return parseExportInt64(m.VideoOffsetTimeMsec) }},
+	{"continuation", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.Continuation }},
+	{"messageType", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.MessageType.String() }},
+	{"authorIsChatModerator", exporter.BoolColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.AuthorIsChatModerator }},
+	{"authorIsChatOwner", exporter.BoolColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.AuthorIsChatOwner }},
+	{"authorIsChatSponsor", exporter.BoolColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.AuthorIsChatSponsor }},
+	{"authorIsVerified", exporter.BoolColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.AuthorIsVerified }},
+	{"authorBadges", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} {
+		if len(m.AuthorBadges) == 0 {
+			return ""
+		}
+		authorBadges, err := json.Marshal(m.AuthorBadges)
+		if err != nil {
+			return ""
+		}
+		return string(authorBadges)
+	}},
+	{"headerText", exporter.StringColumn, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.HeaderText }},
+	{"giftMembershipsCount", exporter.Int64Column, func(m *pb.ArchiveLiveChatMessage) interface{} { return m.GiftMembershipsCount }},
+}
+
+// Exporter converts stored live chat messages to chunks of a file
+// it only reads Storage, so it can be used without Collector (e.g. export subcommand)
+type Exporter struct {
+	verbose bool
+	storage Storage
+}
+
+func (e *Exporter) selectActiveLiveChatExportFields(names []string) ([]*activeLiveChatExportField, error) {
+	if len(names) == 0 {
+		return activeLiveChatExportFields, nil
+	}
+	fields := make([]*activeLiveChatExportField, 0, len(names))
+	for _, name := range names {
+		var found *activeLiveChatExportField
+		for _, field := range activeLiveChatExportFields {
+			if field.name == name {
+				found = field
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("not found field (field = %v)", name)
+		}
+		fields = append(fields, found)
+	}
+	return fields, nil
+}
+
+func (e *Exporter) selectArchiveLiveChatExportFields(names []string) ([]*archiveLiveChatExportField, error) {
+	if len(names) == 0 {
+		return archiveLiveChatExportFields, nil
+	}
+	fields := make([]*archiveLiveChatExportField, 0, len(names))
+	for _, name := range names {
+		var found *archiveLiveChatExportField
+		for _, field := range archiveLiveChatExportFields {
+			if field.name == name {
+				found = field
+				break
+			}
+		}
+		if found == nil {
+			return nil, fmt.Errorf("not found field (field = %v)", name)
+		}
+		fields = append(fields, found)
+	}
+	return fields, nil
+}
+
+func (e *Exporter) getVideos(request *pb.ExportLiveChatRequest) ([]*pb.Video, error) {
+	if request.VideoId == "" {
+		return e.storage.GetVideosByChannelId(request.ChannelId)
+	}
+	video, ok, err := e.storage.GetVideoByVideoId(request.VideoId)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return []*pb.Video{}, nil
+	}
+	return []*pb.Video{video}, nil
+}
+
+// nextActiveLiveChatRows reads next chunk of rows and moves condition after the last message
+func (e *Exporter) nextActiveLiveChatRows(condition *LiveChatPageCondition, fields []*activeLiveChatExportField) ([][]interface{}, error) {
+	pagedActiveLiveChatMessages, err := e.storage.GetActiveLiveChatMessagesByPosition(condition)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([][]interface{}, 0, len(pagedActiveLiveChatMessages))
+	for _, pagedActiveLiveChatMessage := range pagedActiveLiveChatMessages {
+		row := make([]interface{}, 0, len(fields))
+		for _, field := range fields {
+			row = append(row, field.value(pagedActiveLiveChatMessage.ActiveLiveChatMessage))
+		}
+		rows = append(rows, row)
+		condition.AfterPosition = pagedActiveLiveChatMessage.Position
+		condition.AfterMessageId = pagedActiveLiveChatMessage.ActiveLiveChatMessage.MessageId
+	}
+	return rows, nil
+}
+
+// nextArchiveLiveChatRows reads next chunk of rows and moves condition after the last message
+func (e *Exporter) nextArchiveLiveChatRows(condition *LiveChatPageCondition, fields []*archiveLiveChatExportField) ([][]interface{}, error) {
+	pagedArchiveLiveChatMessages, err := e.storage.GetArchiveLiveChatMessagesByPosition(condition)
+	if err != nil {
+		return nil, err
+	}
+	rows := make([][]interface{}, 0, len(pagedArchiveLiveChatMessages))
+	for _, pagedArchiveLiveChatMessage := range pagedArchiveLiveChatMessages {
+		row := make([]interface{}, 0, len(fields))
+		for _, field := range fields {
+			row = append(row, field.value(pagedArchiveLiveChatMessage.ArchiveLiveChatMessage))
+		}
+		rows = append(rows, row)
+		condition.AfterPosition = pagedArchiveLiveChatMessage.Position
+		condition.AfterMessageId = pagedArchiveLiveChatMessage.ArchiveLiveChatMessage.MessageId
+	}
+	return rows, nil
+}
+
+// Export calls sendFunc with chunks in order, the first response has videos and the last one has eof
+// failure of request is sent as status of response, error is returned only when sendFunc fails
+func (e *Exporter) Export(request *pb.ExportLiveChatRequest, sendFunc func(*pb.ExportLiveChatResponse) error) error {
+	status := new(pb.Status)
+	sendStatus := func(code pb.Code, message string) error {
+		status.Code = code
+		status.Message = message
+		return sendFunc(&pb.ExportLiveChatResponse{
+			Status: status,
+			Format: request.Format,
+			Eof:    true,
+		})
+	}
+	if request.VideoId == "" && request.ChannelId == "" {
+		return sendStatus(pb.Code_NOT_PERMITTED, "no videoId and channelId")
+	}
+	chunkSize := request.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = exportChunkSizeDefault
+	} else if chunkSize > exportChunkSizeMax {
+		chunkSize = exportChunkSizeMax
+	}
+	var columns []*exporter.Column
+	var nextRows func(condition *LiveChatPageCondition) ([][]interface{}, error)
+	if request.Source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+		fields, err := e.selectArchiveLiveChatExportFields(request.Fields)
+		if err != nil {
+			return sendStatus(pb.Code_NOT_FOUND, err.Error())
+		}
+		for _, field := range fields {
+			columns = append(columns, &exporter.Column{Name: field.name, Type: field.columnType})
+		}
+		nextRows = func(condition *LiveChatPageCondition) ([][]interface{}, error) {
+			return e.nextArchiveLiveChatRows(condition, fields)
+		}
+	} else {
+		fields, err := e.selectActiveLiveChatExportFields(request.Fields)
+		if err != nil {
+			return sendStatus(pb.Code_NOT_FOUND, err.Error())
+		}
+		for _, field := range fields {
+			columns = append(columns, &exporter.Column{Name: field.name, Type: field.columnType})
+		}
+		nextRows = func(condition *LiveChatPageCondition) ([][]interface{}, error) {
+			return e.nextActiveLiveChatRows(condition, fields)
+		}
+	}
+	fieldNames := make([]string, 0, len(columns))
+	for _, column := range columns {
+		fieldNames = append(fieldNames, column.Name)
+	}
+	videos, err := e.getVideos(request)
+	if err != nil {
+		return sendStatus(pb.Code_INTERNAL_ERROR, fmt.Sprintf("%v (videoId = %v, channelId = %v)", err, request.VideoId, request.ChannelId))
+	}
+	videosJson, err := json.Marshal(videos)
+	if err != nil {
+		return sendStatus(pb.Code_INTERNAL_ERROR, fmt.Sprintf("can not encode videos: %v", err))
+	}
+	writer, err := exporter.NewWriter(
+		request.Format,
+		columns,
+		exporter.Verbose(e.verbose),
+		exporter.Metadata(map[string]string{exportVideosMetadata: string(videosJson)}),
+	)
+	if err != nil {
+		return sendStatus(pb.Code_NOT_PERMITTED, err.Error())
+	}
+	chunk, err := writer.Begin()
+	if err != nil {
+		return sendStatus(pb.Code_INTERNAL_ERROR, err.Error())
+	}
+	condition := &LiveChatPageCondition{
+		ChannelId: request.ChannelId,
+		VideoId:   request.VideoId,
+		Since:     request.Since,
+		Until:     request.Until,
+		Count:     chunkSize,
+	}
+	var sequence int64
+	var total int64
+	for {
+		rows, err := nextRows(condition)
+		if err != nil {
+			return sendStatus(pb.Code_INTERNAL_ERROR, fmt.Sprintf("%v (videoId = %v, channelId = %v)", err, request.VideoId, request.ChannelId))
+		}
+		data, err := writer.Write(rows)
+		if err != nil {
+			return sendStatus(pb.Code_INTERNAL_ERROR, err.Error())
+		}
+		chunk = append(chunk, data...)
+		eof := int64(len(rows)) < chunkSize
+		if eof {
+			data, err := writer.End()
+			if err != nil {
+				return sendStatus(pb.Code_INTERNAL_ERROR, err.Error())
+			}
+			chunk = append(chunk, data...)
+		}
+		response := &pb.ExportLiveChatResponse{
+			Status:   &pb.Status{Code: pb.Code_SUCCESS, Message: "success"},
+			Videos:   nil,
+			Format:   request.Format,
+			Fields:   fieldNames,
+			Sequence: sequence,
+			Chunk:    chunk,
+			Count:    int64(len(rows)),
+			Eof:      eof,
+		}
+		if sequence == 0 {
+			response.Videos = videos
+		}
+		if err := sendFunc(response); err != nil {
+			return fmt.Errorf("can not send chunk: %w", err)
+		}
+		total += int64(len(rows))
+		if eof {
+			break
+		}
+		sequence += 1
+		chunk = nil
+	}
+	if e.verbose {
+		log.Printf("exported live chat (videoId = %v, channelId = %v, source = %v, format = %v, messages = %v)",
+			request.VideoId, request.ChannelId, request.Source, request.Format, total)
+	}
+	return nil
+}
+
+func NewExporter(storage Storage, opts ...Option) *Exporter {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &Exporter{
+		verbose: baseOpts.verbose,
+		storage: storage,
+	}
+}
+
+func (c *Collector) ExportLiveChat(request *pb.ExportLiveChatRequest, sendFunc func(*pb.ExportLiveChatResponse) error) error {
+	return NewExporter(c.dbOperator, Verbose(c.verbose)).Export(request, sendFunc)
+}
@@ -0,0 +1,184 @@
+package collector
+
+import (
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+)
+
+const (
+	followCountDefault int64 = 1000
+	followCountMax     int64 = 10000
+)
+
+// followCursor is position of the last message sent to follower,
+// positions of stored messages increase in the order of storing, so messages stored later are always after the cursor
+type followCursor struct {
+	position  int64
+	messageId string
+}
+
+func (f *followCursor) sent(position int64, messageId string) {
+	f.position = position
+	f.messageId = messageId
+}
+
+// published moves cursor over messages published by collection,
+// they are stored with the positions following the last stored message
+func (f *followCursor) published(activeLiveChatMessages []*pb.ActiveLiveChatMessage) {
+	for _, activeLiveChatMessage := range activeLiveChatMessages {
+		f.sent(nextActiveLiveChatMessagePosition(f.position, activeLiveChatMessage), activeLiveChatMessage.MessageId)
+	}
+}
+
+func (f *followCursor) encode() string {
+	if f.messageId == "" {
+		return ""
+	}
+	return encodeLiveChatCursor(f.position, f.messageId)
+}
+
+// readStoredActiveLiveChat reads a page of stored messages after cursor and moves cursor to the last of them
+func (c *Collector) readStoredActiveLiveChat(videoId string, count int64, cursor *followCursor) ([]*pb.ActiveLiveChatMessage, error) {
+	pagedActiveLiveChatMessages, err := c.dbOperator.GetActiveLiveChatMessagesByPosition(&LiveChatPageCondition{
+		VideoId:        videoId,
+		AfterPosition:  cursor.position,
+		AfterMessageId: cursor.messageId,
+		Count:          count,
+	})
+	if err != nil {
+		return nil, err
+	}
+	activeLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0, len(pagedActiveLiveChatMessages))
+	for _, pagedActiveLiveChatMessage := range pagedActiveLiveChatMessages {
+		cursor.sent(pagedActiveLiveChatMessage.Position, pagedActiveLiveChatMessage.ActiveLiveChatMessage.MessageId)
+		activeLiveChatMessages = append(activeLiveChatMessages, pagedActiveLiveChatMessage.ActiveLiveChatMessage)
+	}
+	return activeLiveChatMessages, nil
+}
+
+// FollowActiveLiveChat sends stored messages after cursor and then messages of collection in progress by sendFunc,
+// failure of request is sent as status of response, error is returned only when sendFunc fails
+func (c *Collector) FollowActiveLiveChat(request *pb.FollowActiveLiveChatRequest, sendFunc func(*pb.FollowActiveLiveChatResponse) error) error {
+	status := new(pb.Status)
+	sendStatus := func(code pb.Code, message string) error {
+		status.Code = code
+		status.Message = message
+		return sendFunc(&pb.FollowActiveLiveChatResponse{
+			Status: status,
+		})
+	}
+	if request.VideoId == "" {
+		return sendStatus(pb.Code_NOT_PERMITTED, "no videoId")
+	}
+	count := request.Count
+	if count <= 0 {
+		count = followCountDefault
+	} else if count > followCountMax {
+		count = followCountMax
+	}
+	startCondition := &LiveChatPageCondition{}
+	if err := decodeLiveChatCursor(request.Cursor, startCondition); err != nil {
+		return sendStatus(pb.Code_NOT_PERMITTED, fmt.Sprintf("%v (videoId = %v)", err, request.VideoId))
+	}
+	cursor := &followCursor{
+		position:  startCondition.AfterPosition,
+		messageId: startCondition.AfterMessageId,
+	}
+	sendBackfill := func(activeLiveChatMessages []*pb.ActiveLiveChatMessage) error {
+		if err := sendFunc(&pb.FollowActiveLiveChatResponse{
+			Status: &pb.Status{
+				Code:    pb.Code_SUCCESS,
+				Message: fmt.Sprintf("success (videoId = %v)", request.VideoId),
+			},
+			ActiveLiveChatMessages: activeLiveChatMessages,
+			Backfill:               true,
+			Cursor:                 cursor.encode(),
+		}); err != nil {
+			return fmt.Errorf("can not send response: %w", err)
+		}
+		return nil
+	}
+	var subscribeParams *subscribeActiveLiveChatParams
+	for canceled := false; subscribeParams == nil; {
+		// most of stored messages are sent without blocking collection
+		for {
+			activeLiveChatMessages, err := c.readStoredActiveLiveChat(request.VideoId, count, cursor)
+			if err != nil {
+				return sendStatus(pb.Code_INTERNAL_ERROR, fmt.Sprintf("%v (videoId = %v)", err, request.VideoId))
+			}
+			if len(activeLiveChatMessages) > 0 {
+				if err := sendBackfill(activeLiveChatMessages); err != nil {
+					return err
+				}
+			}
+			if int64(len(activeLiveChatMessages)) < count {
+				break
+			}
+		}
+		if canceled {
+			break
+		}
+		collectionCtx, ok := c.getRequestedVideoForActiveLiveChat(request.VideoId)
+		if !ok {
+			break
+		}
+		// while publishMutex is held, collected messages are stored and published together,
+		// so messages read here and messages published after subscription do not overlap.
+		// only one page is read, when more messages are stored they are read again without blocking collection
+		collectionCtx.publishMutex.Lock()
+		// canceled collection does not publish messages any more, messages stored before cancellation are read again
+		if collectionCtx.ctx.Err() != nil {
+			collectionCtx.publishMutex.Unlock()
+			canceled = true
+			continue
+		}
+		activeLiveChatMessages, err := c.readStoredActiveLiveChat(request.VideoId, count, cursor)
+		if err != nil {
+			collectionCtx.publishMutex.Unlock()
+			return sendStatus(pb.Code_INTERNAL_ERROR, fmt.Sprintf("%v (videoId = %v)", err, request.VideoId))
+		}
+		if int64(len(activeLiveChatMessages)) < count {
+			subscribeParams = &subscribeActiveLiveChatParams{
+				videoId:      request.VideoId,
+				subscriberCh: make(chan *pb.PollActiveLiveChatResponse),
+			}
+			c.subscribeActiveLiveChatCh <- subscribeParams
+		}
+		collectionCtx.publishMutex.Unlock()
+		if subscribeParams != nil {
+			defer c.UnsubscribeActiveLiveChat(subscribeParams)
+		}
+		if len(activeLiveChatMessages) > 0 {
+			if err := sendBackfill(activeLiveChatMessages); err != nil {
+				return err
+			}
+		}
+	}
+	if subscribeParams == nil {
+		status.Code = pb.Code_SUCCESS
+		status.Message = fmt.Sprintf("finished, collection is not in progress (videoId = %v)", request.VideoId)
+		if err := sendFunc(&pb.FollowActiveLiveChatResponse{
+			Status: status,
+			Cursor: cursor.encode(),
+		}); err != nil {
+			return fmt.Errorf("can not send response: %w", err)
+		}
+		return nil
+	}
+	for {
+		response, ok := <-subscribeParams.GetSubscriberCh()
+		if !ok {
+			return nil
+		}
+		cursor.published(response.ActiveLiveChatMessages)
+		if err := sendFunc(&pb.FollowActiveLiveChatResponse{
+			Status:                 response.Status,
+			ActiveLiveChatMessages: response.ActiveLiveChatMessages,
+			Backfill:               false,
+			Cursor:                 cursor.encode(),
+			CollectionStatus:       response.CollectionStatus,
+		}); err != nil {
+			return fmt.Errorf("can not send response: %w", err)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper/fakeyoutube"
+	"google.golang.org/api/youtube/v3"
+)
+
+func newFollowActiveLiveChatMessage(messageId string, publishedAt string) *pb.ActiveLiveChatMessage {
+	return &pb.ActiveLiveChatMessage{
+		MessageId:   messageId,
+		ChannelId:   fakeChannelId,
+		VideoId:     fakeVideoId,
+		PublishedAt: publishedAt,
+	}
+}
+
+func followActiveLiveChat(t *testing.T, c *Collector, cursor string) ([]string, string) {
+	t.Helper()
+	messageIds := make([]string, 0)
+	lastCursor := ""
+	err := c.FollowActiveLiveChat(&pb.FollowActiveLiveChatRequest{
+		VideoId: fakeVideoId,
+		Count:   2,
+		Cursor:  cursor,
+	}, func(response *pb.FollowActiveLiveChatResponse) error {
+		if response.Status.Code != pb.Code_SUCCESS {
+			t.Fatalf("follow status = %v, want SUCCESS: %v", response.Status.Code, response.Status.Message)
+		}
+		for _, activeLiveChatMessage := range response.ActiveLiveChatMessages {
+			messageIds = append(messageIds, activeLiveChatMessage.MessageId)
+		}
+		lastCursor = response.Cursor
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("can not follow active live chat: %v", err)
+	}
+	return messageIds, lastCursor
+}
+
+func TestFollowActiveLiveChatSendsMessagesStoredAfterCursor(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	c := newFakeYoutubeCollector(t, fakeServer)
+	if _, err := c.dbOperator.UpdateActiveLiveChatMessages([]*pb.ActiveLiveChatMessage{
+		newFollowActiveLiveChatMessage("fakeMessage01", "2022-01-01T00:10:00Z"),
+		newFollowActiveLiveChatMessage("fakeMessage02", "2022-01-01T00:10:01Z"),
+		newFollowActiveLiveChatMessage("fakeMessage03", "2022-01-01T00:10:02Z"),
+	}); err != nil {
+		t.Fatalf("can not store messages: %v", err)
+	}
+	messageIds, cursor := followActiveLiveChat(t, c, "")
+	if len(messageIds) != 3 || messageIds[2] != "fakeMessage03" || cursor == "" {
+		t.Fatalf("unexpected followed messages: %v (cursor = %v)", messageIds, cursor)
+	}
+
+	// message published long before the cursor but stored after it is not lost
+	if _, err := c.dbOperator.UpdateActiveLiveChatMessages([]*pb.ActiveLiveChatMessage{
+		newFollowActiveLiveChatMessage("fakeMessage04", "2022-01-01T00:00:00Z"),
+	}); err != nil {
+		t.Fatalf("can not store late message: %v", err)
+	}
+	messageIds, nextCursor := followActiveLiveChat(t, c, cursor)
+	if len(messageIds) != 1 || messageIds[0] != "fakeMessage04" || nextCursor == cursor {
+		t.Errorf("unexpected messages followed from cursor: %v (cursor = %v)", messageIds, nextCursor)
+	}
+	messageIds, _ = followActiveLiveChat(t, c, nextCursor)
+	if len(messageIds) != 0 {
+		t.Errorf("messages are followed again: %v", messageIds)
+	}
+}
+
+func TestFollowCursorOfPublishedMessagesIsStoredPosition(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	c := newFakeYoutubeCollector(t, fakeServer)
+	if _, err := c.dbOperator.UpdateActiveLiveChatMessages([]*pb.ActiveLiveChatMessage{
+		newFollowActiveLiveChatMessage("fakeMessage01", "2022-01-01T00:00:10Z"),
+	}); err != nil {
+		t.Fatalf("can not store messages: %v", err)
+	}
+	cursor := &followCursor{}
+	if _, err := c.readStoredActiveLiveChat(fakeVideoId, 10, cursor); err != nil {
+		t.Fatalf("can not read stored messages: %v", err)
+	}
+	storedCursor := *cursor
+
+	// stored message is fetched again with new messages, only new messages are published
+	activeLiveChatMessages := []*pb.ActiveLiveChatMessage{
+		newFollowActiveLiveChatMessage("fakeMessage02", "2022-01-01T00:00:05Z"),
+		newFollowActiveLiveChatMessage("fakeMessage03", "2022-01-01T00:00:05Z"),
+		newFollowActiveLiveChatMessage("fakeMessage04", "2022-01-01T00:00:20.5Z"),
+	}
+	insertedActiveLiveChatMessages, err := c.dbOperator.UpdateActiveLiveChatMessages(append([]*pb.ActiveLiveChatMessage{
+		newFollowActiveLiveChatMessage("fakeMessage01", "2022-01-01T00:00:10Z"),
+	}, activeLiveChatMessages...))
+	if err != nil {
+		t.Fatalf("can not store messages: %v", err)
+	}
+	if len(insertedActiveLiveChatMessages) != len(activeLiveChatMessages) || insertedActiveLiveChatMessages[0].MessageId != "fakeMessage02" {
+		t.Fatalf("unexpected inserted messages: %+v", insertedActiveLiveChatMessages)
+	}
+	cursor.published(insertedActiveLiveChatMessages)
+	storedActiveLiveChatMessages, err := c.readStoredActiveLiveChat(fakeVideoId, 10, &storedCursor)
+	if err != nil {
+		t.Fatalf("can not read stored messages: %v", err)
+	}
+	if len(storedActiveLiveChatMessages) != len(activeLiveChatMessages) {
+		t.Fatalf("count of stored messages = %v, want %v", len(storedActiveLiveChatMessages), len(activeLiveChatMessages))
+	}
+	for i, activeLiveChatMessage := range activeLiveChatMessages {
+		if storedActiveLiveChatMessages[i].MessageId != activeLiveChatMessage.MessageId {
+			t.Errorf("stored messages[%v] = %v, want %v", i, storedActiveLiveChatMessages[i].MessageId, activeLiveChatMessage.MessageId)
+		}
+	}
+	if *cursor != storedCursor {
+		t.Errorf("cursor of published messages = %+v, want %+v", *cursor, storedCursor)
+	}
+}
+
+func TestFollowActiveLiveChatDoesNotSendFetchedAgainMessages(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	fakeServer.AddVideo(fakeyoutube.NewLiveVideo(fakeVideoId, fakeChannelId, "fake live", fakeLiveChatId))
+	c := newFakeYoutubeCollector(t, fakeServer)
+	startResponse, err := c.StartCollectionActiveLiveChat(&pb.StartCollectionActiveLiveChatRequest{
+		VideoId: fakeVideoId,
+	})
+	if err != nil || startResponse.Status.Code != pb.Code_SUCCESS {
+		t.Fatalf("can not start collection: %v, %+v", err, startResponse)
+	}
+
+	responseCh := make(chan *pb.FollowActiveLiveChatResponse, 16)
+	followErrCh := make(chan error, 1)
+	go func() {
+		followErrCh <- c.FollowActiveLiveChat(&pb.FollowActiveLiveChatRequest{
+			VideoId: fakeVideoId,
+			Count:   2,
+		}, func(response *pb.FollowActiveLiveChatResponse) error {
+			responseCh <- response
+			return nil
+		})
+		close(responseCh)
+	}()
+	// second page overlaps with first page as backlog of data api
+	fakeServer.AddLiveChatPage(fakeLiveChatId, &fakeyoutube.LiveChatPage{
+		Messages: []*youtube.LiveChatMessage{
+			fakeyoutube.NewTextMessage("fakeMessage01", fakeLiveChatId, "UCfakeViewer00000000001", "viewer1", "hello"),
+			fakeyoutube.NewTextMessage("fakeMessage02", fakeLiveChatId, "UCfakeViewer00000000002", "viewer2", "world"),
+		},
+		PollingIntervalMillis: fakePollingInterval.Milliseconds(),
+	})
+	fakeServer.AddLiveChatPage(fakeLiveChatId, &fakeyoutube.LiveChatPage{
+		Messages: []*youtube.LiveChatMessage{
+			fakeyoutube.NewTextMessage("fakeMessage02", fakeLiveChatId, "UCfakeViewer00000000002", "viewer2", "world"),
+			fakeyoutube.NewTextMessage("fakeMessage03", fakeLiveChatId, "UCfakeViewer00000000003", "viewer3", "again"),
+		},
+		PollingIntervalMillis: fakePollingInterval.Milliseconds(),
+	})
+	fakeServer.AddLiveChatPage(fakeLiveChatId, &fakeyoutube.LiveChatPage{
+		Messages: []*youtube.LiveChatMessage{
+			fakeyoutube.NewChatEndedMessage("fakeMessage04", fakeLiveChatId),
+		},
+	})
+	fakeServer.EndLiveChat(fakeLiveChatId)
+
+	messageIds := make([]string, 0)
+	lastCursor := ""
+	timer := time.NewTimer(fakeCollectorTimeout)
+	defer timer.Stop()
+	for finished := false; !finished; {
+		select {
+		case response, ok := <-responseCh:
+			if !ok {
+				finished = true
+				break
+			}
+			for _, activeLiveChatMessage := range response.ActiveLiveChatMessages {
+				messageIds = append(messageIds, activeLiveChatMessage.MessageId)
+			}
+			if response.Cursor != "" {
+				lastCursor = response.Cursor
+			}
+		case <-timer.C:
+			t.Fatalf("follow is not finished in %v", fakeCollectorTimeout)
+		}
+	}
+	if err := <-followErrCh; err != nil {
+		t.Fatalf("can not follow active live chat: %v", err)
+	}
+	wantMessageIds := []string{"fakeMessage01", "fakeMessage02", "fakeMessage03", "fakeMessage04"}
+	if len(messageIds) != len(wantMessageIds) {
+		t.Fatalf("followed messages = %v, want %v", messageIds, wantMessageIds)
+	}
+	for i, messageId := range messageIds {
+		if messageId != wantMessageIds[i] {
+			t.Errorf("followed messages[%v] = %v, want %v", i, messageId, wantMessageIds[i])
+		}
+	}
+	// cursor of the last response points the last stored message
+	storedCursor := &followCursor{}
+	if _, err := c.readStoredActiveLiveChat(fakeVideoId, 10, storedCursor); err != nil {
+		t.Fatalf("can not read stored messages: %v", err)
+	}
+	if lastCursor != storedCursor.encode() {
+		t.Errorf("cursor of the last response = %v, want %v", lastCursor, storedCursor.encode())
+	}
+}
@@ -0,0 +1,306 @@
+package collector
+
+import (
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper"
+	"io"
+	"log"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Importer writes live chat saved by other tools to archive live chat
+// it only uses Storage, so it can be used without Collector (e.g. import subcommand)
+type Importer struct {
+	verbose bool
+	storage Storage
+}
+
+func (i *Importer) setChatDownloaderAuthorBadges(archiveLiveChatMessage *pb.ArchiveLiveChatMessage, badges []youtubehelper.ChatDownloaderBadge) {
+	// icon names of chat-downloader are lower case of iconType, member badge has icons instead of icon name
+	authorBadges := make([]*pb.AuthorBadge, 0, len(badges))
+	for _, badge := range badges {
+		iconType := strings.ToUpper(badge.IconName)
+		switch iconType {
+		case "OWNER":
+			archiveLiveChatMessage.AuthorIsChatOwner = true
+		case "MODERATOR":
+			archiveLiveChatMessage.AuthorIsChatModerator = true
+		case "VERIFIED", "CHECK_CIRCLE_THICK":
+			archiveLiveChatMessage.AuthorIsVerified = true
+		case "":
+			if badge.ThumbnailURL() != "" {
+				archiveLiveChatMessage.AuthorIsChatSponsor = true
+			}
+		}
+		authorBadges = append(authorBadges, &pb.AuthorBadge{
+			IconType:     iconType,
+			Tooltip:      badge.Title,
+			ThumbnailUrl: badge.ThumbnailURL(),
+		})
+	}
+	archiveLiveChatMessage.AuthorBadges = authorBadges
+}
+
+func (i *Importer) newArchiveLiveChatMessageFromChatDownloader(message *youtubehelper.ChatDownloaderMessage, channelId string, videoId string) (*pb.ArchiveLiveChatMessage, bool) {
+	if message.MessageID == "" {
+		return nil, false
+	}
+	// ticker items are duplicates of messages
+	if message.ActionType != "" && message.ActionType != "add_chat_item" {
+		return nil, false
+	}
+	timestampUsec := ""
+	if message.Timestamp > 0 {
+		timestampUsec = strconv.FormatInt(message.Timestamp, 10)
+	}
+	archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{
+		MessageId:               message.MessageID,
+		ChannelId:               channelId,
+		VideoId:                 videoId,
+		AuthorName:              message.Author.Name,
+		AuthorExternalChannelId: message.Author.ID,
+		MessageText:             message.Message,
+		TimestampUsec:           timestampUsec,
+		TimestampText:           message.TimeText,
+		VideoOffsetTimeMsec:     strconv.FormatInt(int64(math.Round(message.TimeInSeconds*1000)), 10),
+	}
+	i.setChatDownloaderAuthorBadges(archiveLiveChatMessage, message.Author.Badges)
+	switch message.MessageType {
+	case "text_message":
+		archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_TEXT_MESSAGE
+	case "paid_message":
+		archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_PAID_MESSAGE
+		archiveLiveChatMessage.PurchaseAmountText = message.Money.Text
+		archiveLiveChatMessage.IsPaid = true
+	case "paid_sticker":
+		archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_PAID_STICKER
+		archiveLiveChatMessage.PurchaseAmountText = message.Money.Text
+		archiveLiveChatMessage.IsPaid = true
+	case "membership_item":
+		archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_MEMBERSHIP_ITEM
+		archiveLiveChatMessage.HeaderText = message.HeaderText()
+		archiveLiveChatMessage.AuthorIsChatSponsor = true
+	case "sponsorships_gift_purchase_announcement":
+		archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_PURCHASE
+		archiveLiveChatMessage.HeaderText = message.HeaderText()
+		archiveLiveChatMessage.GiftMembershipsCount = message.GiftMembershipsCount()
+	case "sponsorships_gift_redemption_announcement":
+		archiveLiveChatMessage.MessageType = pb.ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_REDEMPTION
+	default:
+		return nil, false
+	}
+	return archiveLiveChatMessage, true
+}
+
+func (i *Importer) importYtDlp(video *pb.Video, reader io.Reader) (int64, int64, error) {
+	var imported int64
+	var skipped int64
+	err := youtubehelper.ReadYtDlpLiveChat(reader, int(bulkMessageMax), func(resp *youtubehelper.GetLiveChatRespose) error {
+		var actions int64
+		for _, cact := range resp.ContinuationContents.LiveChatContinuation.Actions {
+			actions += int64(len(cact.ReplayChatItemAction.Actions))
+		}
+		archiveLiveChatMessages, _ := newArchiveLiveChatMessages(resp, video.ChannelId, video.VideoId, "")
+		if err := i.storage.UpdateArchiveLiveChatMessages(archiveLiveChatMessages); err != nil {
+			return fmt.Errorf("can not update archive live chat messages in database: %w", err)
+		}
+		imported += int64(len(archiveLiveChatMessages))
+		skipped += actions - int64(len(archiveLiveChatMessages))
+		return nil
+	})
+	return imported, skipped, err
+}
+
+func (i *Importer) importChatDownloader(video *pb.Video, reader io.Reader) (int64, int64, error) {
+	var imported int64
+	var skipped int64
+	err := youtubehelper.ReadChatDownloaderMessages(reader, int(bulkMessageMax), func(messages []*youtubehelper.ChatDownloaderMessage) error {
+		archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0, len(messages))
+		for _, message := range messages {
+			archiveLiveChatMessage, ok := i.newArchiveLiveChatMessageFromChatDownloader(message, video.ChannelId, video.VideoId)
+			if !ok {
+				skipped += 1
+				continue
+			}
+			archiveLiveChatMessages = append(archiveLiveChatMessages, archiveLiveChatMessage)
+		}
+		if err := i.storage.UpdateArchiveLiveChatMessages(archiveLiveChatMessages); err != nil {
+			return fmt.Errorf("can not update archive live chat messages in database: %w", err)
+		}
+		imported += int64(len(archiveLiveChatMessages))
+		return nil
+	})
+	return imported, skipped, err
+}
+
+// getVideo returns stored video, video which has only channelId is stored when it is not found
+func (i *Importer) getVideo(videoId string, channelId string) (*pb.Video, bool, error) {
+	video, ok, err := i.storage.GetVideoByVideoId(videoId)
+	if err != nil {
+		return nil, false, err
+	}
+	if ok {
+		return video, true, nil
+	}
+	if channelId == "" {
+		return nil, false, nil
+	}
+	video = &pb.Video{
+		VideoId:   videoId,
+		ChannelId: channelId,
+	}
+	if err := i.storage.UpdateVideo(video); err != nil {
+		return nil, false, err
+	}
+	return video, true, nil
+}
+
+// Import reads file of the format from reader and writes messages to archiveLiveChatMessage
+func (i *Importer) Import(videoId string, channelId string, format pb.ImportFormat, reader io.Reader) *pb.ImportArchiveLiveChatResponse {
+	status := new(pb.Status)
+	if videoId == "" {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = "no videoId"
+		return &pb.ImportArchiveLiveChatResponse{
+			Status: status,
+		}
+	}
+	video, ok, err := i.getVideo(videoId, channelId)
+	if err != nil {
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, videoId)
+		return &pb.ImportArchiveLiveChatResponse{
+			Status: status,
+		}
+	}
+	if !ok {
+		status.Code = pb.Code_NOT_FOUND
+		status.Message = fmt.Sprintf("not found videoId, channelId is required (videoId = %v)", videoId)
+		return &pb.ImportArchiveLiveChatResponse{
+			Status: status,
+		}
+	}
+	var imported int64
+	var skipped int64
+	switch format {
+	case pb.ImportFormat_YT_DLP_IMPORT_FORMAT:
+		imported, skipped, err = i.importYtDlp(video, reader)
+	case pb.ImportFormat_CHAT_DOWNLOADER_IMPORT_FORMAT:
+		imported, skipped, err = i.importChatDownloader(video, reader)
+	default:
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = fmt.Sprintf("unsupported format (format = %v)", format)
+		return &pb.ImportArchiveLiveChatResponse{
+			Status: status,
+			Video:  video,
+		}
+	}
+	if i.verbose {
+		log.Printf("imported archive live chat (videoId = %v, format = %v, imported = %v, skipped = %v)", videoId, format, imported, skipped)
+	}
+	if err != nil {
+		// messages before the error are kept
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, videoId)
+		return &pb.ImportArchiveLiveChatResponse{
+			Status:   status,
+			Video:    video,
+			Imported: imported,
+			Skipped:  skipped,
+		}
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (videoId = %v)", videoId)
+	return &pb.ImportArchiveLiveChatResponse{
+		Status:   status,
+		Video:    video,
+		Imported: imported,
+		Skipped:  skipped,
+	}
+}
+
+func NewImporter(storage Storage, opts ...Option) *Importer {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &Importer{
+		verbose: baseOpts.verbose,
+		storage: storage,
+	}
+}
+
+// ImportArchiveLiveChat receives chunks of file by recvFunc until io.EOF and imports them,
+// video is fetched from youtube when it is not stored
+func (c *Collector) ImportArchiveLiveChat(recvFunc func() (*pb.ImportArchiveLiveChatRequest, error)) (*pb.ImportArchiveLiveChatResponse, error) {
+	status := new(pb.Status)
+	request, err := recvFunc()
+	if err == io.EOF {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = "no request"
+		return &pb.ImportArchiveLiveChatResponse{
+			Status: status,
+		}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("can not receive request: %w", err)
+	}
+	if request.VideoId != "" {
+		_, ok, err := c.dbOperator.GetVideoByVideoId(request.VideoId)
+		if err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+			return &pb.ImportArchiveLiveChatResponse{
+				Status: status,
+			}, nil
+		}
+		if !ok {
+			youtubeVideo, ok, err := c.chatSource.GetVideo(request.VideoId)
+			if err != nil {
+				log.Printf("can not get video, channelId of request is used (videoId = %v): %v", request.VideoId, err)
+			} else if ok {
+				if err := c.dbOperator.UpdateVideo(c.createVideo(youtubeVideo)); err != nil {
+					status.Code = pb.Code_INTERNAL_ERROR
+					status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+					return &pb.ImportArchiveLiveChatResponse{
+						Status: status,
+					}, nil
+				}
+			}
+		}
+	}
+	reader, writer := io.Pipe()
+	doneCh := make(chan struct{})
+	go func() {
+		defer close(doneCh)
+		chunk := request.Chunk
+		for {
+			if len(chunk) > 0 {
+				if _, err := writer.Write(chunk); err != nil {
+					// importer was finished
+					return
+				}
+			}
+			nextRequest, err := recvFunc()
+			if err == io.EOF {
+				writer.Close()
+				return
+			}
+			if err != nil {
+				writer.CloseWithError(fmt.Errorf("can not receive request: %w", err))
+				return
+			}
+			chunk = nextRequest.Chunk
+		}
+	}()
+	response := NewImporter(c.dbOperator, Verbose(c.verbose)).Import(request.VideoId, request.ChannelId, request.Format, reader)
+	reader.Close()
+	<-doneCh
+	return response, nil
+}
@@ -0,0 +1,191 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package collector
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper"
+)
+
+// fixtures of chat log are shared with tests of youtubehelper
+const (
+	importFixtureDir       = "../youtubehelper/testdata/chatlog"
+	importFixtureVideoId   = "fixtureVid01"
+	importFixtureChannelId = "UCfixtureChannel0000001"
+)
+
+func openImportFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+	file, err := os.Open(filepath.Join(importFixtureDir, name))
+	if err != nil {
+		t.Fatalf("can not open fixture (name = %v): %v", name, err)
+	}
+	t.Cleanup(func() { file.Close() })
+	return file
+}
+
+// importFixture imports fixture to empty database and returns response and stored messages in order of position
+func importFixture(t *testing.T, format pb.ImportFormat, name string) (*pb.ImportArchiveLiveChatResponse, []*pb.ArchiveLiveChatMessage) {
+	t.Helper()
+	d, err := NewDatabaseOperator(filepath.Join(t.TempDir(), "ylcc.db"))
+	if err != nil {
+		t.Fatalf("can not create database operator: %v", err)
+	}
+	if err := d.Open(); err != nil {
+		t.Fatalf("can not open database: %v", err)
+	}
+	t.Cleanup(d.Close)
+	response := NewImporter(d).Import(importFixtureVideoId, importFixtureChannelId, format, openImportFixture(t, name))
+	if response.Status.Code != pb.Code_SUCCESS {
+		t.Fatalf("import status = %v, want SUCCESS: %v", response.Status.Code, response.Status.Message)
+	}
+	pagedArchiveLiveChatMessages, err := d.GetArchiveLiveChatMessagesByPosition(&LiveChatPageCondition{
+		VideoId: importFixtureVideoId,
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not get imported messages: %v", err)
+	}
+	archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0, len(pagedArchiveLiveChatMessages))
+	for _, pagedArchiveLiveChatMessage := range pagedArchiveLiveChatMessages {
+		archiveLiveChatMessages = append(archiveLiveChatMessages, pagedArchiveLiveChatMessage.ArchiveLiveChatMessage)
+	}
+	return response, archiveLiveChatMessages
+}
+
+func TestImportYtDlp(t *testing.T) {
+	response, archiveLiveChatMessages := importFixture(t, pb.ImportFormat_YT_DLP_IMPORT_FORMAT, "ytdlp.live_chat.json")
+	// ticker item is skipped
+	if response.Imported != 3 || response.Skipped != 1 {
+		t.Errorf("(imported, skipped) = (%v, %v), want (3, 1)", response.Imported, response.Skipped)
+	}
+	if response.Video.VideoId != importFixtureVideoId || response.Video.ChannelId != importFixtureChannelId {
+		t.Errorf("unexpected video: %+v", response.Video)
+	}
+	if len(archiveLiveChatMessages) != 3 {
+		t.Fatalf("count of stored messages = %v, want 3", len(archiveLiveChatMessages))
+	}
+	text := archiveLiveChatMessages[0]
+	if text.MessageId != "fixtureYtDlpText01" || text.MessageType != pb.ArchiveLiveChatMessageType_TEXT_MESSAGE ||
+		text.MessageText != "hello fixture" || text.VideoOffsetTimeMsec != "1500" || text.ClientId != "fixtureClient01" ||
+		text.ChannelId != importFixtureChannelId || text.VideoId != importFixtureVideoId {
+		t.Errorf("unexpected text message: %+v", text)
+	}
+	if !text.AuthorIsChatModerator || text.AuthorIsChatOwner || text.AuthorIsChatSponsor ||
+		len(text.AuthorBadges) != 1 || text.AuthorBadges[0].IconType != "MODERATOR" {
+		t.Errorf("unexpected role of moderator: %+v", text)
+	}
+	paid := archiveLiveChatMessages[1]
+	if paid.MessageId != "fixtureYtDlpPaid01" || paid.MessageType != pb.ArchiveLiveChatMessageType_PAID_MESSAGE ||
+		!paid.IsPaid || paid.PurchaseAmountText != "$5.00" || paid.MessageText != "thanks" || paid.VideoOffsetTimeMsec != "2500" {
+		t.Errorf("unexpected paid message: %+v", paid)
+	}
+	if !paid.AuthorIsChatSponsor || paid.AuthorIsChatModerator ||
+		len(paid.AuthorBadges) != 1 || paid.AuthorBadges[0].ThumbnailUrl != "https://yt3.ggpht.com/fixture-member-badge=s16-c-k" {
+		t.Errorf("unexpected role of member: %+v", paid)
+	}
+	if owner := archiveLiveChatMessages[2]; owner.MessageId != "fixtureYtDlpText02" || !owner.AuthorIsChatOwner {
+		t.Errorf("unexpected message of owner: %+v", owner)
+	}
+}
+
+func TestImportChatDownloader(t *testing.T) {
+	response, archiveLiveChatMessages := importFixture(t, pb.ImportFormat_CHAT_DOWNLOADER_IMPORT_FORMAT, "chatdownloader.json")
+	// ticker item, unsupported message type and message without messageId are skipped
+	if response.Imported != 4 || response.Skipped != 3 {
+		t.Errorf("(imported, skipped) = (%v, %v), want (4, 3)", response.Imported, response.Skipped)
+	}
+	wantMessageIds := []string{"fixtureChatDownloaderText01", "fixtureChatDownloaderPaid01", "fixtureChatDownloaderMember01", "fixtureChatDownloaderGift01"}
+	if len(archiveLiveChatMessages) != len(wantMessageIds) {
+		t.Fatalf("count of stored messages = %v, want %v", len(archiveLiveChatMessages), len(wantMessageIds))
+	}
+	for i, archiveLiveChatMessage := range archiveLiveChatMessages {
+		if archiveLiveChatMessage.MessageId != wantMessageIds[i] {
+			t.Errorf("stored messages[%v] = %v, want %v", i, archiveLiveChatMessage.MessageId, wantMessageIds[i])
+		}
+	}
+}
+
+func TestNewArchiveLiveChatMessageFromChatDownloader(t *testing.T) {
+	i := NewImporter(nil)
+	chatDownloaderMessages := make([]*youtubehelper.ChatDownloaderMessage, 0)
+	err := youtubehelper.ReadChatDownloaderMessages(openImportFixture(t, "chatdownloader.json"), 10, func(messages []*youtubehelper.ChatDownloaderMessage) error {
+		chatDownloaderMessages = append(chatDownloaderMessages, messages...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("can not read fixture: %v", err)
+	}
+	archiveLiveChatMessages := make([]*pb.ArchiveLiveChatMessage, 0, len(chatDownloaderMessages))
+	for _, chatDownloaderMessage := range chatDownloaderMessages {
+		archiveLiveChatMessage, ok := i.newArchiveLiveChatMessageFromChatDownloader(chatDownloaderMessage, importFixtureChannelId, importFixtureVideoId)
+		if !ok {
+			archiveLiveChatMessage = nil
+		}
+		archiveLiveChatMessages = append(archiveLiveChatMessages, archiveLiveChatMessage)
+	}
+	if len(archiveLiveChatMessages) != 7 {
+		t.Fatalf("count of messages = %v, want 7", len(archiveLiveChatMessages))
+	}
+
+	text := archiveLiveChatMessages[0]
+	if text == nil {
+		t.Fatalf("text message is skipped")
+	}
+	// 12.3456 seconds is rounded to milliseconds
+	if text.MessageType != pb.ArchiveLiveChatMessageType_TEXT_MESSAGE || text.MessageText != "hello fixture" ||
+		text.VideoOffsetTimeMsec != "12346" || text.TimestampUsec != "1640995212345600" || text.TimestampText != "0:12" ||
+		text.AuthorName != "Fixture Moderator" || text.AuthorExternalChannelId != "UCfixtureModerator00001" ||
+		text.ChannelId != importFixtureChannelId || text.VideoId != importFixtureVideoId || text.IsPaid {
+		t.Errorf("unexpected text message: %+v", text)
+	}
+	// icon names are mapped to icon types of youtube
+	if !text.AuthorIsChatModerator || !text.AuthorIsVerified || text.AuthorIsChatOwner || text.AuthorIsChatSponsor ||
+		len(text.AuthorBadges) != 2 || text.AuthorBadges[0].IconType != "MODERATOR" || text.AuthorBadges[0].Tooltip != "Moderator" ||
+		text.AuthorBadges[1].IconType != "VERIFIED" {
+		t.Errorf("unexpected role of moderator: %+v", text)
+	}
+
+	paid := archiveLiveChatMessages[1]
+	if paid == nil {
+		t.Fatalf("paid message is skipped")
+	}
+	// 20.0004 seconds is rounded down
+	if paid.MessageType != pb.ArchiveLiveChatMessageType_PAID_MESSAGE || !paid.IsPaid || paid.PurchaseAmountText != "¥1,000" ||
+		paid.MessageText != "thanks" || paid.VideoOffsetTimeMsec != "20000" {
+		t.Errorf("unexpected paid message: %+v", paid)
+	}
+	// member badge has icons instead of icon name
+	if !paid.AuthorIsChatSponsor || paid.AuthorIsChatModerator || len(paid.AuthorBadges) != 1 ||
+		paid.AuthorBadges[0].IconType != "" || paid.AuthorBadges[0].ThumbnailUrl != "https://yt3.ggpht.com/fixture-member-badge=s16-c-k" {
+		t.Errorf("unexpected role of member: %+v", paid)
+	}
+
+	if ticker := archiveLiveChatMessages[2]; ticker != nil {
+		t.Errorf("ticker item is not skipped: %+v", ticker)
+	}
+
+	membership := archiveLiveChatMessages[3]
+	if membership == nil || membership.MessageType != pb.ArchiveLiveChatMessageType_MEMBERSHIP_ITEM ||
+		membership.HeaderText != "Welcome to Fixture Channel!" || !membership.AuthorIsChatSponsor || membership.VideoOffsetTimeMsec != "30000" {
+		t.Errorf("unexpected membership item: %+v", membership)
+	}
+
+	gift := archiveLiveChatMessages[4]
+	if gift == nil || gift.MessageType != pb.ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_PURCHASE ||
+		gift.HeaderText != "Gifted 5 Fixture Channel memberships" || gift.GiftMembershipsCount != 5 || !gift.AuthorIsChatOwner || gift.IsPaid {
+		t.Errorf("unexpected gift purchase: %+v", gift)
+	}
+
+	if engagement := archiveLiveChatMessages[5]; engagement != nil {
+		t.Errorf("unsupported message type is not skipped: %+v", engagement)
+	}
+	if noMessageId := archiveLiveChatMessages[6]; noMessageId != nil {
+		t.Errorf("message without messageId is not skipped: %+v", noMessageId)
+	}
+}
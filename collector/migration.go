@@ -0,0 +1,630 @@
+package collector
+
+import (
+	"database/sql"
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"log"
+	"time"
+)
+
+// Migration is versioned change of schema.
+// up must be idempotent, because databases created before versioning already have some of changes.
+// up runs in the transaction which records version, so failed migration leaves no change.
+type Migration struct {
+	Version     int
+	Description string
+	up          func(d *DatabaseOperator, tx *sql.Tx) error
+}
+
+// column types are valid in both sqlite and postgres, BIGINT has integer affinity in sqlite
+// migrations are ordered by version, append new migration to the end and never change applied one
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "create video, activeLiveChatMessage and archiveLiveChatMessage tables",
+		up:          migrateCreateLiveChatTables,
+	},
+	{
+		Version:     2,
+		Description: "add event columns to activeLiveChatMessage",
+		up:          migrateAddActiveLiveChatMessageEventColumns,
+	},
+	{
+		Version:     3,
+		Description: "add renderer columns to archiveLiveChatMessage",
+		up:          migrateAddArchiveLiveChatMessageRendererColumns,
+	},
+	{
+		Version:     4,
+		Description: "create activeLiveChatCollection table",
+		up:          migrateCreateActiveLiveChatCollectionTable,
+	},
+	{
+		Version:     5,
+		Description: "add backend column to activeLiveChatCollection",
+		up:          migrateAddActiveLiveChatCollectionBackendColumn,
+	},
+	{
+		Version:     6,
+		Description: "create watchedChannel table",
+		up:          migrateCreateWatchedChannelTable,
+	},
+	{
+		Version:     7,
+		Description: "create pinnedVideo table",
+		up:          migrateCreatePinnedVideoTable,
+	},
+	{
+		Version:     8,
+		Description: "create full text search index of activeLiveChatMessage and archiveLiveChatMessage",
+		up:          migrateCreateLiveChatFullTextSearchIndex,
+	},
+	{
+		Version:     9,
+		Description: "create author index of activeLiveChatMessage and archiveLiveChatMessage",
+		up:          migrateCreateLiveChatAuthorIndex,
+	},
+	{
+		Version:     10,
+		Description: "add position column and position index to activeLiveChatMessage and archiveLiveChatMessage",
+		up:          migrateAddLiveChatPositionColumn,
+	},
+	{
+		Version:     11,
+		Description: "add amount columns to archiveLiveChatMessage and add position to author index of activeLiveChatMessage and archiveLiveChatMessage",
+		up:          migrateAddArchiveLiveChatMessageAmountColumns,
+	},
+	{
+		Version:     12,
+		Description: "add owner column to activeLiveChatCollection and watchedChannel",
+		up:          migrateAddOwnerColumns,
+	},
+}
+
+func migrateCreateLiveChatTables(d *DatabaseOperator, tx *sql.Tx) error {
+	videoTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS video (
+                videoId            TEXT PRIMARY KEY,
+                channelId          TEXT NOT NULL,
+		categoryId         TEXT NOT NULL,
+                title              TEXT NOT NULL,
+                description        TEXT NOT NULL,
+		publishedAt        TEXT NOT NULL,
+		duration           TEXT NOT NULL,
+		activeLiveChatId   TEXT NOT NULL,
+		actualStartTime    TEXT NOT NULL,
+		actualEndtime      TEXT NOT NULL,
+		scheduledStartTime TEXT NOT NULL,
+		scheduledEndTime   TEXT NOT NULL,
+		privacyStatus      TEXT NOT NULL,
+		uploadStatus       TEXT NOT NULL,
+		embeddable         TEXT NOT NULL,
+		lastUpdate         BIGINT NOT NULL
+	)`
+	_, err := d.txExec(tx, videoTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create video table: %w", err)
+	}
+	videoLastUpdateIndexQuery := `CREATE INDEX IF NOT EXISTS videoLastUpdateIndex ON video(lastUpdate)`
+	_, err = d.txExec(tx, videoLastUpdateIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create lastUpdate index of video: %w", err)
+	}
+
+	activeLiveChatMessageTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS activeLiveChatMessage (
+                messageId             TEXT PRIMARY KEY,
+		channelId             TEXT NOT NULL,
+		videoId               TEXT NOT NULL,
+		apiEtag               TEXT NOT NULL,
+		authorChannelId       TEXT NOT NULL,
+		authorChannelUrl      TEXT NOT NULL,
+		authorDisplayName     TEXT NOT NULL,
+		authorIsChatModerator TEXT NOT NULL,
+		authorIsChatOwner     TEXT NOT NULL,
+		authorIsChatSponsor   TEXT NOT NULL,
+		authorIsVerified      TEXT NOT NULL,
+		liveChatId            TEXT NOT NULL,
+		displayMessage        TEXT NOT NULL,
+		publishedAt           TEXT NOT NULL,
+		isSuperChat           BIGINT NOT NULL,
+		isSuperSticker        BIGINT NOT NULL,
+		isFanFundingEvent     BIGINT NOT NULL,
+		amountMicros          TEXT NOT NULL,
+		amountDisplayString   TEXT NOT NULL,
+		currency              TEXT NOT NULL,
+		pageToken             TEXT NOT NULL,
+		lastUpdate            BIGINT NOT NULL
+	)`
+	_, err = d.txExec(tx, activeLiveChatMessageTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create activeLiveChatMessage table: %w", err)
+	}
+	activeLiveChatMessageVideoIdIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageVideoIdIndex ON activeLiveChatMessage(videoId)`
+	_, err = d.txExec(tx, activeLiveChatMessageVideoIdIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create videoId index of avtiveLiveChatMessage: %w", err)
+	}
+	activeLiveChatMessageChannelIdIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageChannelIdIndex ON activeLiveChatMessage(channelId)`
+	_, err = d.txExec(tx, activeLiveChatMessageChannelIdIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create channelId index of activeLiveChatMessage: %w", err)
+	}
+	activeLiveChatMessageLastUpdateIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageLastUPdateIndex ON activeLiveChatMessage(lastUpdate)`
+	_, err = d.txExec(tx, activeLiveChatMessageLastUpdateIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create lastUpdate index of activeLiveChatMessage: %w", err)
+	}
+
+	archiveLiveChatMessageTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS archiveLiveChatMessage (
+		messageId               TEXT PRIMARY KEY,
+		channelId               TEXT NOT NULL,
+		videoId                 TEXT NOT NULL,
+		clientId                TEXT NOT NULL,
+		authorName              TEXT NOT NULL,
+		authorExternalChannelId TEXT NOT NULL,
+		messageText             TEXT NOT NULL,
+		purchaseAmountText      TEXT NOT NULL,
+		isPaid                  BIGINT NOT NULL,
+		timestampUsec           TEXT NOT NULL,
+		timestampText           TEXT NOT NULL,
+		videoOffsetTimeMsec     TEXT NOT NULL,
+		continuation            TEXT NOT NULL,
+		lastUpdate              BIGINT NOT NULL
+	)`
+	_, err = d.txExec(tx, archiveLiveChatMessageTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create archiveLiveChatMessage table: %w", err)
+	}
+	archiveLiveChatMessageVideoIdIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageVideoIdIndex ON archiveLiveChatMessage(videoId)`
+	_, err = d.txExec(tx, archiveLiveChatMessageVideoIdIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create vodeoId index of archiveLiveChatMessage: %w", err)
+	}
+	archiveLiveChatMessageChannelIdIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageChannelIdIndex ON archiveLiveChatMessage(channelId)`
+	_, err = d.txExec(tx, archiveLiveChatMessageChannelIdIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create channelId index archiveLiveChatMessage: %w", err)
+	}
+	archiveLiveChatMessageLastUpdateIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageLastUPdateIndex ON archiveLiveChatMessage(lastUpdate)`
+	_, err = d.txExec(tx, archiveLiveChatMessageLastUpdateIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create lastUPdate of archiveLiveChatMessage: %w", err)
+	}
+	return nil
+}
+
+func migrateAddActiveLiveChatMessageEventColumns(d *DatabaseOperator, tx *sql.Tx) error {
+	activeLiveChatMessageEventColumns := [][]string{
+		{"eventType", "BIGINT NOT NULL DEFAULT 0"},
+		{"memberLevelName", "TEXT NOT NULL DEFAULT ''"},
+		{"memberMonth", "BIGINT NOT NULL DEFAULT 0"},
+		{"isUpgrade", "BIGINT NOT NULL DEFAULT 0"},
+		{"giftMembershipsCount", "BIGINT NOT NULL DEFAULT 0"},
+		{"gifterChannelId", "TEXT NOT NULL DEFAULT ''"},
+		{"associatedMembershipGiftingMessageId", "TEXT NOT NULL DEFAULT ''"},
+		{"targetMessageId", "TEXT NOT NULL DEFAULT ''"},
+		{"bannedUserChannelId", "TEXT NOT NULL DEFAULT ''"},
+		{"bannedUserDisplayName", "TEXT NOT NULL DEFAULT ''"},
+		{"banType", "TEXT NOT NULL DEFAULT ''"},
+		{"banDurationSeconds", "BIGINT NOT NULL DEFAULT 0"},
+		{"isDeleted", "BIGINT NOT NULL DEFAULT 0"},
+		{"isAuthorBanned", "BIGINT NOT NULL DEFAULT 0"},
+	}
+	for _, column := range activeLiveChatMessageEventColumns {
+		if err := d.addColumnIfNotExists(tx, "activeLiveChatMessage", column[0], column[1]); err != nil {
+			return fmt.Errorf("can not upgrade activeLiveChatMessage table: %w", err)
+		}
+	}
+	return nil
+}
+
+func migrateAddArchiveLiveChatMessageRendererColumns(d *DatabaseOperator, tx *sql.Tx) error {
+	archiveLiveChatMessageRendererColumns := [][]string{
+		{"messageType", "BIGINT NOT NULL DEFAULT 0"},
+		{"authorIsChatModerator", "BIGINT NOT NULL DEFAULT 0"},
+		{"authorIsChatOwner", "BIGINT NOT NULL DEFAULT 0"},
+		{"authorIsChatSponsor", "BIGINT NOT NULL DEFAULT 0"},
+		{"authorIsVerified", "BIGINT NOT NULL DEFAULT 0"},
+		{"authorBadges", "TEXT NOT NULL DEFAULT ''"},
+		{"headerText", "TEXT NOT NULL DEFAULT ''"},
+		{"giftMembershipsCount", "BIGINT NOT NULL DEFAULT 0"},
+	}
+	for _, column := range archiveLiveChatMessageRendererColumns {
+		if err := d.addColumnIfNotExists(tx, "archiveLiveChatMessage", column[0], column[1]); err != nil {
+			return fmt.Errorf("can not upgrade archiveLiveChatMessage table: %w", err)
+		}
+	}
+	return nil
+}
+
+func migrateCreateActiveLiveChatCollectionTable(d *DatabaseOperator, tx *sql.Tx) error {
+	activeLiveChatCollectionTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS activeLiveChatCollection (
+		videoId          TEXT PRIMARY KEY,
+		activeLiveChatId TEXT NOT NULL,
+		pageToken        TEXT NOT NULL,
+		startedAt        BIGINT NOT NULL,
+		lastUpdate       BIGINT NOT NULL
+	)`
+	_, err := d.txExec(tx, activeLiveChatCollectionTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create activeLiveChatCollection table: %w", err)
+	}
+	return nil
+}
+
+func migrateAddActiveLiveChatCollectionBackendColumn(d *DatabaseOperator, tx *sql.Tx) error {
+	if err := d.addColumnIfNotExists(tx, "activeLiveChatCollection", "backend", "BIGINT NOT NULL DEFAULT 0"); err != nil {
+		return fmt.Errorf("can not upgrade activeLiveChatCollection table: %w", err)
+	}
+	return nil
+}
+
+func migrateCreateWatchedChannelTable(d *DatabaseOperator, tx *sql.Tx) error {
+	watchedChannelTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS watchedChannel (
+		channelId  TEXT PRIMARY KEY,
+		backend    BIGINT NOT NULL,
+		lastUpdate BIGINT NOT NULL
+	)`
+	_, err := d.txExec(tx, watchedChannelTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create watchedChannel table: %w", err)
+	}
+	return nil
+}
+
+func migrateCreatePinnedVideoTable(d *DatabaseOperator, tx *sql.Tx) error {
+	pinnedVideoTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS pinnedVideo (
+		videoId    TEXT PRIMARY KEY,
+		pinnedAt   BIGINT NOT NULL,
+		lastUpdate BIGINT NOT NULL
+	)`
+	_, err := d.txExec(tx, pinnedVideoTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create pinnedVideo table: %w", err)
+	}
+	return nil
+}
+
+func migrateCreateLiveChatFullTextSearchIndex(d *DatabaseOperator, tx *sql.Tx) error {
+	liveChatMessageColumns := [][]string{
+		{"activeLiveChatMessage", "displayMessage"},
+		{"archiveLiveChatMessage", "messageText"},
+	}
+	for _, column := range liveChatMessageColumns {
+		for _, query := range d.dialect.fullTextSearchIndexQueries(column[0], column[1]) {
+			if _, err := d.txExec(tx, query); err != nil {
+				return fmt.Errorf("can not create full text search index of %v (sqlite3 has to be built with -tags sqlite_fts5): %w", column[0], err)
+			}
+		}
+	}
+	return nil
+}
+
+func migrateCreateLiveChatAuthorIndex(d *DatabaseOperator, tx *sql.Tx) error {
+	// statistics looks up past messages of author in channel
+	activeLiveChatMessageAuthorIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageAuthorIndex ON activeLiveChatMessage(channelId, authorChannelId)`
+	_, err := d.txExec(tx, activeLiveChatMessageAuthorIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create author index of activeLiveChatMessage: %w", err)
+	}
+	archiveLiveChatMessageAuthorIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageAuthorIndex ON archiveLiveChatMessage(channelId, authorExternalChannelId)`
+	_, err = d.txExec(tx, archiveLiveChatMessageAuthorIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create author index of archiveLiveChatMessage: %w", err)
+	}
+	return nil
+}
+
+// migrateAddLiveChatPositionColumn stores position used by paging in column, so paging reads index instead of sorting all messages of video
+func migrateAddLiveChatPositionColumn(d *DatabaseOperator, tx *sql.Tx) error {
+	for _, table := range []string{"activeLiveChatMessage", "archiveLiveChatMessage"} {
+		if err := d.addColumnIfNotExists(tx, table, "position", "BIGINT NOT NULL DEFAULT 0"); err != nil {
+			return fmt.Errorf("can not upgrade %v table: %w", table, err)
+		}
+	}
+	_, err := d.txExec(tx, `UPDATE archiveLiveChatMessage SET position = CAST(timestampUsec AS BIGINT) WHERE position = 0 AND timestampUsec != ''`)
+	if err != nil {
+		return fmt.Errorf("can not set position of archiveLiveChatMessage: %w", err)
+	}
+	// position of activeLiveChatMessage has precision of microseconds which can not be computed by sql of both databases
+	if err := d.setActiveLiveChatMessagePositions(tx); err != nil {
+		return err
+	}
+	activeLiveChatMessagePositionIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessagePositionIndex ON activeLiveChatMessage(videoId, position, messageId)`
+	_, err = d.txExec(tx, activeLiveChatMessagePositionIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create position index of activeLiveChatMessage: %w", err)
+	}
+	archiveLiveChatMessagePositionIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessagePositionIndex ON archiveLiveChatMessage(videoId, position, messageId)`
+	_, err = d.txExec(tx, archiveLiveChatMessagePositionIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create position index of archiveLiveChatMessage: %w", err)
+	}
+	return nil
+}
+
+// migrateAddArchiveLiveChatMessageAmountColumns stores amount parsed from purchaseAmountText, so totals of super chat are summed by database.
+// author index is replaced with the one including position, so previous messages of author are looked up by index
+func migrateAddArchiveLiveChatMessageAmountColumns(d *DatabaseOperator, tx *sql.Tx) error {
+	if err := d.addColumnIfNotExists(tx, "archiveLiveChatMessage", "amountMicros", "BIGINT NOT NULL DEFAULT 0"); err != nil {
+		return fmt.Errorf("can not upgrade archiveLiveChatMessage table: %w", err)
+	}
+	if err := d.addColumnIfNotExists(tx, "archiveLiveChatMessage", "currency", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return fmt.Errorf("can not upgrade archiveLiveChatMessage table: %w", err)
+	}
+	if err := d.setArchiveLiveChatMessageAmounts(tx); err != nil {
+		return err
+	}
+	activeLiveChatMessageAuthorIndexQuery := `CREATE INDEX IF NOT EXISTS activeLiveChatMessageAuthorPositionIndex ON activeLiveChatMessage(channelId, authorChannelId, position)`
+	_, err := d.txExec(tx, activeLiveChatMessageAuthorIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create author position index of activeLiveChatMessage: %w", err)
+	}
+	archiveLiveChatMessageAuthorIndexQuery := `CREATE INDEX IF NOT EXISTS archiveLiveChatMessageAuthorPositionIndex ON archiveLiveChatMessage(channelId, authorExternalChannelId, position)`
+	_, err = d.txExec(tx, archiveLiveChatMessageAuthorIndexQuery)
+	if err != nil {
+		return fmt.Errorf("can not create author position index of archiveLiveChatMessage: %w", err)
+	}
+	for _, index := range []string{"activeLiveChatMessageAuthorIndex", "archiveLiveChatMessageAuthorIndex"} {
+		_, err = d.txExec(tx, fmt.Sprintf(`DROP INDEX IF EXISTS %v`, index))
+		if err != nil {
+			return fmt.Errorf("can not drop %v: %w", index, err)
+		}
+	}
+	return nil
+}
+
+// migrateAddOwnerColumns records instance which collects video or watches channel, so instances sharing database do not resume the same rows
+func migrateAddOwnerColumns(d *DatabaseOperator, tx *sql.Tx) error {
+	for _, table := range []string{"activeLiveChatCollection", "watchedChannel"} {
+		if err := d.addColumnIfNotExists(tx, table, "owner", "TEXT NOT NULL DEFAULT ''"); err != nil {
+			return fmt.Errorf("can not upgrade %v table: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// setArchiveLiveChatMessageAmounts sets amount of messages stored before amount columns are added
+func (d *DatabaseOperator) setArchiveLiveChatMessageAmounts(tx *sql.Tx) error {
+	const batchSize = 1000
+	type messageAmount struct {
+		messageId    string
+		currency     string
+		amountMicros int64
+	}
+	lastMessageId := ""
+	for {
+		rows, err := tx.Query(d.dialect.rebind(`SELECT messageId, purchaseAmountText FROM archiveLiveChatMessage WHERE purchaseAmountText <> '' AND messageId > ? ORDER BY messageId LIMIT ?`), lastMessageId, batchSize)
+		if err != nil {
+			return fmt.Errorf("can not get purchaseAmountText of archiveLiveChatMessage: %w", err)
+		}
+		messageAmounts := make([]*messageAmount, 0, batchSize)
+		for rows.Next() {
+			archiveLiveChatMessage := &pb.ArchiveLiveChatMessage{}
+			if err := rows.Scan(&archiveLiveChatMessage.MessageId, &archiveLiveChatMessage.PurchaseAmountText); err != nil {
+				rows.Close()
+				return fmt.Errorf("can not scan purchaseAmountText of archiveLiveChatMessage: %w", err)
+			}
+			currency, amountMicros := archiveLiveChatMessageAmount(archiveLiveChatMessage)
+			messageAmounts = append(messageAmounts, &messageAmount{
+				messageId:    archiveLiveChatMessage.MessageId,
+				currency:     currency,
+				amountMicros: amountMicros,
+			})
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("can not iterate purchaseAmountText of archiveLiveChatMessage: %w", err)
+		}
+		rows.Close()
+		for _, messageAmount := range messageAmounts {
+			if _, err := d.txExec(tx, `UPDATE archiveLiveChatMessage SET amountMicros = ?, currency = ? WHERE messageId = ?`,
+				messageAmount.amountMicros, messageAmount.currency, messageAmount.messageId); err != nil {
+				return fmt.Errorf("can not set amount of archiveLiveChatMessage: %w", err)
+			}
+			lastMessageId = messageAmount.messageId
+		}
+		if len(messageAmounts) < batchSize {
+			return nil
+		}
+	}
+}
+
+// setActiveLiveChatMessagePositions sets position of messages stored before position column is added
+func (d *DatabaseOperator) setActiveLiveChatMessagePositions(tx *sql.Tx) error {
+	const batchSize = 1000
+	type messagePosition struct {
+		messageId string
+		position  int64
+	}
+	lastMessageId := ""
+	for {
+		rows, err := tx.Query(d.dialect.rebind(`SELECT messageId, publishedAt FROM activeLiveChatMessage WHERE position = 0 AND messageId > ? ORDER BY messageId LIMIT ?`), lastMessageId, batchSize)
+		if err != nil {
+			return fmt.Errorf("can not get publishedAt of activeLiveChatMessage: %w", err)
+		}
+		messagePositions := make([]*messagePosition, 0, batchSize)
+		for rows.Next() {
+			activeLiveChatMessage := &pb.ActiveLiveChatMessage{}
+			if err := rows.Scan(&activeLiveChatMessage.MessageId, &activeLiveChatMessage.PublishedAt); err != nil {
+				rows.Close()
+				return fmt.Errorf("can not scan publishedAt of activeLiveChatMessage: %w", err)
+			}
+			messagePositions = append(messagePositions, &messagePosition{
+				messageId: activeLiveChatMessage.MessageId,
+				position:  activeLiveChatMessagePosition(activeLiveChatMessage),
+			})
+		}
+		if err := rows.Err(); err != nil {
+			rows.Close()
+			return fmt.Errorf("can not iterate publishedAt of activeLiveChatMessage: %w", err)
+		}
+		rows.Close()
+		for _, messagePosition := range messagePositions {
+			if _, err := d.txExec(tx, `UPDATE activeLiveChatMessage SET position = ? WHERE messageId = ?`, messagePosition.position, messagePosition.messageId); err != nil {
+				return fmt.Errorf("can not set position of activeLiveChatMessage: %w", err)
+			}
+			lastMessageId = messagePosition.messageId
+		}
+		if len(messagePositions) < batchSize {
+			return nil
+		}
+	}
+}
+
+func (d *DatabaseOperator) createSchemaVersionTable() error {
+	schemaVersionTableCreateQuery := `
+            CREATE TABLE IF NOT EXISTS schemaVersion (
+		version     BIGINT PRIMARY KEY,
+		description TEXT NOT NULL,
+		appliedAt   BIGINT NOT NULL
+	)`
+	_, err := d.exec(schemaVersionTableCreateQuery)
+	if err != nil {
+		return fmt.Errorf("can not create schemaVersion table: %w", err)
+	}
+	return nil
+}
+
+// SchemaVersion returns version of the last applied migration, 0 means no migration is applied.
+// it does not create schemaVersion table, so it can report status of database without changing it
+func (d *DatabaseOperator) SchemaVersion() (int, error) {
+	exists, err := d.dialect.columnExists(d.db, "schemaVersion", "version")
+	if err != nil {
+		return 0, err
+	}
+	if !exists {
+		return 0, nil
+	}
+	return d.schemaVersion(d.db)
+}
+
+func (d *DatabaseOperator) schemaVersion(q queryer) (int, error) {
+	schemaVersionRows, err := q.Query(`SELECT COALESCE(MAX(version), 0) FROM schemaVersion`)
+	if err != nil {
+		return 0, fmt.Errorf("can not get schemaVersion: %w", err)
+	}
+	defer schemaVersionRows.Close()
+	for schemaVersionRows.Next() {
+		var version int
+		if err := schemaVersionRows.Scan(&version); err != nil {
+			return 0, fmt.Errorf("can not scan schemaVersion: %w", err)
+		}
+		return version, nil
+	}
+	return 0, nil
+}
+
+func (d *DatabaseOperator) PendingMigrations() ([]*Migration, error) {
+	version, err := d.SchemaVersion()
+	if err != nil {
+		return nil, err
+	}
+	pendingMigrations := make([]*Migration, 0)
+	for _, migration := range migrations {
+		if migration.Version <= version {
+			continue
+		}
+		pendingMigrations = append(pendingMigrations, migration)
+	}
+	return pendingMigrations, nil
+}
+
+// applyMigration applies migration and records its version in one transaction,
+// it returns false when other ylcc sharing database has already applied the migration
+func (d *DatabaseOperator) applyMigration(migration *Migration) (bool, error) {
+	tx, err := d.db.Begin()
+	if err != nil {
+		return false, fmt.Errorf("can not start transaction of migration (version = %v): %w", migration.Version, err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			if err := tx.Rollback(); err != nil {
+				log.Fatalf("can not rollback of migration (version = %v): %v", migration.Version, err)
+			}
+			panic(p)
+		}
+	}()
+	// other ylcc sharing database waits until the migration is committed
+	if lockQuery := d.dialect.lockTableQuery("schemaVersion"); lockQuery != "" {
+		if _, err := d.txExec(tx, lockQuery); err != nil {
+			if err := tx.Rollback(); err != nil {
+				return false, fmt.Errorf("can not rollback of migration (version = %v): %w", migration.Version, err)
+			}
+			return false, fmt.Errorf("can not lock schemaVersion: %w", err)
+		}
+	}
+	version, err := d.schemaVersion(tx)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			return false, fmt.Errorf("can not rollback of migration (version = %v): %w", migration.Version, err)
+		}
+		return false, err
+	}
+	if migration.Version <= version {
+		if err := tx.Rollback(); err != nil {
+			return false, fmt.Errorf("can not rollback of migration (version = %v): %w", migration.Version, err)
+		}
+		return false, nil
+	}
+	if err := migration.up(d, tx); err != nil {
+		if err := tx.Rollback(); err != nil {
+			return false, fmt.Errorf("can not rollback of migration (version = %v): %w", migration.Version, err)
+		}
+		return false, fmt.Errorf("can not apply migration (version = %v): %w", migration.Version, err)
+	}
+	_, err = d.txExec(tx,
+		`INSERT INTO schemaVersion (
+                version,
+                description,
+                appliedAt
+            ) VALUES (
+                ?, ?, ?
+            )`,
+		migration.Version,
+		migration.Description,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		if err := tx.Rollback(); err != nil {
+			return false, fmt.Errorf("can not rollback of migration (version = %v): %w", migration.Version, err)
+		}
+		return false, fmt.Errorf("can not update schemaVersion (version = %v): %w", migration.Version, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return false, fmt.Errorf("can not commit of migration (version = %v): %w", migration.Version, err)
+	}
+	return true, nil
+}
+
+// Migrate applies pending migrations in order of version and returns applied migrations
+func (d *DatabaseOperator) Migrate() ([]*Migration, error) {
+	if err := d.createSchemaVersionTable(); err != nil {
+		return nil, err
+	}
+	pendingMigrations, err := d.PendingMigrations()
+	if err != nil {
+		return nil, err
+	}
+	appliedMigrations := make([]*Migration, 0, len(pendingMigrations))
+	for _, migration := range pendingMigrations {
+		applied, err := d.applyMigration(migration)
+		if err != nil {
+			return appliedMigrations, err
+		}
+		if !applied {
+			continue
+		}
+		if d.verbose {
+			log.Printf("apply migration (version = %v, description = %v)", migration.Version, migration.Description)
+		}
+		appliedMigrations = append(appliedMigrations, migration)
+	}
+	return appliedMigrations, nil
+}
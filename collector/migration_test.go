@@ -0,0 +1,206 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package collector
+
+import (
+	"database/sql"
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"path/filepath"
+	"testing"
+)
+
+func newTestMigrator(t *testing.T) *DatabaseOperator {
+	t.Helper()
+	databaseOperator, err := NewDatabaseOperator(filepath.Join(t.TempDir(), "ylcc.db"))
+	if err != nil {
+		t.Fatalf("can not create database operator: %v", err)
+	}
+	if err := databaseOperator.Connect(); err != nil {
+		t.Fatalf("can not connect database: %v", err)
+	}
+	t.Cleanup(databaseOperator.Close)
+	return databaseOperator
+}
+
+func TestSchemaVersionDoesNotChangeDatabase(t *testing.T) {
+	d := newTestMigrator(t)
+	version, err := d.SchemaVersion()
+	if err != nil {
+		t.Fatalf("can not get schema version: %v", err)
+	}
+	if version != 0 {
+		t.Errorf("schema version of empty database = %v, want 0", version)
+	}
+	pendingMigrations, err := d.PendingMigrations()
+	if err != nil {
+		t.Fatalf("can not get pending migrations: %v", err)
+	}
+	if len(pendingMigrations) != len(migrations) {
+		t.Errorf("count of pending migrations = %v, want %v", len(pendingMigrations), len(migrations))
+	}
+	exists, err := d.dialect.columnExists(d.db, "schemaVersion", "version")
+	if err != nil {
+		t.Fatalf("can not look up schemaVersion: %v", err)
+	}
+	if exists {
+		t.Errorf("schemaVersion table is created by status")
+	}
+
+	appliedMigrations, err := d.Migrate()
+	if err != nil {
+		t.Fatalf("can not migrate: %v", err)
+	}
+	if len(appliedMigrations) != len(migrations) {
+		t.Errorf("count of applied migrations = %v, want %v", len(appliedMigrations), len(migrations))
+	}
+	version, err = d.SchemaVersion()
+	if err != nil {
+		t.Fatalf("can not get schema version: %v", err)
+	}
+	if want := migrations[len(migrations)-1].Version; version != want {
+		t.Errorf("schema version = %v, want %v", version, want)
+	}
+}
+
+func TestFailedMigrationIsRolledBack(t *testing.T) {
+	d := newTestMigrator(t)
+	if _, err := d.Migrate(); err != nil {
+		t.Fatalf("can not migrate: %v", err)
+	}
+	version, err := d.SchemaVersion()
+	if err != nil {
+		t.Fatalf("can not get schema version: %v", err)
+	}
+
+	savedMigrations := migrations
+	defer func() { migrations = savedMigrations }()
+	migrations = append(migrations[:len(migrations):len(migrations)], &Migration{
+		Version:     version + 1,
+		Description: "fail after adding column",
+		up: func(d *DatabaseOperator, tx *sql.Tx) error {
+			if err := d.addColumnIfNotExists(tx, "video", "failedMigrationColumn", "TEXT NOT NULL DEFAULT ''"); err != nil {
+				return err
+			}
+			return fmt.Errorf("failed migration")
+		},
+	})
+	if _, err := d.Migrate(); err == nil {
+		t.Fatalf("failed migration is applied")
+	}
+	exists, err := d.dialect.columnExists(d.db, "video", "failedMigrationColumn")
+	if err != nil {
+		t.Fatalf("can not look up column: %v", err)
+	}
+	if exists {
+		t.Errorf("column added by failed migration is left")
+	}
+	newVersion, err := d.SchemaVersion()
+	if err != nil {
+		t.Fatalf("can not get schema version: %v", err)
+	}
+	if newVersion != version {
+		t.Errorf("schema version after failed migration = %v, want %v", newVersion, version)
+	}
+}
+
+func TestMigrationSetsPositionOfStoredMessages(t *testing.T) {
+	d := newTestMigrator(t)
+	savedMigrations := migrations
+	defer func() { migrations = savedMigrations }()
+	for i, migration := range savedMigrations {
+		if migration.Version == 10 {
+			migrations = savedMigrations[:i]
+			break
+		}
+	}
+	if _, err := d.Migrate(); err != nil {
+		t.Fatalf("can not migrate to version 9: %v", err)
+	}
+	if err := d.UpdateVideo(&pb.Video{VideoId: "migrationVideo01", ChannelId: "UCmigrationChannel00001"}); err != nil {
+		t.Fatalf("can not update video: %v", err)
+	}
+	_, err := d.exec(`INSERT INTO activeLiveChatMessage (
+		messageId, channelId, videoId, apiEtag, authorChannelId, authorChannelUrl, authorDisplayName,
+		authorIsChatModerator, authorIsChatOwner, authorIsChatSponsor, authorIsVerified, liveChatId, displayMessage, publishedAt,
+		isSuperChat, isSuperSticker, isFanFundingEvent, amountMicros, amountDisplayString, currency, pageToken, lastUpdate
+	    ) VALUES (?, 'UCmigrationChannel00001', 'migrationVideo01', '', '', '', '', 0, 0, 0, 0, '', '', ?, 0, 0, 0, '', '', '', '', 0)`,
+		"migrationActive01", "2022-01-01T00:00:01.123456Z")
+	if err != nil {
+		t.Fatalf("can not insert activeLiveChatMessage: %v", err)
+	}
+	_, err = d.exec(`INSERT INTO archiveLiveChatMessage (
+		messageId, channelId, videoId, clientId, authorName, authorExternalChannelId, messageText, purchaseAmountText,
+		isPaid, timestampUsec, timestampText, videoOffsetTimeMsec, continuation, lastUpdate
+	    ) VALUES (?, 'UCmigrationChannel00001', 'migrationVideo01', '', '', '', '', '', 0, ?, '', '', '', 0)`,
+		"migrationArchive01", "1640995201654321")
+	if err != nil {
+		t.Fatalf("can not insert archiveLiveChatMessage: %v", err)
+	}
+
+	migrations = savedMigrations
+	if _, err := d.Migrate(); err != nil {
+		t.Fatalf("can not migrate: %v", err)
+	}
+	pagedActiveLiveChatMessages, err := d.GetActiveLiveChatMessagesByPosition(&LiveChatPageCondition{VideoId: "migrationVideo01", Count: 10})
+	if err != nil {
+		t.Fatalf("can not get active live chat messages: %v", err)
+	}
+	if len(pagedActiveLiveChatMessages) != 1 || pagedActiveLiveChatMessages[0].Position != 1640995201123456 {
+		t.Errorf("unexpected position of active live chat messages: %+v", pagedActiveLiveChatMessages)
+	}
+	pagedArchiveLiveChatMessages, err := d.GetArchiveLiveChatMessagesByPosition(&LiveChatPageCondition{VideoId: "migrationVideo01", Count: 10})
+	if err != nil {
+		t.Fatalf("can not get archive live chat messages: %v", err)
+	}
+	if len(pagedArchiveLiveChatMessages) != 1 || pagedArchiveLiveChatMessages[0].Position != 1640995201654321 {
+		t.Errorf("unexpected position of archive live chat messages: %+v", pagedArchiveLiveChatMessages)
+	}
+}
+
+func TestMigrationSetsAmountOfStoredArchiveMessages(t *testing.T) {
+	d := newTestMigrator(t)
+	savedMigrations := migrations
+	defer func() { migrations = savedMigrations }()
+	for i, migration := range savedMigrations {
+		if migration.Version == 11 {
+			migrations = savedMigrations[:i]
+			break
+		}
+	}
+	if _, err := d.Migrate(); err != nil {
+		t.Fatalf("can not migrate to version 10: %v", err)
+	}
+	for i, purchaseAmountText := range []string{"￥1,000", "￥500", "$5.00", "unknown"} {
+		_, err := d.exec(`INSERT INTO archiveLiveChatMessage (
+		messageId, channelId, videoId, clientId, authorName, authorExternalChannelId, messageText, purchaseAmountText,
+		isPaid, timestampUsec, timestampText, videoOffsetTimeMsec, continuation, lastUpdate
+	    ) VALUES (?, 'UCmigrationChannel00001', 'migrationVideo01', '', '', '', '', ?, 1, '', '', '', '', 0)`,
+			fmt.Sprintf("migrationArchive%02d", i), purchaseAmountText)
+		if err != nil {
+			t.Fatalf("can not insert archiveLiveChatMessage: %v", err)
+		}
+	}
+
+	migrations = savedMigrations
+	if _, err := d.Migrate(); err != nil {
+		t.Fatalf("can not migrate: %v", err)
+	}
+	statistics, err := d.GetArchiveLiveChatStatistics(&LiveChatStatisticsCondition{VideoId: "migrationVideo01"})
+	if err != nil {
+		t.Fatalf("can not get statistics of archive live chat messages: %v", err)
+	}
+	want := []*pb.SuperChatTotal{
+		{Currency: "JPY", AmountMicros: 1500000000, Count: 2},
+		{Currency: "USD", AmountMicros: 5000000, Count: 1},
+	}
+	if len(statistics.SuperChatTotals) != len(want) {
+		t.Fatalf("unexpected super chat totals: %+v", statistics.SuperChatTotals)
+	}
+	for i, superChatTotal := range statistics.SuperChatTotals {
+		if superChatTotal.Currency != want[i].Currency || superChatTotal.AmountMicros != want[i].AmountMicros || superChatTotal.Count != want[i].Count {
+			t.Errorf("super chat totals[%v] = %+v, want %+v", i, superChatTotal, want[i])
+		}
+	}
+}
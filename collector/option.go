@@ -1,12 +1,56 @@
 package collector
 
+import (
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper"
+	"time"
+)
+
 type options struct {
-	verbose bool
+	verbose             bool
+	quotaUnitsPerApiKey int64
+	autoStopGracePeriod time.Duration
+	youtubeBaseUrl      string
+	httpRecordDir       string
+	httpReplayDir       string
+	apiEndpoint         string
+	chatSource          youtubehelper.ChatSource
+	backend             pb.ActiveLiveChatBackend
+	innertubeSource     youtubehelper.ActiveLiveChatSource
+	watchChannelIds     []string
+	watchInterval       time.Duration
+	discoveryInterval   time.Duration
+	leadTime            time.Duration
+	archiveDelay        time.Duration
+	retentionPolicy     *RetentionPolicy
+	cleanInterval       time.Duration
+	databaseDriver      string
+	storage             Storage
+	instanceId          string
 }
 
 func defaultOptions() *options {
 	return &options{
-		verbose: false,
+		verbose:             false,
+		quotaUnitsPerApiKey: 0,
+		autoStopGracePeriod: 0,
+		youtubeBaseUrl:      "",
+		httpRecordDir:       "",
+		httpReplayDir:       "",
+		apiEndpoint:         "",
+		chatSource:          nil,
+		backend:             pb.ActiveLiveChatBackend_DATA_API_BACKEND,
+		innertubeSource:     nil,
+		watchChannelIds:     nil,
+		watchInterval:       30 * time.Second,
+		discoveryInterval:   10 * time.Minute,
+		leadTime:            10 * time.Minute,
+		archiveDelay:        5 * time.Minute,
+		retentionPolicy:     defaultRetentionPolicy(),
+		cleanInterval:       time.Hour,
+		databaseDriver:      DatabaseDriverSqlite,
+		storage:             nil,
+		instanceId:          "",
 	}
 }
 
@@ -17,3 +61,156 @@ func Verbose(verbose bool) Option {
 		opts.verbose = verbose
 	}
 }
+
+func QuotaUnitsPerApiKey(quotaUnitsPerApiKey int64) Option {
+	return func(opts *options) {
+		opts.quotaUnitsPerApiKey = quotaUnitsPerApiKey
+	}
+}
+
+func AutoStopGracePeriod(autoStopGracePeriod time.Duration) Option {
+	return func(opts *options) {
+		opts.autoStopGracePeriod = autoStopGracePeriod
+	}
+}
+
+// YoutubeBaseUrl overrides base url of youtube pages for archive live chat
+func YoutubeBaseUrl(youtubeBaseUrl string) Option {
+	return func(opts *options) {
+		opts.youtubeBaseUrl = youtubeBaseUrl
+	}
+}
+
+// HTTPRecordDir saves responses of youtube pages for archive live chat to the directory
+func HTTPRecordDir(httpRecordDir string) Option {
+	return func(opts *options) {
+		opts.httpRecordDir = httpRecordDir
+	}
+}
+
+// HTTPReplayDir returns responses of youtube pages for archive live chat from the directory instead of network
+func HTTPReplayDir(httpReplayDir string) Option {
+	return func(opts *options) {
+		opts.httpReplayDir = httpReplayDir
+	}
+}
+
+// ApiEndpoint overrides endpoint of youtube data api
+func ApiEndpoint(apiEndpoint string) Option {
+	return func(opts *options) {
+		opts.apiEndpoint = apiEndpoint
+	}
+}
+
+// ChatSource replaces youtube data api and archive live chat page with other source
+func ChatSource(chatSource youtubehelper.ChatSource) Option {
+	return func(opts *options) {
+		opts.chatSource = chatSource
+	}
+}
+
+// ActiveLiveChatBackend selects backend of active live chat used when request does not specify it
+func ActiveLiveChatBackend(backend pb.ActiveLiveChatBackend) Option {
+	return func(opts *options) {
+		opts.backend = backend
+	}
+}
+
+// InnertubeSource replaces innertube get_live_chat with other source
+func InnertubeSource(innertubeSource youtubehelper.ActiveLiveChatSource) Option {
+	return func(opts *options) {
+		opts.innertubeSource = innertubeSource
+	}
+}
+
+// WatchChannelIds watches channels and starts collection when they go live
+func WatchChannelIds(watchChannelIds []string) Option {
+	return func(opts *options) {
+		opts.watchChannelIds = watchChannelIds
+	}
+}
+
+// WatchInterval is interval of checking watched videos
+func WatchInterval(watchInterval time.Duration) Option {
+	return func(opts *options) {
+		if watchInterval <= 0 {
+			return
+		}
+		opts.watchInterval = watchInterval
+	}
+}
+
+// DiscoveryInterval is interval of discovering upcoming and live videos of watched channels
+func DiscoveryInterval(discoveryInterval time.Duration) Option {
+	return func(opts *options) {
+		if discoveryInterval <= 0 {
+			return
+		}
+		opts.discoveryInterval = discoveryInterval
+	}
+}
+
+// LeadTime starts checking upcoming video this time before scheduled start time
+func LeadTime(leadTime time.Duration) Option {
+	return func(opts *options) {
+		if leadTime <= 0 {
+			return
+		}
+		opts.leadTime = leadTime
+	}
+}
+
+// ArchiveDelay starts collection of archive live chat this time after live is ended
+func ArchiveDelay(archiveDelay time.Duration) Option {
+	return func(opts *options) {
+		if archiveDelay <= 0 {
+			return
+		}
+		opts.archiveDelay = archiveDelay
+	}
+}
+
+// Retention replaces retention policy of cleaner
+func Retention(retentionPolicy *RetentionPolicy) Option {
+	return func(opts *options) {
+		if retentionPolicy == nil {
+			return
+		}
+		opts.retentionPolicy = retentionPolicy
+	}
+}
+
+// CleanInterval is interval of cleaner
+func CleanInterval(cleanInterval time.Duration) Option {
+	return func(opts *options) {
+		if cleanInterval <= 0 {
+			return
+		}
+		opts.cleanInterval = cleanInterval
+	}
+}
+
+// DatabaseDriver selects database of storage, sqlite3 or postgres
+func DatabaseDriver(databaseDriver string) Option {
+	return func(opts *options) {
+		if databaseDriver == "" {
+			return
+		}
+		opts.databaseDriver = databaseDriver
+	}
+}
+
+// StorageBackend replaces database of storage with other storage
+func StorageBackend(storage Storage) Option {
+	return func(opts *options) {
+		opts.storage = storage
+	}
+}
+
+// InstanceId identifies ylcc sharing database with other ylcc, it has to be unique and kept across restarts,
+// each ylcc resumes collections and watches channels which it owns
+func InstanceId(instanceId string) Option {
+	return func(opts *options) {
+		opts.instanceId = instanceId
+	}
+}
@@ -0,0 +1,319 @@
+package collector
+
+import (
+	"context"
+	"crypto/sha1"
+	"fmt"
+	"github.com/google/uuid"
+	pb "github.com/potix/ylcc/protocol"
+	"log"
+	"strconv"
+	"time"
+)
+
+const (
+	replayBulkMessageMax int64 = 500
+	replayControlMax     int   = 8
+)
+
+type replayContext struct {
+	replayId         string
+	videoId          string
+	ctx              context.Context
+	cancel           context.CancelFunc
+	controlCh        chan *pb.ControlReplayArchiveLiveChatRequest
+	subscriberCh     chan *pb.ReplayArchiveLiveChatResponse
+	state            pb.ReplayState
+	speed            float64
+	baseOffsetMsec   int64
+	baseTime         time.Time
+	buffer           []*pb.ArchiveLiveChatMessage
+	cursorOffsetMsec int64
+	cursorMessageId  string
+	eof              bool
+}
+
+func (r *replayContext) GetSubscriberCh() chan *pb.ReplayArchiveLiveChatResponse {
+	return r.subscriberCh
+}
+
+func (r *replayContext) currentOffsetMsec(now time.Time) int64 {
+	if r.state != pb.ReplayState_PLAYING {
+		return r.baseOffsetMsec
+	}
+	elapsedMsec := float64(now.Sub(r.baseTime)) / float64(time.Millisecond)
+	return r.baseOffsetMsec + int64(elapsedMsec*r.speed)
+}
+
+func (r *replayContext) rebase(now time.Time) {
+	r.baseOffsetMsec = r.currentOffsetMsec(now)
+	r.baseTime = now
+}
+
+func (r *replayContext) seek(offsetMsec int64, now time.Time) {
+	r.baseOffsetMsec = offsetMsec
+	r.baseTime = now
+	r.buffer = nil
+	r.cursorOffsetMsec = offsetMsec
+	r.cursorMessageId = ""
+	r.eof = false
+}
+
+func (c *Collector) createReplayId() (string, error) {
+	u, err := uuid.NewRandom()
+	if err != nil {
+		return "", fmt.Errorf("can not create uuid: %w", err)
+	}
+	s := sha1.Sum([]byte(time.Now().String()))
+	return fmt.Sprintf("%v-%x", u.String(), s), nil
+}
+
+func (c *Collector) registerRequestedReplay(replayCtx *replayContext) {
+	c.requestedReplayMutex.Lock()
+	defer c.requestedReplayMutex.Unlock()
+	c.requestedReplay[replayCtx.replayId] = replayCtx
+}
+
+func (c *Collector) unregisterRequestedReplay(replayCtx *replayContext) {
+	c.requestedReplayMutex.Lock()
+	defer c.requestedReplayMutex.Unlock()
+	delete(c.requestedReplay, replayCtx.replayId)
+}
+
+func (c *Collector) getRequestedReplay(replayId string) (*replayContext, bool) {
+	c.requestedReplayMutex.Lock()
+	defer c.requestedReplayMutex.Unlock()
+	replayCtx, ok := c.requestedReplay[replayId]
+	return replayCtx, ok
+}
+
+func (c *Collector) parseVideoOffsetTimeMsec(videoOffsetTimeMsec string) int64 {
+	offset, err := strconv.ParseInt(videoOffsetTimeMsec, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return offset
+}
+
+func (c *Collector) fillReplayBuffer(replayCtx *replayContext) error {
+	if len(replayCtx.buffer) > 0 || replayCtx.eof {
+		return nil
+	}
+	archiveLiveChatMessages, err := c.dbOperator.GetArchiveLiveChatMessagesByVideoIdAndVideoOffset(
+		replayCtx.videoId,
+		replayCtx.cursorOffsetMsec,
+		replayCtx.cursorMessageId,
+		replayBulkMessageMax,
+	)
+	if err != nil {
+		return fmt.Errorf("can not get archive live chat messages for replay: %w", err)
+	}
+	if len(archiveLiveChatMessages) == 0 {
+		replayCtx.eof = true
+		return nil
+	}
+	last := archiveLiveChatMessages[len(archiveLiveChatMessages)-1]
+	replayCtx.cursorOffsetMsec = c.parseVideoOffsetTimeMsec(last.VideoOffsetTimeMsec)
+	replayCtx.cursorMessageId = last.MessageId
+	replayCtx.buffer = archiveLiveChatMessages
+	return nil
+}
+
+func (c *Collector) sendReplayResponse(replayCtx *replayContext, status *pb.Status, archiveLiveChatMessages []*pb.ArchiveLiveChatMessage) bool {
+	if status == nil {
+		status = &pb.Status{
+			Code:    pb.Code_SUCCESS,
+			Message: fmt.Sprintf("success (replayId = %v)", replayCtx.replayId),
+		}
+	}
+	response := &pb.ReplayArchiveLiveChatResponse{
+		Status:                  status,
+		ReplayId:                replayCtx.replayId,
+		State:                   replayCtx.state,
+		VideoOffsetTimeMsec:     replayCtx.currentOffsetMsec(time.Now()),
+		Speed:                   replayCtx.speed,
+		ArchiveLiveChatMessages: archiveLiveChatMessages,
+	}
+	select {
+	case replayCtx.subscriberCh <- response:
+		return true
+	case <-replayCtx.ctx.Done():
+		return false
+	}
+}
+
+func (c *Collector) applyReplayControl(replayCtx *replayContext, request *pb.ControlReplayArchiveLiveChatRequest) {
+	now := time.Now()
+	switch request.Control {
+	case pb.ReplayControl_PAUSE:
+		if replayCtx.state == pb.ReplayState_PLAYING {
+			replayCtx.rebase(now)
+			replayCtx.state = pb.ReplayState_PAUSED
+		}
+	case pb.ReplayControl_RESUME:
+		if replayCtx.state == pb.ReplayState_PAUSED {
+			replayCtx.baseTime = now
+			replayCtx.state = pb.ReplayState_PLAYING
+		}
+	case pb.ReplayControl_SEEK:
+		offsetMsec := request.OffsetMsec
+		if offsetMsec < 0 {
+			offsetMsec = 0
+		}
+		replayCtx.seek(offsetMsec, now)
+	case pb.ReplayControl_CHANGE_SPEED:
+		replayCtx.rebase(now)
+		replayCtx.speed = request.Speed
+	}
+	if c.verbose {
+		log.Printf("apply replay control (replayId = %v, control = %v, state = %v, offset = %v, speed = %v)",
+			replayCtx.replayId, request.Control, replayCtx.state, replayCtx.baseOffsetMsec, replayCtx.speed)
+	}
+}
+
+func (c *Collector) replayer(replayCtx *replayContext) {
+	if c.verbose {
+		log.Printf("start replay (replayId = %v, videoId = %v)", replayCtx.replayId, replayCtx.videoId)
+	}
+	defer func() {
+		c.unregisterRequestedReplay(replayCtx)
+		replayCtx.cancel()
+		close(replayCtx.subscriberCh)
+		if c.verbose {
+			log.Printf("end replay (replayId = %v)", replayCtx.replayId)
+		}
+	}()
+	// first response notifies replayId
+	if !c.sendReplayResponse(replayCtx, nil, nil) {
+		return
+	}
+	for {
+		if err := c.fillReplayBuffer(replayCtx); err != nil {
+			c.sendReplayResponse(replayCtx, &pb.Status{
+				Code:    pb.Code_INTERNAL_ERROR,
+				Message: fmt.Sprintf("%v (replayId = %v)", err, replayCtx.replayId),
+			}, nil)
+			return
+		}
+		if len(replayCtx.buffer) == 0 {
+			replayCtx.rebase(time.Now())
+			replayCtx.state = pb.ReplayState_ENDED
+			c.sendReplayResponse(replayCtx, nil, nil)
+			return
+		}
+		var timer *time.Timer
+		var timerCh <-chan time.Time
+		if replayCtx.state == pb.ReplayState_PLAYING {
+			currentOffsetMsec := replayCtx.currentOffsetMsec(time.Now())
+			nextOffsetMsec := c.parseVideoOffsetTimeMsec(replayCtx.buffer[0].VideoOffsetTimeMsec)
+			if nextOffsetMsec <= currentOffsetMsec {
+				idx := 0
+				for idx < len(replayCtx.buffer) && c.parseVideoOffsetTimeMsec(replayCtx.buffer[idx].VideoOffsetTimeMsec) <= currentOffsetMsec {
+					idx += 1
+				}
+				archiveLiveChatMessages := replayCtx.buffer[:idx]
+				replayCtx.buffer = replayCtx.buffer[idx:]
+				if !c.sendReplayResponse(replayCtx, nil, archiveLiveChatMessages) {
+					return
+				}
+				continue
+			}
+			wait := time.Duration(float64(nextOffsetMsec-currentOffsetMsec) / replayCtx.speed * float64(time.Millisecond))
+			timer = time.NewTimer(wait)
+			timerCh = timer.C
+		}
+		select {
+		case <-timerCh:
+		case request := <-replayCtx.controlCh:
+			if timer != nil {
+				timer.Stop()
+			}
+			c.applyReplayControl(replayCtx, request)
+			if !c.sendReplayResponse(replayCtx, nil, nil) {
+				return
+			}
+		case <-replayCtx.ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return
+		}
+	}
+}
+
+func (c *Collector) StartReplayArchiveLiveChat(request *pb.ReplayArchiveLiveChatRequest) (*replayContext, error) {
+	if c.checkRequestedVideoForArchiveLiveChat(request.VideoId) {
+		return nil, fmt.Errorf("collecting archive live chat is in progress (videoId = %v)", request.VideoId)
+	}
+	count, err := c.dbOperator.CountArchiveLiveChatMessagesByVideoId(request.VideoId)
+	if err != nil {
+		return nil, fmt.Errorf("can not count archive live chat messages: %w", err)
+	}
+	if count == 0 {
+		return nil, fmt.Errorf("not found archive live chat (videoId = %v)", request.VideoId)
+	}
+	replayId, err := c.createReplayId()
+	if err != nil {
+		return nil, fmt.Errorf("can not create replayId: %w", err)
+	}
+	speed := request.Speed
+	if speed <= 0 {
+		speed = 1
+	}
+	startOffsetMsec := request.StartOffsetMsec
+	if startOffsetMsec < 0 {
+		startOffsetMsec = 0
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	replayCtx := &replayContext{
+		replayId:     replayId,
+		videoId:      request.VideoId,
+		ctx:          ctx,
+		cancel:       cancel,
+		controlCh:    make(chan *pb.ControlReplayArchiveLiveChatRequest, replayControlMax),
+		subscriberCh: make(chan *pb.ReplayArchiveLiveChatResponse),
+		state:        pb.ReplayState_PLAYING,
+		speed:        speed,
+	}
+	replayCtx.seek(startOffsetMsec, time.Now())
+	c.registerRequestedReplay(replayCtx)
+	go c.replayer(replayCtx)
+	return replayCtx, nil
+}
+
+func (c *Collector) StopReplayArchiveLiveChat(replayCtx *replayContext) {
+	replayCtx.cancel()
+}
+
+func (c *Collector) ControlReplayArchiveLiveChat(request *pb.ControlReplayArchiveLiveChatRequest) (*pb.ControlReplayArchiveLiveChatResponse, error) {
+	status := new(pb.Status)
+	if request.Control == pb.ReplayControl_CHANGE_SPEED && request.Speed <= 0 {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = fmt.Sprintf("invalid speed (replayId = %v, speed = %v)", request.ReplayId, request.Speed)
+		return &pb.ControlReplayArchiveLiveChatResponse{
+			Status: status,
+		}, nil
+	}
+	replayCtx, ok := c.getRequestedReplay(request.ReplayId)
+	if !ok {
+		status.Code = pb.Code_NOT_FOUND
+		status.Message = fmt.Sprintf("not found replayId (replayId = %v)", request.ReplayId)
+		return &pb.ControlReplayArchiveLiveChatResponse{
+			Status: status,
+		}, nil
+	}
+	select {
+	case replayCtx.controlCh <- request:
+	case <-replayCtx.ctx.Done():
+		status.Code = pb.Code_NOT_FOUND
+		status.Message = fmt.Sprintf("replay was already ended (replayId = %v)", request.ReplayId)
+		return &pb.ControlReplayArchiveLiveChatResponse{
+			Status: status,
+		}, nil
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (replayId = %v)", request.ReplayId)
+	return &pb.ControlReplayArchiveLiveChatResponse{
+		Status: status,
+	}, nil
+}
@@ -0,0 +1,264 @@
+package collector
+
+import (
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"log"
+	"time"
+)
+
+const (
+	RetentionTableVideo                  string = "video"
+	RetentionTableActiveLiveChatMessage  string = "activeLiveChatMessage"
+	RetentionTableArchiveLiveChatMessage string = "archiveLiveChatMessage"
+	defaultRetention                            = 24 * time.Hour
+	cleanerReportMax                            = 24
+)
+
+var retentionTables = []string{
+	RetentionTableVideo,
+	RetentionTableActiveLiveChatMessage,
+	RetentionTableArchiveLiveChatMessage,
+}
+
+// RetentionRule overrides retention of videos which match channelIds or videoIds.
+// rule matched by videoId takes precedence over rule matched by channelId.
+type RetentionRule struct {
+	ChannelIds []string
+	VideoIds   []string
+	// empty means all tables
+	Tables []string
+	// 0 keeps rows forever
+	Retention time.Duration
+}
+
+func (r *RetentionRule) matchTable(table string) bool {
+	if len(r.Tables) == 0 {
+		return true
+	}
+	for _, t := range r.Tables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RetentionRule) matchVideoId(videoId string) bool {
+	for _, v := range r.VideoIds {
+		if v == videoId {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *RetentionRule) matchChannelId(channelId string) bool {
+	for _, c := range r.ChannelIds {
+		if c == channelId {
+			return true
+		}
+	}
+	return false
+}
+
+// RetentionPolicy is retention of each table, 0 keeps rows forever
+type RetentionPolicy struct {
+	Video                  time.Duration
+	ActiveLiveChatMessage  time.Duration
+	ArchiveLiveChatMessage time.Duration
+	Rules                  []*RetentionRule
+}
+
+func (r *RetentionPolicy) validate() error {
+	for _, rule := range r.Rules {
+		for _, table := range rule.Tables {
+			found := false
+			for _, t := range retentionTables {
+				if t == table {
+					found = true
+					break
+				}
+			}
+			if !found {
+				return fmt.Errorf("unknown table of retention rule (table = %v)", table)
+			}
+		}
+	}
+	return nil
+}
+
+func (r *RetentionPolicy) resolve(table string, videoId string, channelId string) time.Duration {
+	for _, rule := range r.Rules {
+		if rule.matchTable(table) && rule.matchVideoId(videoId) {
+			return rule.Retention
+		}
+	}
+	for _, rule := range r.Rules {
+		if rule.matchTable(table) && rule.matchChannelId(channelId) {
+			return rule.Retention
+		}
+	}
+	switch table {
+	case RetentionTableVideo:
+		return r.Video
+	case RetentionTableActiveLiveChatMessage:
+		return r.ActiveLiveChatMessage
+	case RetentionTableArchiveLiveChatMessage:
+		return r.ArchiveLiveChatMessage
+	}
+	return 0
+}
+
+func defaultRetentionPolicy() *RetentionPolicy {
+	return &RetentionPolicy{
+		Video:                  defaultRetention,
+		ActiveLiveChatMessage:  defaultRetention,
+		ArchiveLiveChatMessage: defaultRetention,
+		Rules:                  nil,
+	}
+}
+
+func (c *Collector) formatCleanerTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func (c *Collector) clean() *pb.CleanerReport {
+	startedAt := time.Now()
+	cleanerReport := &pb.CleanerReport{
+		StartedAt:        c.formatCleanerTime(startedAt),
+		CleanerDeletions: make([]*pb.CleanerDeletion, 0),
+	}
+	pinnedVideoIds, err := c.dbOperator.GetPinnedVideoIds()
+	if err != nil {
+		cleanerReport.LastError = err.Error()
+		cleanerReport.FinishedAt = c.formatCleanerTime(time.Now())
+		return cleanerReport
+	}
+	pinned := make(map[string]bool)
+	for _, videoId := range pinnedVideoIds {
+		pinned[videoId] = true
+	}
+	for _, table := range retentionTables {
+		retentionTargets, err := c.dbOperator.GetRetentionTargets(table)
+		if err != nil {
+			cleanerReport.LastError = err.Error()
+			continue
+		}
+		for _, retentionTarget := range retentionTargets {
+			if pinned[retentionTarget.VideoId] {
+				continue
+			}
+			retention := c.retentionPolicy.resolve(table, retentionTarget.VideoId, retentionTarget.ChannelId)
+			if retention <= 0 {
+				continue
+			}
+			lastUpdate := startedAt.Add(-retention).Unix()
+			rowsAffected, err := c.dbOperator.DeleteByVideoIdAndLastUpdate(table, retentionTarget.VideoId, lastUpdate)
+			if err != nil {
+				cleanerReport.LastError = err.Error()
+				continue
+			}
+			if rowsAffected == 0 {
+				continue
+			}
+			cleanerReport.TotalRowsAffected += rowsAffected
+			cleanerReport.CleanerDeletions = append(cleanerReport.CleanerDeletions, &pb.CleanerDeletion{
+				Table:        table,
+				VideoId:      retentionTarget.VideoId,
+				ChannelId:    retentionTarget.ChannelId,
+				Retention:    int64(retention.Seconds()),
+				RowsAffected: rowsAffected,
+			})
+		}
+	}
+	cleanerReport.FinishedAt = c.formatCleanerTime(time.Now())
+	return cleanerReport
+}
+
+func (c *Collector) addCleanerReport(cleanerReport *pb.CleanerReport) {
+	c.cleanerReportsMutex.Lock()
+	defer c.cleanerReportsMutex.Unlock()
+	c.cleanerReports = append([]*pb.CleanerReport{cleanerReport}, c.cleanerReports...)
+	if len(c.cleanerReports) > cleanerReportMax {
+		c.cleanerReports = c.cleanerReports[:cleanerReportMax]
+	}
+}
+
+func (c *Collector) PinVideo(request *pb.PinVideoRequest) (*pb.PinVideoResponse, error) {
+	status := new(pb.Status)
+	if request.Unpin {
+		ok, err := c.dbOperator.DeletePinnedVideo(request.VideoId)
+		if err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+			return &pb.PinVideoResponse{
+				Status:         status,
+				PinnedVideoIds: nil,
+			}, nil
+		}
+		if !ok {
+			status.Code = pb.Code_NOT_FOUND
+			status.Message = fmt.Sprintf("not found pinned video (videoId = %v)", request.VideoId)
+			return &pb.PinVideoResponse{
+				Status:         status,
+				PinnedVideoIds: nil,
+			}, nil
+		}
+	} else {
+		if err := c.dbOperator.UpdatePinnedVideo(request.VideoId); err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+			return &pb.PinVideoResponse{
+				Status:         status,
+				PinnedVideoIds: nil,
+			}, nil
+		}
+	}
+	pinnedVideoIds, err := c.dbOperator.GetPinnedVideoIds()
+	if err != nil {
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+		return &pb.PinVideoResponse{
+			Status:         status,
+			PinnedVideoIds: nil,
+		}, nil
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (videoId = %v)", request.VideoId)
+	return &pb.PinVideoResponse{
+		Status:         status,
+		PinnedVideoIds: pinnedVideoIds,
+	}, nil
+}
+
+func (c *Collector) GetCleanerReports(request *pb.GetCleanerReportsRequest) (*pb.GetCleanerReportsResponse, error) {
+	c.cleanerReportsMutex.Lock()
+	defer c.cleanerReportsMutex.Unlock()
+	cleanerReports := make([]*pb.CleanerReport, len(c.cleanerReports))
+	copy(cleanerReports, c.cleanerReports)
+	return &pb.GetCleanerReportsResponse{
+		Status: &pb.Status{
+			Code:    pb.Code_SUCCESS,
+			Message: "success",
+		},
+		CleanerReports: cleanerReports,
+	}, nil
+}
+
+func (c *Collector) logCleanerReport(cleanerReport *pb.CleanerReport) {
+	if cleanerReport.LastError != "" {
+		log.Printf("cleaner has error (startedAt = %v): %v", cleanerReport.StartedAt, cleanerReport.LastError)
+	}
+	if !c.verbose {
+		return
+	}
+	log.Printf("cleaner deleted rows (startedAt = %v, finishedAt = %v, totalRowsAffected = %v)", cleanerReport.StartedAt, cleanerReport.FinishedAt, cleanerReport.TotalRowsAffected)
+	for _, cleanerDeletion := range cleanerReport.CleanerDeletions {
+		log.Printf("cleaner deleted rows (table = %v, videoId = %v, channelId = %v, retention = %v, rowsAffected = %v)",
+			cleanerDeletion.Table, cleanerDeletion.VideoId, cleanerDeletion.ChannelId, cleanerDeletion.Retention, cleanerDeletion.RowsAffected)
+	}
+}
@@ -0,0 +1,171 @@
+package collector
+
+import (
+	pb "github.com/potix/ylcc/protocol"
+	"html"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	searchResultDefault    int64 = 100
+	searchResultMax        int64 = 1000
+	snippetHighlightPrefix       = "<b>"
+	snippetHighlightSuffix       = "</b>"
+	snippetLeadingRunes          = 24
+	snippetMaxRunes              = 120
+	snippetEllipsis              = "..."
+)
+
+// createSnippet surrounds terms in message with highlight and cuts message around the first term,
+// message is html escaped, so only highlight is markup in snippet
+func createSnippet(message string, terms []string) string {
+	runes := []rune(message)
+	lowerRunes := make([]rune, len(runes))
+	for i, r := range runes {
+		lowerRunes[i] = unicode.ToLower(r)
+	}
+	highlighted := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		termRunes := []rune(strings.ToLower(term))
+		if len(termRunes) == 0 {
+			continue
+		}
+		for i := 0; i+len(termRunes) <= len(lowerRunes); i++ {
+			if string(lowerRunes[i:i+len(termRunes)]) != string(termRunes) {
+				continue
+			}
+			for j := i; j < i+len(termRunes); j++ {
+				highlighted[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+		}
+	}
+	start := 0
+	if first > snippetLeadingRunes {
+		start = first - snippetLeadingRunes
+	}
+	end := start + snippetMaxRunes
+	if end > len(runes) {
+		end = len(runes)
+	}
+	var builder strings.Builder
+	if start > 0 {
+		builder.WriteString(snippetEllipsis)
+	}
+	for i := start; i < end; i++ {
+		if highlighted[i] && (i == start || !highlighted[i-1]) {
+			builder.WriteString(snippetHighlightPrefix)
+		}
+		builder.WriteString(html.EscapeString(string(runes[i])))
+		if highlighted[i] && (i == end-1 || !highlighted[i+1]) {
+			builder.WriteString(snippetHighlightSuffix)
+		}
+	}
+	if end < len(runes) {
+		builder.WriteString(snippetEllipsis)
+	}
+	return builder.String()
+}
+
+func (c *Collector) newSearchLiveChatResult(chatMessage *ChatMessage, channelId string, rank float64, terms []string) *pb.SearchLiveChatResult {
+	publishedAt := ""
+	if !chatMessage.PublishedAt.IsZero() {
+		publishedAt = chatMessage.PublishedAt.Format(time.RFC3339Nano)
+	}
+	return &pb.SearchLiveChatResult{
+		Source:                chatMessage.Source,
+		MessageId:             chatMessage.MessageId,
+		VideoId:               chatMessage.VideoId,
+		ChannelId:             channelId,
+		AuthorChannelId:       chatMessage.AuthorChannelId,
+		AuthorDisplayName:     chatMessage.AuthorDisplayName,
+		AuthorIsChatModerator: chatMessage.AuthorIsChatModerator,
+		AuthorIsChatOwner:     chatMessage.AuthorIsChatOwner,
+		AuthorIsChatSponsor:   chatMessage.AuthorIsChatSponsor,
+		Message:               chatMessage.Message,
+		Snippet:               createSnippet(chatMessage.Message, terms),
+		Rank:                  rank,
+		PublishedAt:           publishedAt,
+		VideoOffsetTimeMsec:   chatMessage.VideoOffsetTimeMsec,
+		IsPaid:                chatMessage.IsPaid,
+		AmountDisplayString:   chatMessage.AmountDisplayString,
+	}
+}
+
+func (c *Collector) SearchLiveChat(request *pb.SearchLiveChatRequest) (*pb.SearchLiveChatResponse, error) {
+	status := new(pb.Status)
+	count := request.Count
+	if count <= 0 {
+		count = searchResultDefault
+	} else if count > searchResultMax {
+		count = searchResultMax
+	}
+	offset := request.Offset
+	if offset < 0 {
+		offset = 0
+	}
+	terms := strings.Fields(request.Query)
+	condition := &LiveChatSearchCondition{
+		Terms:     terms,
+		ChannelId: request.ChannelId,
+		VideoId:   request.VideoId,
+		Author:    request.Author,
+		Since:     request.Since,
+		Until:     request.Until,
+		PaidOnly:  request.PaidOnly,
+		Target:    request.Target,
+		Offset:    offset,
+		Count:     count,
+	}
+	results := make([]*pb.SearchLiveChatResult, 0)
+	if request.Source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+		searchedArchiveLiveChatMessages, err := c.dbOperator.SearchArchiveLiveChatMessages(condition)
+		if err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = err.Error()
+			return &pb.SearchLiveChatResponse{
+				Status:  status,
+				Results: nil,
+			}, nil
+		}
+		for _, searchedArchiveLiveChatMessage := range searchedArchiveLiveChatMessages {
+			archiveLiveChatMessage := searchedArchiveLiveChatMessage.ArchiveLiveChatMessage
+			results = append(results, c.newSearchLiveChatResult(
+				NewChatMessageFromArchiveLiveChatMessage(archiveLiveChatMessage),
+				archiveLiveChatMessage.ChannelId,
+				searchedArchiveLiveChatMessage.Rank,
+				terms,
+			))
+		}
+	} else {
+		searchedActiveLiveChatMessages, err := c.dbOperator.SearchActiveLiveChatMessages(condition)
+		if err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = err.Error()
+			return &pb.SearchLiveChatResponse{
+				Status:  status,
+				Results: nil,
+			}, nil
+		}
+		for _, searchedActiveLiveChatMessage := range searchedActiveLiveChatMessages {
+			activeLiveChatMessage := searchedActiveLiveChatMessage.ActiveLiveChatMessage
+			results = append(results, c.newSearchLiveChatResult(
+				NewChatMessageFromActiveLiveChatMessage(activeLiveChatMessage),
+				activeLiveChatMessage.ChannelId,
+				searchedActiveLiveChatMessage.Rank,
+				terms,
+			))
+		}
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = "success"
+	return &pb.SearchLiveChatResponse{
+		Status:  status,
+		Results: results,
+	}, nil
+}
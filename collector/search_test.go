@@ -0,0 +1,36 @@
+package collector
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCreateSnippet(t *testing.T) {
+	tests := []struct {
+		message string
+		terms   []string
+		want    string
+	}{
+		{"hello world", []string{"world"}, "hello <b>world</b>"},
+		{"Hello World", []string{"hello", "WORLD"}, "<b>Hello</b> <b>World</b>"},
+		{"<i>hi</i> hello", []string{"hello"}, "&lt;i&gt;hi&lt;/i&gt; <b>hello</b>"},
+		{"a<b & c", []string{"<b"}, "a<b>&lt;b</b> &amp; c"},
+		{"\"quoted\" 'text'", []string{"quoted"}, "&#34;<b>quoted</b>&#34; &#39;text&#39;"},
+		{"no match", []string{"other"}, "no match"},
+	}
+	for _, test := range tests {
+		if got := createSnippet(test.message, test.terms); got != test.want {
+			t.Errorf("createSnippet(%q, %q) = %q, want %q", test.message, test.terms, got, test.want)
+		}
+	}
+}
+
+func TestCreateSnippetCutsAroundFirstTerm(t *testing.T) {
+	message := strings.Repeat("<", 100) + "term" + strings.Repeat(">", 200)
+	got := createSnippet(message, []string{"term"})
+	want := snippetEllipsis + strings.Repeat("&lt;", snippetLeadingRunes) + "<b>term</b>" +
+		strings.Repeat("&gt;", snippetMaxRunes-snippetLeadingRunes-len("term")) + snippetEllipsis
+	if got != want {
+		t.Errorf("createSnippet = %q, want %q", got, want)
+	}
+}
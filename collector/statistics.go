@@ -0,0 +1,56 @@
+package collector
+
+import (
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+)
+
+const (
+	topAuthorsDefault int64 = 10
+	topAuthorsMax     int64 = 100
+)
+
+// GetChatStatistics returns statistics of stored live chat of a video or channel, they are computed by database
+func (c *Collector) GetChatStatistics(request *pb.GetChatStatisticsRequest) (*pb.GetChatStatisticsResponse, error) {
+	status := new(pb.Status)
+	if request.VideoId == "" && request.ChannelId == "" {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = "no videoId and channelId"
+		return &pb.GetChatStatisticsResponse{
+			Status: status,
+		}, nil
+	}
+	topAuthorsCount := request.TopAuthorsCount
+	if topAuthorsCount <= 0 {
+		topAuthorsCount = topAuthorsDefault
+	} else if topAuthorsCount > topAuthorsMax {
+		topAuthorsCount = topAuthorsMax
+	}
+	condition := &LiveChatStatisticsCondition{
+		ChannelId:       request.ChannelId,
+		VideoId:         request.VideoId,
+		Since:           request.Since,
+		Until:           request.Until,
+		TopAuthorsCount: topAuthorsCount,
+	}
+	var chatStatistics *pb.ChatStatistics
+	var err error
+	if request.Source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+		chatStatistics, err = c.dbOperator.GetArchiveLiveChatStatistics(condition)
+	} else {
+		chatStatistics, err = c.dbOperator.GetActiveLiveChatStatistics(condition)
+	}
+	if err != nil {
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v, channelId = %v)", err, request.VideoId, request.ChannelId)
+		return &pb.GetChatStatisticsResponse{
+			Status: status,
+		}, nil
+	}
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (videoId = %v, channelId = %v)", request.VideoId, request.ChannelId)
+	return &pb.GetChatStatisticsResponse{
+		Status:     status,
+		Statistics: chatStatistics,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package collector
+
+import (
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+)
+
+const (
+	DatabaseDriverSqlite   string = "sqlite3"
+	DatabaseDriverPostgres string = "postgres"
+)
+
+// Storage stores videos, live chat messages and states of collector
+// several ylcc can share one Storage when it is backed by database server such as postgres
+type Storage interface {
+	Open() error
+	Close()
+
+	GetVideoByVideoId(videoId string) (*pb.Video, bool, error)
+	GetVideosByChannelId(channelId string) ([]*pb.Video, error)
+	UpdateVideo(video *pb.Video) error
+	DeleteVideoByLastUpdate(lastUpdate int) error
+
+	// UpdateActiveLiveChatMessages returns messages which are inserted, messages already stored are not returned
+	UpdateActiveLiveChatMessages(activeLiveChatMessages []*pb.ActiveLiveChatMessage) ([]*pb.ActiveLiveChatMessage, error)
+	DeleteActiveLiveChatMessagesByLastUpdate(lastUpdate int) error
+
+	GetArchiveLiveChatMessagesByVideoIdAndVideoOffset(videoId string, videoOffsetTimeMsec int64, messageId string, count int64) ([]*pb.ArchiveLiveChatMessage, error)
+	CountArchiveLiveChatMessagesByVideoId(videoId string) (int, error)
+	UpdateArchiveLiveChatMessages(archiveLiveChatMessages []*pb.ArchiveLiveChatMessage) error
+	DeleteArchiveLiveChatMessagesByLastUpdate(lastUpdate int) error
+
+	SearchActiveLiveChatMessages(condition *LiveChatSearchCondition) ([]*SearchedActiveLiveChatMessage, error)
+	SearchArchiveLiveChatMessages(condition *LiveChatSearchCondition) ([]*SearchedArchiveLiveChatMessage, error)
+	GetActiveLiveChatMessagesByPosition(condition *LiveChatPageCondition) ([]*PagedActiveLiveChatMessage, error)
+	GetArchiveLiveChatMessagesByPosition(condition *LiveChatPageCondition) ([]*PagedArchiveLiveChatMessage, error)
+	CountActiveLiveChatMessages(condition *LiveChatPageCondition) (int64, error)
+	CountArchiveLiveChatMessages(condition *LiveChatPageCondition) (int64, error)
+	GetActiveLiveChatStatistics(condition *LiveChatStatisticsCondition) (*pb.ChatStatistics, error)
+	GetArchiveLiveChatStatistics(condition *LiveChatStatisticsCondition) (*pb.ChatStatistics, error)
+
+	// collections and watched channels are owned by instance which stored them last,
+	// Get methods claim rows which have no owner and return rows of the owner
+	GetActiveLiveChatCollections(owner string) ([]*ActiveLiveChatCollection, error)
+	UpdateActiveLiveChatCollection(videoId string, activeLiveChatId string, pageToken string, backend pb.ActiveLiveChatBackend, owner string) error
+	DeleteActiveLiveChatCollection(videoId string) error
+
+	GetWatchedChannels(owner string) ([]*WatchedChannel, error)
+	UpdateWatchedChannel(channelId string, backend pb.ActiveLiveChatBackend, owner string) error
+	DeleteWatchedChannel(channelId string) error
+
+	// GetRetentionTargets returns videos which have rows in the table
+	GetRetentionTargets(table string) ([]*RetentionTarget, error)
+	DeleteByVideoIdAndLastUpdate(table string, videoId string, lastUpdate int64) (int64, error)
+	GetPinnedVideoIds() ([]string, error)
+	UpdatePinnedVideo(videoId string) error
+	DeletePinnedVideo(videoId string) (bool, error)
+}
+
+// Migrator is implemented by Storage which has versioned schema
+type Migrator interface {
+	// Connect opens database without applying migrations
+	Connect() error
+	Close()
+	SchemaVersion() (int, error)
+	PendingMigrations() ([]*Migration, error)
+	Migrate() ([]*Migration, error)
+}
+
+// NewStorage creates Storage of the database driver,
+// dataSourceName is path of database file for sqlite3 and connection string for postgres
+func NewStorage(databaseDriver string, dataSourceName string, opts ...Option) (Storage, error) {
+	var databaseOperator *DatabaseOperator
+	var err error
+	switch databaseDriver {
+	case "", DatabaseDriverSqlite:
+		databaseOperator, err = NewDatabaseOperator(dataSourceName, opts...)
+	case DatabaseDriverPostgres:
+		databaseOperator, err = NewPostgresOperator(dataSourceName, opts...)
+	default:
+		return nil, fmt.Errorf("unsupported database driver (databaseDriver = %v)", databaseDriver)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return databaseOperator, nil
+}
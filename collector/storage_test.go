@@ -0,0 +1,428 @@
+package collector
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/potix/ylcc/protocol"
+)
+
+// testStorage runs same operations against each backend of DatabaseOperator, database has to be empty
+func testStorage(t *testing.T, d *DatabaseOperator) {
+	t.Run("Video", func(t *testing.T) { testStorageVideo(t, d) })
+	t.Run("ActiveLiveChatMessage", func(t *testing.T) { testStorageActiveLiveChatMessage(t, d) })
+	t.Run("ArchiveLiveChatMessage", func(t *testing.T) { testStorageArchiveLiveChatMessage(t, d) })
+	t.Run("ActiveLiveChatCollection", func(t *testing.T) { testStorageActiveLiveChatCollection(t, d) })
+	t.Run("Retention", func(t *testing.T) { testStorageRetention(t, d) })
+	t.Run("QuestionMarkInLiteral", func(t *testing.T) { testStorageQuestionMarkInLiteral(t, d) })
+}
+
+func testStorageVideo(t *testing.T, d *DatabaseOperator) {
+	video := &pb.Video{
+		VideoId:    "storageVideo01",
+		ChannelId:  "UCstorageChannel0000001",
+		Title:      "storage test",
+		Embeddable: true,
+	}
+	if err := d.UpdateVideo(video); err != nil {
+		t.Fatalf("can not update video: %v", err)
+	}
+	stored, ok, err := d.GetVideoByVideoId(video.VideoId)
+	if err != nil {
+		t.Fatalf("can not get video: %v", err)
+	}
+	if !ok || stored.Title != video.Title || !stored.Embeddable {
+		t.Errorf("unexpected video: %+v", stored)
+	}
+	videos, err := d.GetVideosByChannelId(video.ChannelId)
+	if err != nil {
+		t.Fatalf("can not get videos: %v", err)
+	}
+	if len(videos) != 1 || videos[0].VideoId != video.VideoId {
+		t.Errorf("unexpected videos of channel: %+v", videos)
+	}
+	if _, ok, err := d.GetVideoByVideoId("storageVideo99"); err != nil || ok {
+		t.Errorf("unknown video is found (ok = %v, err = %v)", ok, err)
+	}
+}
+
+func testStorageActiveLiveChatMessage(t *testing.T, d *DatabaseOperator) {
+	activeLiveChatMessages := []*pb.ActiveLiveChatMessage{
+		{
+			MessageId:         "storageActive01",
+			ChannelId:         "UCstorageChannel0000001",
+			VideoId:           "storageVideo01",
+			AuthorChannelId:   "UCstorageOwner000000001",
+			AuthorDisplayName: "owner",
+			AuthorIsChatOwner: true,
+			DisplayMessage:    "welcome to the stream",
+			PublishedAt:       "2022-01-01T00:00:01.100000Z",
+			EventType:         pb.ActiveLiveChatEventType_TEXT_MESSAGE_EVENT,
+		},
+		{
+			MessageId:           "storageActive02",
+			ChannelId:           "UCstorageChannel0000001",
+			VideoId:             "storageVideo01",
+			AuthorChannelId:     "UCstorageViewer00000001",
+			AuthorDisplayName:   "viewer",
+			DisplayMessage:      "thanks for the stream",
+			PublishedAt:         "2022-01-01T00:00:01.000200Z",
+			IsSuperChat:         true,
+			AmountMicros:        "5000000",
+			AmountDisplayString: "$5.00",
+			Currency:            "USD",
+			EventType:           pb.ActiveLiveChatEventType_SUPER_CHAT_EVENT,
+		},
+	}
+	if _, err := d.UpdateActiveLiveChatMessages(activeLiveChatMessages); err != nil {
+		t.Fatalf("can not update active live chat messages: %v", err)
+	}
+
+	// bool is stored as same value by each driver
+	rows, err := d.query(`SELECT authorIsChatOwner, authorIsChatModerator, isSuperChat FROM activeLiveChatMessage WHERE messageId = ?`, "storageActive02")
+	if err != nil {
+		t.Fatalf("can not get flags: %v", err)
+	}
+	if !rows.Next() {
+		rows.Close()
+		t.Fatalf("not found message")
+	}
+	var authorIsChatOwner string
+	var authorIsChatModerator string
+	var isSuperChat int64
+	err = rows.Scan(&authorIsChatOwner, &authorIsChatModerator, &isSuperChat)
+	rows.Close()
+	if err != nil {
+		t.Fatalf("can not scan flags: %v", err)
+	}
+	if authorIsChatOwner != "0" || authorIsChatModerator != "0" || isSuperChat != 1 {
+		t.Errorf("flags = (%q, %q, %v), want (\"0\", \"0\", 1)", authorIsChatOwner, authorIsChatModerator, isSuperChat)
+	}
+
+	searched, err := d.SearchActiveLiveChatMessages(&LiveChatSearchCondition{
+		Terms:   []string{"stream"},
+		VideoId: "storageVideo01",
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not search active live chat messages: %v", err)
+	}
+	if len(searched) != 2 {
+		t.Errorf("count of searched messages = %v, want 2", len(searched))
+	}
+	searched, err = d.SearchActiveLiveChatMessages(&LiveChatSearchCondition{
+		Terms:    []string{"stream"},
+		VideoId:  "storageVideo01",
+		PaidOnly: true,
+		Count:    10,
+	})
+	if err != nil {
+		t.Fatalf("can not search paid active live chat messages: %v", err)
+	}
+	if len(searched) != 1 || searched[0].ActiveLiveChatMessage.MessageId != "storageActive02" {
+		t.Errorf("unexpected paid messages: %+v", searched)
+	}
+	// time range is compared with position, the second of 1640995201 contains both messages
+	searched, err = d.SearchActiveLiveChatMessages(&LiveChatSearchCondition{
+		Terms:   []string{"stream"},
+		VideoId: "storageVideo01",
+		Since:   1640995201,
+		Until:   1640995202,
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not search active live chat messages in time range: %v", err)
+	}
+	if len(searched) != 2 {
+		t.Errorf("count of searched messages in time range = %v, want 2", len(searched))
+	}
+	searched, err = d.SearchActiveLiveChatMessages(&LiveChatSearchCondition{
+		Terms:   []string{"stream"},
+		VideoId: "storageVideo01",
+		Until:   1640995201,
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not search active live chat messages until: %v", err)
+	}
+	if len(searched) != 0 {
+		t.Errorf("messages after until are searched: %+v", searched)
+	}
+	// event rows are not searched even if they have text
+	if _, err := d.UpdateActiveLiveChatMessages([]*pb.ActiveLiveChatMessage{
+		{
+			MessageId:       "storageActive91",
+			ChannelId:       "UCstorageChannel0000091",
+			VideoId:         "storageVideo91",
+			AuthorChannelId: "UCstorageViewer00000001",
+			DisplayMessage:  "stream message is deleted",
+			PublishedAt:     "2022-01-01T00:00:01Z",
+			EventType:       pb.ActiveLiveChatEventType_MESSAGE_DELETED_EVENT,
+			TargetMessageId: "storageActive90",
+		},
+	}); err != nil {
+		t.Fatalf("can not update active live chat event: %v", err)
+	}
+	searched, err = d.SearchActiveLiveChatMessages(&LiveChatSearchCondition{
+		Terms:   []string{"stream"},
+		VideoId: "storageVideo91",
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not search active live chat events: %v", err)
+	}
+	if len(searched) != 0 {
+		t.Errorf("event rows are searched: %+v", searched)
+	}
+	if _, err := d.DeleteByVideoIdAndLastUpdate("activeLiveChatMessage", "storageVideo91", time.Now().Unix()+1); err != nil {
+		t.Fatalf("can not delete active live chat event: %v", err)
+	}
+
+	paged, err := d.GetActiveLiveChatMessagesByPosition(&LiveChatPageCondition{
+		VideoId: "storageVideo01",
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not get active live chat messages by position: %v", err)
+	}
+	// position is publishedAt in microseconds, but message published before stored messages is positioned after them
+	if len(paged) != 2 || paged[0].ActiveLiveChatMessage.MessageId != "storageActive01" || paged[0].Position != 1640995201100000 || !paged[0].ActiveLiveChatMessage.AuthorIsChatOwner ||
+		paged[1].ActiveLiveChatMessage.MessageId != "storageActive02" || paged[1].Position != 1640995201100001 {
+		t.Fatalf("unexpected paged messages: %+v", paged)
+	}
+	paged, err = d.GetActiveLiveChatMessagesByPosition(&LiveChatPageCondition{
+		VideoId:        "storageVideo01",
+		AfterPosition:  paged[0].Position,
+		AfterMessageId: paged[0].ActiveLiveChatMessage.MessageId,
+		Count:          10,
+	})
+	if err != nil {
+		t.Fatalf("can not get active live chat messages after cursor: %v", err)
+	}
+	if len(paged) != 1 || paged[0].ActiveLiveChatMessage.MessageId != "storageActive02" {
+		t.Errorf("unexpected paged messages after cursor: %+v", paged)
+	}
+	paged, err = d.GetActiveLiveChatMessagesByPosition(&LiveChatPageCondition{
+		VideoId: "storageVideo01",
+		Since:   1640995202,
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not get active live chat messages since: %v", err)
+	}
+	if len(paged) != 0 {
+		t.Errorf("messages before since are paged: %+v", paged)
+	}
+
+	// messages stored again (e.g. retry or resume of page) keep their positions
+	for i := 0; i < 2; i++ {
+		if _, err := d.UpdateActiveLiveChatMessages(activeLiveChatMessages); err != nil {
+			t.Fatalf("can not update active live chat messages again: %v", err)
+		}
+	}
+	if _, err := d.UpdateActiveLiveChatMessages(activeLiveChatMessages[:1]); err != nil {
+		t.Fatalf("can not update active live chat message again: %v", err)
+	}
+	paged, err = d.GetActiveLiveChatMessagesByPosition(&LiveChatPageCondition{
+		VideoId: "storageVideo01",
+		Count:   10,
+	})
+	if err != nil {
+		t.Fatalf("can not get active live chat messages stored again: %v", err)
+	}
+	if len(paged) != 2 || paged[0].ActiveLiveChatMessage.MessageId != "storageActive01" || paged[0].Position != 1640995201100000 ||
+		paged[1].ActiveLiveChatMessage.MessageId != "storageActive02" || paged[1].Position != 1640995201100001 {
+		t.Errorf("order of messages stored again is changed: %+v", paged)
+	}
+}
+
+func testStorageArchiveLiveChatMessage(t *testing.T, d *DatabaseOperator) {
+	archiveLiveChatMessages := []*pb.ArchiveLiveChatMessage{
+		{
+			MessageId:               "storageArchive01",
+			ChannelId:               "UCstorageChannel0000001",
+			VideoId:                 "storageVideo02",
+			AuthorName:              "moderator",
+			AuthorExternalChannelId: "UCstorageModerator00001",
+			MessageText:             "first archive message",
+			TimestampUsec:           "1640995201000000",
+			VideoOffsetTimeMsec:     "1000",
+			AuthorIsChatModerator:   true,
+		},
+		{
+			MessageId:               "storageArchive02",
+			ChannelId:               "UCstorageChannel0000001",
+			VideoId:                 "storageVideo02",
+			AuthorName:              "viewer",
+			AuthorExternalChannelId: "UCstorageViewer00000001",
+			MessageText:             "second archive message",
+			PurchaseAmountText:      "￥1,000",
+			IsPaid:                  true,
+			TimestampUsec:           "1640995202000000",
+			VideoOffsetTimeMsec:     "2000",
+		},
+	}
+	if err := d.UpdateArchiveLiveChatMessages(archiveLiveChatMessages); err != nil {
+		t.Fatalf("can not update archive live chat messages: %v", err)
+	}
+	count, err := d.CountArchiveLiveChatMessagesByVideoId("storageVideo02")
+	if err != nil {
+		t.Fatalf("can not count archive live chat messages: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("count of archive live chat messages = %v, want 2", count)
+	}
+	stored, err := d.GetArchiveLiveChatMessagesByVideoIdAndVideoOffset("storageVideo02", 0, "", 10)
+	if err != nil {
+		t.Fatalf("can not get archive live chat messages: %v", err)
+	}
+	if len(stored) != 2 || stored[0].MessageId != "storageArchive01" || !stored[0].AuthorIsChatModerator || stored[1].AuthorIsChatModerator || !stored[1].IsPaid {
+		t.Errorf("unexpected archive live chat messages: %+v", stored)
+	}
+	statistics, err := d.GetArchiveLiveChatStatistics(&LiveChatStatisticsCondition{VideoId: "storageVideo02"})
+	if err != nil {
+		t.Fatalf("can not get statistics of archive live chat messages: %v", err)
+	}
+	if statistics.MessageCount != 2 || statistics.ModeratorMessageCount != 1 || statistics.UniqueChatters != 2 || len(statistics.SuperChatTotals) != 1 ||
+		statistics.SuperChatTotals[0].Currency != "JPY" || statistics.SuperChatTotals[0].AmountMicros != 1000000000 || statistics.SuperChatTotals[0].Count != 1 {
+		t.Errorf("unexpected statistics: %+v", statistics)
+	}
+
+	// author who posted in previous video of the channel is returning chatter
+	if err := d.UpdateArchiveLiveChatMessages([]*pb.ArchiveLiveChatMessage{
+		{
+			MessageId:               "storageArchive03",
+			ChannelId:               "UCstorageChannel0000001",
+			VideoId:                 "storageVideo03",
+			AuthorName:              "viewer",
+			AuthorExternalChannelId: "UCstorageViewer00000001",
+			MessageText:             "archive message of next stream",
+			TimestampUsec:           "1641081600000000",
+			VideoOffsetTimeMsec:     "1000",
+		},
+	}); err != nil {
+		t.Fatalf("can not update archive live chat messages of next video: %v", err)
+	}
+	statistics, err = d.GetArchiveLiveChatStatistics(&LiveChatStatisticsCondition{VideoId: "storageVideo03"})
+	if err != nil {
+		t.Fatalf("can not get statistics of archive live chat messages of next video: %v", err)
+	}
+	if statistics.UniqueChatters != 1 || statistics.ReturningChatters != 1 || statistics.NewChatters != 0 || len(statistics.SuperChatTotals) != 0 {
+		t.Errorf("unexpected statistics of next video: %+v", statistics)
+	}
+}
+
+func testStorageActiveLiveChatCollection(t *testing.T, d *DatabaseOperator) {
+	// collection stored by instance which does not have instanceId is claimed by the first instance which gets collections
+	if err := d.UpdateActiveLiveChatCollection("storageVideo01", "storageLiveChat01", "token1", pb.ActiveLiveChatBackend_DATA_API_BACKEND, ""); err != nil {
+		t.Fatalf("can not update active live chat collection: %v", err)
+	}
+	collections, err := d.GetActiveLiveChatCollections("storageInstance1")
+	if err != nil {
+		t.Fatalf("can not get active live chat collections: %v", err)
+	}
+	if len(collections) != 1 || collections[0].PageToken != "token1" {
+		t.Errorf("unowned active live chat collection is not claimed: %+v", collections)
+	}
+	if err := d.UpdateActiveLiveChatCollection("storageVideo01", "storageLiveChat01", "token2", pb.ActiveLiveChatBackend_DATA_API_BACKEND, "storageInstance1"); err != nil {
+		t.Fatalf("can not update page token of active live chat collection: %v", err)
+	}
+	if err := d.UpdateActiveLiveChatCollection("storageVideo02", "storageLiveChat02", "token1", pb.ActiveLiveChatBackend_DATA_API_BACKEND, "storageInstance2"); err != nil {
+		t.Fatalf("can not update active live chat collection of other instance: %v", err)
+	}
+	collections, err = d.GetActiveLiveChatCollections("storageInstance1")
+	if err != nil {
+		t.Fatalf("can not get active live chat collections: %v", err)
+	}
+	if len(collections) != 1 || collections[0].VideoId != "storageVideo01" || collections[0].PageToken != "token2" {
+		t.Errorf("unexpected active live chat collections: %+v", collections)
+	}
+	collections, err = d.GetActiveLiveChatCollections("storageInstance2")
+	if err != nil {
+		t.Fatalf("can not get active live chat collections of other instance: %v", err)
+	}
+	if len(collections) != 1 || collections[0].VideoId != "storageVideo02" {
+		t.Errorf("unexpected active live chat collections of other instance: %+v", collections)
+	}
+	for _, videoId := range []string{"storageVideo01", "storageVideo02"} {
+		if err := d.DeleteActiveLiveChatCollection(videoId); err != nil {
+			t.Fatalf("can not delete active live chat collection: %v", err)
+		}
+	}
+	collections, err = d.GetActiveLiveChatCollections("storageInstance1")
+	if err != nil {
+		t.Fatalf("can not get active live chat collections: %v", err)
+	}
+	if len(collections) != 0 {
+		t.Errorf("active live chat collections are left: %+v", collections)
+	}
+
+	if err := d.UpdateWatchedChannel("UCstorageChannel0000001", pb.ActiveLiveChatBackend_DATA_API_BACKEND, "storageInstance1"); err != nil {
+		t.Fatalf("can not update watched channel: %v", err)
+	}
+	if err := d.UpdateWatchedChannel("UCstorageChannel0000002", pb.ActiveLiveChatBackend_DATA_API_BACKEND, "storageInstance2"); err != nil {
+		t.Fatalf("can not update watched channel of other instance: %v", err)
+	}
+	watchedChannels, err := d.GetWatchedChannels("storageInstance1")
+	if err != nil {
+		t.Fatalf("can not get watched channels: %v", err)
+	}
+	if len(watchedChannels) != 1 || watchedChannels[0].ChannelId != "UCstorageChannel0000001" {
+		t.Errorf("unexpected watched channels: %+v", watchedChannels)
+	}
+	for _, channelId := range []string{"UCstorageChannel0000001", "UCstorageChannel0000002"} {
+		if err := d.DeleteWatchedChannel(channelId); err != nil {
+			t.Fatalf("can not delete watched channel: %v", err)
+		}
+	}
+}
+
+func testStorageRetention(t *testing.T, d *DatabaseOperator) {
+	if err := d.UpdatePinnedVideo("storageVideo02"); err != nil {
+		t.Fatalf("can not pin video: %v", err)
+	}
+	pinnedVideoIds, err := d.GetPinnedVideoIds()
+	if err != nil {
+		t.Fatalf("can not get pinned videos: %v", err)
+	}
+	if len(pinnedVideoIds) != 1 || pinnedVideoIds[0] != "storageVideo02" {
+		t.Errorf("unexpected pinned videos: %v", pinnedVideoIds)
+	}
+	if deleted, err := d.DeletePinnedVideo("storageVideo02"); err != nil || !deleted {
+		t.Errorf("can not unpin video (deleted = %v, err = %v)", deleted, err)
+	}
+
+	retentionTargets, err := d.GetRetentionTargets("activeLiveChatMessage")
+	if err != nil {
+		t.Fatalf("can not get retention targets: %v", err)
+	}
+	if len(retentionTargets) != 1 || retentionTargets[0].VideoId != "storageVideo01" {
+		t.Errorf("unexpected retention targets: %+v", retentionTargets)
+	}
+	deleted, err := d.DeleteByVideoIdAndLastUpdate("activeLiveChatMessage", "storageVideo01", time.Now().Unix()+1)
+	if err != nil {
+		t.Fatalf("can not delete active live chat messages: %v", err)
+	}
+	if deleted != 2 {
+		t.Errorf("count of deleted messages = %v, want 2", deleted)
+	}
+}
+
+func testStorageQuestionMarkInLiteral(t *testing.T, d *DatabaseOperator) {
+	rows, err := d.query(`SELECT 'why?', CAST(? AS TEXT) || '?'`, "how")
+	if err != nil {
+		t.Fatalf("can not query with question mark in literal: %v", err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		t.Fatalf("no row")
+	}
+	var literal string
+	var concatenated string
+	if err := rows.Scan(&literal, &concatenated); err != nil {
+		t.Fatalf("can not scan: %v", err)
+	}
+	if literal != "why?" || concatenated != "how?" {
+		t.Errorf("result = (%q, %q), want (\"why?\", \"how?\")", literal, concatenated)
+	}
+}
@@ -0,0 +1,411 @@
+package collector
+
+import (
+	"context"
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper"
+	"google.golang.org/api/youtube/v3"
+	"log"
+	"time"
+)
+
+const (
+	channelFeedFetchTimeout = 30 * time.Second
+	upcomingVideoExpiration = 24 * time.Hour
+)
+
+type watchedVideo struct {
+	videoId            string
+	title              string
+	scheduledStartTime time.Time
+	state              pb.WatchedVideoState
+	endedAt            time.Time
+}
+
+type watchedChannel struct {
+	channelId        string
+	backend          pb.ActiveLiveChatBackend
+	lastDiscoveredAt time.Time
+	lastError        string
+	knownVideoIds    map[string]bool
+	watchedVideos    map[string]*watchedVideo
+}
+
+func newWatchedChannel(channelId string, backend pb.ActiveLiveChatBackend) *watchedChannel {
+	return &watchedChannel{
+		channelId:     channelId,
+		backend:       backend,
+		knownVideoIds: make(map[string]bool),
+		watchedVideos: make(map[string]*watchedVideo),
+	}
+}
+
+func (w *watchedChannel) formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+func (w *watchedChannel) toWatchedChannel() *pb.WatchedChannel {
+	watchedVideos := make([]*pb.WatchedVideo, 0, len(w.watchedVideos))
+	for _, v := range w.watchedVideos {
+		watchedVideos = append(watchedVideos, &pb.WatchedVideo{
+			VideoId:            v.videoId,
+			Title:              v.title,
+			ScheduledStartTime: w.formatTime(v.scheduledStartTime),
+			State:              v.state,
+		})
+	}
+	return &pb.WatchedChannel{
+		ChannelId:        w.channelId,
+		Backend:          w.backend,
+		LastDiscoveredAt: w.formatTime(w.lastDiscoveredAt),
+		LastError:        w.lastError,
+		WatchedVideos:    watchedVideos,
+	}
+}
+
+func (c *Collector) parseScheduledStartTime(video *youtube.Video) time.Time {
+	if video.LiveStreamingDetails == nil || video.LiveStreamingDetails.ScheduledStartTime == "" {
+		return time.Time{}
+	}
+	scheduledStartTime, err := time.Parse(time.RFC3339, video.LiveStreamingDetails.ScheduledStartTime)
+	if err != nil {
+		return time.Time{}
+	}
+	return scheduledStartTime
+}
+
+func (c *Collector) isLiveEnded(video *youtube.Video) bool {
+	if video.LiveStreamingDetails != nil && video.LiveStreamingDetails.ActualEndTime != "" {
+		return true
+	}
+	return video.Snippet != nil && video.Snippet.LiveBroadcastContent == "none"
+}
+
+func (c *Collector) addWatchedChannel(channelId string, backend pb.ActiveLiveChatBackend) *pb.WatchedChannel {
+	c.watchedChannelsMutex.Lock()
+	defer c.watchedChannelsMutex.Unlock()
+	wc, ok := c.watchedChannels[channelId]
+	if !ok {
+		wc = newWatchedChannel(channelId, backend)
+		c.watchedChannels[channelId] = wc
+	}
+	wc.backend = backend
+	return wc.toWatchedChannel()
+}
+
+func (c *Collector) removeWatchedChannel(channelId string) (*pb.WatchedChannel, bool) {
+	c.watchedChannelsMutex.Lock()
+	defer c.watchedChannelsMutex.Unlock()
+	wc, ok := c.watchedChannels[channelId]
+	if !ok {
+		return nil, false
+	}
+	delete(c.watchedChannels, channelId)
+	return wc.toWatchedChannel(), true
+}
+
+// stopWatchedVideo keeps watcher from starting collection of active live chat stopped by request or auto stop again
+func (c *Collector) stopWatchedVideo(videoId string) {
+	c.watchedChannelsMutex.Lock()
+	defer c.watchedChannelsMutex.Unlock()
+	for _, wc := range c.watchedChannels {
+		v, ok := wc.watchedVideos[videoId]
+		if !ok || v.state == pb.WatchedVideoState_ENDED_VIDEO {
+			continue
+		}
+		if c.verbose {
+			log.Printf("stop watched video (channelId = %v, videoId = %v)", wc.channelId, videoId)
+		}
+		v.state = pb.WatchedVideoState_STOPPED_VIDEO
+	}
+}
+
+func (c *Collector) getWatchedChannelIds() []string {
+	c.watchedChannelsMutex.Lock()
+	defer c.watchedChannelsMutex.Unlock()
+	channelIds := make([]string, 0, len(c.watchedChannels))
+	for channelId := range c.watchedChannels {
+		channelIds = append(channelIds, channelId)
+	}
+	return channelIds
+}
+
+func (c *Collector) discoverChannelVideos(channelSource youtubehelper.ChannelSource, channelId string) {
+	c.watchedChannelsMutex.Lock()
+	wc, ok := c.watchedChannels[channelId]
+	if !ok || time.Since(wc.lastDiscoveredAt) < c.discoveryInterval {
+		c.watchedChannelsMutex.Unlock()
+		return
+	}
+	c.watchedChannelsMutex.Unlock()
+	ctx, cancel := context.WithTimeout(context.Background(), channelFeedFetchTimeout)
+	defer cancel()
+	videoIds, err := channelSource.GetChannelVideoIds(ctx, channelId)
+	var videos []*youtube.Video
+	if err == nil {
+		newVideoIds := make([]string, 0, len(videoIds))
+		c.watchedChannelsMutex.Lock()
+		for _, videoId := range videoIds {
+			if wc.knownVideoIds[videoId] {
+				continue
+			}
+			newVideoIds = append(newVideoIds, videoId)
+		}
+		c.watchedChannelsMutex.Unlock()
+		if len(newVideoIds) > 0 {
+			videos, err = channelSource.GetVideos(newVideoIds)
+		}
+	}
+	c.watchedChannelsMutex.Lock()
+	defer c.watchedChannelsMutex.Unlock()
+	wc.lastDiscoveredAt = time.Now()
+	if err != nil {
+		wc.lastError = err.Error()
+		log.Printf("can not discover videos of channel (channelId = %v): %v", channelId, err)
+		return
+	}
+	wc.lastError = ""
+	for _, video := range videos {
+		wc.knownVideoIds[video.Id] = true
+		if video.Snippet == nil {
+			continue
+		}
+		if video.Snippet.LiveBroadcastContent != "upcoming" && video.Snippet.LiveBroadcastContent != "live" {
+			continue
+		}
+		if _, ok := wc.watchedVideos[video.Id]; ok {
+			continue
+		}
+		wc.watchedVideos[video.Id] = &watchedVideo{
+			videoId:            video.Id,
+			title:              video.Snippet.Title,
+			scheduledStartTime: c.parseScheduledStartTime(video),
+			state:              pb.WatchedVideoState_UPCOMING_VIDEO,
+		}
+		if c.verbose {
+			log.Printf("discover video of channel (channelId = %v, videoId = %v, liveBroadcastContent = %v)", channelId, video.Id, video.Snippet.LiveBroadcastContent)
+		}
+	}
+}
+
+func (c *Collector) startArchiveOfWatchedVideo(channelId string, videoId string) {
+	response, err := c.StartCollectionArchiveLiveChat(&pb.StartCollectionArchiveLiveChatRequest{
+		VideoId: videoId,
+	})
+	if err != nil {
+		log.Printf("can not start collection of archive live chat of watched video (channelId = %v, videoId = %v): %v", channelId, videoId, err)
+		return
+	}
+	if response.Status.Code != pb.Code_SUCCESS && response.Status.Code != pb.Code_IN_PROGRESS {
+		log.Printf("can not start collection of archive live chat of watched video (channelId = %v, videoId = %v): %v", channelId, videoId, response.Status.Message)
+		return
+	}
+	if c.verbose {
+		log.Printf("start collection of archive live chat of watched video (channelId = %v, videoId = %v)", channelId, videoId)
+	}
+}
+
+func (c *Collector) startActiveOfWatchedVideo(channelId string, videoId string, backend pb.ActiveLiveChatBackend) bool {
+	response, err := c.StartCollectionActiveLiveChat(&pb.StartCollectionActiveLiveChatRequest{
+		VideoId: videoId,
+		Backend: backend,
+	})
+	if err != nil {
+		log.Printf("can not start collection of active live chat of watched video (channelId = %v, videoId = %v): %v", channelId, videoId, err)
+		return false
+	}
+	if response.Status.Code != pb.Code_SUCCESS && response.Status.Code != pb.Code_IN_PROGRESS {
+		log.Printf("can not start collection of active live chat of watched video (channelId = %v, videoId = %v): %v", channelId, videoId, response.Status.Message)
+		return false
+	}
+	if c.verbose {
+		log.Printf("start collection of active live chat of watched video (channelId = %v, videoId = %v)", channelId, videoId)
+	}
+	return true
+}
+
+func (c *Collector) checkWatchedVideos(channelSource youtubehelper.ChannelSource, channelId string) {
+	now := time.Now()
+	checkVideoIds := make([]string, 0)
+	checkVideoStates := make(map[string]pb.WatchedVideoState)
+	archiveVideoIds := make([]string, 0)
+	c.watchedChannelsMutex.Lock()
+	wc, ok := c.watchedChannels[channelId]
+	if !ok {
+		c.watchedChannelsMutex.Unlock()
+		return
+	}
+	backend := wc.backend
+	for videoId, v := range wc.watchedVideos {
+		switch v.state {
+		case pb.WatchedVideoState_UPCOMING_VIDEO:
+			if !v.scheduledStartTime.IsZero() && now.After(v.scheduledStartTime.Add(upcomingVideoExpiration)) {
+				delete(wc.watchedVideos, videoId)
+				continue
+			}
+			if v.scheduledStartTime.IsZero() || now.After(v.scheduledStartTime.Add(-c.leadTime)) {
+				checkVideoIds = append(checkVideoIds, videoId)
+				checkVideoStates[videoId] = v.state
+			}
+		case pb.WatchedVideoState_LIVE_VIDEO:
+			// collection is finished, the video is checked only for end of live
+			if !c.checkRequestedVideoForActiveLiveChat(videoId) {
+				checkVideoIds = append(checkVideoIds, videoId)
+				checkVideoStates[videoId] = v.state
+			}
+		case pb.WatchedVideoState_STOPPED_VIDEO:
+			checkVideoIds = append(checkVideoIds, videoId)
+			checkVideoStates[videoId] = v.state
+		case pb.WatchedVideoState_ENDED_VIDEO:
+			if now.After(v.endedAt.Add(c.archiveDelay)) {
+				archiveVideoIds = append(archiveVideoIds, videoId)
+				delete(wc.watchedVideos, videoId)
+			}
+		}
+	}
+	c.watchedChannelsMutex.Unlock()
+	for _, videoId := range archiveVideoIds {
+		c.startArchiveOfWatchedVideo(channelId, videoId)
+	}
+	if len(checkVideoIds) == 0 {
+		return
+	}
+	videos, err := channelSource.GetVideos(checkVideoIds)
+	if err != nil {
+		c.watchedChannelsMutex.Lock()
+		wc.lastError = err.Error()
+		c.watchedChannelsMutex.Unlock()
+		log.Printf("can not check watched videos (channelId = %v): %v", channelId, err)
+		return
+	}
+	foundVideos := make(map[string]*youtube.Video)
+	for _, video := range videos {
+		foundVideos[video.Id] = video
+	}
+	for _, videoId := range checkVideoIds {
+		video, ok := foundVideos[videoId]
+		if !ok {
+			// deleted or private
+			c.watchedChannelsMutex.Lock()
+			delete(wc.watchedVideos, videoId)
+			c.watchedChannelsMutex.Unlock()
+			continue
+		}
+		state := checkVideoStates[videoId]
+		if c.isLiveEnded(video) {
+			state = pb.WatchedVideoState_ENDED_VIDEO
+		} else if state == pb.WatchedVideoState_UPCOMING_VIDEO && video.LiveStreamingDetails != nil && video.LiveStreamingDetails.ActiveLiveChatId != "" {
+			// collection is started only when upcoming video becomes live
+			if c.startActiveOfWatchedVideo(channelId, videoId, backend) {
+				state = pb.WatchedVideoState_LIVE_VIDEO
+			}
+		}
+		c.watchedChannelsMutex.Lock()
+		v, ok := wc.watchedVideos[videoId]
+		if ok {
+			if state == pb.WatchedVideoState_ENDED_VIDEO && v.state != pb.WatchedVideoState_ENDED_VIDEO {
+				v.endedAt = now
+			}
+			// collection is stopped while the video is checked
+			if state != pb.WatchedVideoState_ENDED_VIDEO && v.state == pb.WatchedVideoState_STOPPED_VIDEO {
+				state = pb.WatchedVideoState_STOPPED_VIDEO
+			}
+			v.state = state
+			v.scheduledStartTime = c.parseScheduledStartTime(video)
+		}
+		c.watchedChannelsMutex.Unlock()
+	}
+}
+
+func (c *Collector) watcher() {
+	for {
+		select {
+		case <-time.After(c.watchInterval):
+			channelSource, ok := c.chatSource.(youtubehelper.ChannelSource)
+			if !ok {
+				continue
+			}
+			for _, channelId := range c.getWatchedChannelIds() {
+				c.discoverChannelVideos(channelSource, channelId)
+				c.checkWatchedVideos(channelSource, channelId)
+			}
+		case <-c.watcherFinishRequestCh:
+			goto LAST
+		}
+	}
+LAST:
+	close(c.watcherFinishResponseCh)
+}
+
+func (c *Collector) loadWatchedChannels() error {
+	for _, channelId := range c.watchChannelIds {
+		if err := c.dbOperator.UpdateWatchedChannel(channelId, c.resolveActiveLiveChatBackend(pb.ActiveLiveChatBackend_DEFAULT_BACKEND), c.instanceId); err != nil {
+			return fmt.Errorf("can not update watched channel: %w", err)
+		}
+	}
+	watchedChannels, err := c.dbOperator.GetWatchedChannels(c.instanceId)
+	if err != nil {
+		return fmt.Errorf("can not get watched channels: %w", err)
+	}
+	for _, watchedChannel := range watchedChannels {
+		c.addWatchedChannel(watchedChannel.ChannelId, watchedChannel.Backend)
+	}
+	return nil
+}
+
+func (c *Collector) WatchChannel(request *pb.WatchChannelRequest) (*pb.WatchChannelResponse, error) {
+	status := new(pb.Status)
+	if _, ok := c.chatSource.(youtubehelper.ChannelSource); !ok {
+		status.Code = pb.Code_NOT_PERMITTED
+		status.Message = fmt.Sprintf("chat source does not support watching channel (channelId = %v)", request.ChannelId)
+		return &pb.WatchChannelResponse{
+			Status:         status,
+			WatchedChannel: nil,
+		}, nil
+	}
+	if request.Unwatch {
+		watchedChannel, ok := c.removeWatchedChannel(request.ChannelId)
+		if !ok {
+			status.Code = pb.Code_NOT_FOUND
+			status.Message = fmt.Sprintf("not found watched channel (channelId = %v)", request.ChannelId)
+			return &pb.WatchChannelResponse{
+				Status:         status,
+				WatchedChannel: nil,
+			}, nil
+		}
+		if err := c.dbOperator.DeleteWatchedChannel(request.ChannelId); err != nil {
+			status.Code = pb.Code_INTERNAL_ERROR
+			status.Message = fmt.Sprintf("%v (channelId = %v)", err, request.ChannelId)
+			return &pb.WatchChannelResponse{
+				Status:         status,
+				WatchedChannel: watchedChannel,
+			}, nil
+		}
+		status.Code = pb.Code_SUCCESS
+		status.Message = fmt.Sprintf("success (channelId = %v)", request.ChannelId)
+		return &pb.WatchChannelResponse{
+			Status:         status,
+			WatchedChannel: watchedChannel,
+		}, nil
+	}
+	backend := c.resolveActiveLiveChatBackend(request.Backend)
+	if err := c.dbOperator.UpdateWatchedChannel(request.ChannelId, backend, c.instanceId); err != nil {
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (channelId = %v)", err, request.ChannelId)
+		return &pb.WatchChannelResponse{
+			Status:         status,
+			WatchedChannel: nil,
+		}, nil
+	}
+	watchedChannel := c.addWatchedChannel(request.ChannelId, backend)
+	status.Code = pb.Code_SUCCESS
+	status.Message = fmt.Sprintf("success (channelId = %v)", request.ChannelId)
+	return &pb.WatchChannelResponse{
+		Status:         status,
+		WatchedChannel: watchedChannel,
+	}, nil
+}
@@ -0,0 +1,100 @@
+//go:build sqlite_fts5
+// +build sqlite_fts5
+
+package collector
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/potix/ylcc/protocol"
+	"github.com/potix/ylcc/youtubehelper/fakeyoutube"
+	"google.golang.org/api/youtube/v3"
+)
+
+type fakeChannelSource struct {
+	videos map[string]*youtube.Video
+}
+
+func (f *fakeChannelSource) GetChannelVideoIds(ctx context.Context, channelId string) ([]string, error) {
+	videoIds := make([]string, 0, len(f.videos))
+	for videoId := range f.videos {
+		videoIds = append(videoIds, videoId)
+	}
+	return videoIds, nil
+}
+
+func (f *fakeChannelSource) GetVideos(videoIds []string) ([]*youtube.Video, error) {
+	videos := make([]*youtube.Video, 0, len(videoIds))
+	for _, videoId := range videoIds {
+		if video, ok := f.videos[videoId]; ok {
+			videos = append(videos, video)
+		}
+	}
+	return videos, nil
+}
+
+func getWatchedVideoState(t *testing.T, c *Collector, channelId string, videoId string) pb.WatchedVideoState {
+	t.Helper()
+	c.watchedChannelsMutex.Lock()
+	defer c.watchedChannelsMutex.Unlock()
+	v, ok := c.watchedChannels[channelId].watchedVideos[videoId]
+	if !ok {
+		t.Fatalf("not found watched video (videoId = %v)", videoId)
+	}
+	return v.state
+}
+
+func TestWatcherDoesNotStartStoppedCollectionAgain(t *testing.T) {
+	fakeServer := fakeyoutube.NewServer()
+	fakeServer.Start()
+	defer fakeServer.Stop()
+	liveVideo := fakeyoutube.NewLiveVideo(fakeVideoId, fakeChannelId, "fake live", fakeLiveChatId)
+	fakeServer.AddVideo(liveVideo)
+	c := newFakeYoutubeCollector(t, fakeServer)
+	channelSource := &fakeChannelSource{
+		videos: map[string]*youtube.Video{fakeVideoId: liveVideo},
+	}
+	c.addWatchedChannel(fakeChannelId, pb.ActiveLiveChatBackend_DEFAULT_BACKEND)
+	c.discoverChannelVideos(channelSource, fakeChannelId)
+	c.checkWatchedVideos(channelSource, fakeChannelId)
+	if state := getWatchedVideoState(t, c, fakeChannelId, fakeVideoId); state != pb.WatchedVideoState_LIVE_VIDEO {
+		t.Fatalf("state of watched video = %v, want LIVE_VIDEO", state)
+	}
+	if !c.checkRequestedVideoForActiveLiveChat(fakeVideoId) {
+		t.Fatalf("collection of watched video is not started")
+	}
+
+	stopResponse, err := c.StopCollectionActiveLiveChat(&pb.StopCollectionActiveLiveChatRequest{
+		VideoId: fakeVideoId,
+	})
+	if err != nil || stopResponse.Status.Code != pb.Code_SUCCESS {
+		t.Fatalf("can not stop collection: %v, %+v", err, stopResponse)
+	}
+	deadline := time.Now().Add(fakeCollectorTimeout)
+	for c.checkRequestedVideoForActiveLiveChat(fakeVideoId) {
+		if time.Now().After(deadline) {
+			t.Fatalf("collection is not finished in %v", fakeCollectorTimeout)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	c.checkWatchedVideos(channelSource, fakeChannelId)
+	if state := getWatchedVideoState(t, c, fakeChannelId, fakeVideoId); state != pb.WatchedVideoState_STOPPED_VIDEO {
+		t.Errorf("state of watched video = %v, want STOPPED_VIDEO", state)
+	}
+	if c.checkRequestedVideoForActiveLiveChat(fakeVideoId) {
+		t.Errorf("stopped collection of watched video is started again")
+	}
+
+	// stopped video is still watched for end of live
+	endedVideo := *liveVideo
+	endedVideo.LiveStreamingDetails = &youtube.VideoLiveStreamingDetails{
+		ActualEndTime: time.Now().UTC().Format(time.RFC3339),
+	}
+	channelSource.videos[fakeVideoId] = &endedVideo
+	c.checkWatchedVideos(channelSource, fakeChannelId)
+	if state := getWatchedVideoState(t, c, fakeChannelId, fakeVideoId); state != pb.WatchedVideoState_ENDED_VIDEO {
+		t.Errorf("state of watched video = %v, want ENDED_VIDEO", state)
+	}
+}
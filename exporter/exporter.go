@@ -0,0 +1,192 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	pb "github.com/potix/ylcc/protocol"
+	"strconv"
+)
+
+type options struct {
+	verbose  bool
+	metadata map[string]string
+}
+
+func defaultOptions() *options {
+	return &options{
+		verbose:  false,
+		metadata: nil,
+	}
+}
+
+type Option func(*options)
+
+func Verbose(verbose bool) Option {
+	return func(opts *options) {
+		opts.verbose = verbose
+	}
+}
+
+// Metadata is written to key value metadata of parquet, other formats ignore it
+func Metadata(metadata map[string]string) Option {
+	return func(opts *options) {
+		opts.metadata = metadata
+	}
+}
+
+type ColumnType int
+
+const (
+	StringColumn ColumnType = iota
+	Int64Column
+	BoolColumn
+)
+
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// Writer converts rows to bytes of a file, concatenation of bytes returned by Begin, Write and End is the file
+// values of row are string, int64 or bool in order of columns
+type Writer interface {
+	Begin() ([]byte, error)
+	Write(rows [][]interface{}) ([]byte, error)
+	End() ([]byte, error)
+}
+
+func NewWriter(format pb.ExportFormat, columns []*Column, opts ...Option) (Writer, error) {
+	switch format {
+	case pb.ExportFormat_JSONL_EXPORT_FORMAT:
+		return NewJSONLWriter(columns, opts...), nil
+	case pb.ExportFormat_CSV_EXPORT_FORMAT:
+		return NewCSVWriter(columns, opts...), nil
+	case pb.ExportFormat_PARQUET_EXPORT_FORMAT:
+		return NewParquetWriter(columns, opts...), nil
+	}
+	return nil, fmt.Errorf("unsupported format (format = %v)", format)
+}
+
+// JSONLWriter writes a json object per row, keys of object are names of columns
+type JSONLWriter struct {
+	verbose bool
+	columns []*Column
+}
+
+func (j *JSONLWriter) Begin() ([]byte, error) {
+	return nil, nil
+}
+
+func (j *JSONLWriter) Write(rows [][]interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	for _, row := range rows {
+		if len(row) != len(j.columns) {
+			return nil, fmt.Errorf("length of row does not match columns (row = %v, columns = %v)", len(row), len(j.columns))
+		}
+		// object is built by hand to keep order of columns
+		buffer.WriteByte('{')
+		for i, column := range j.columns {
+			if i > 0 {
+				buffer.WriteByte(',')
+			}
+			name, err := json.Marshal(column.Name)
+			if err != nil {
+				return nil, fmt.Errorf("can not encode name of column (name = %v): %w", column.Name, err)
+			}
+			value, err := json.Marshal(row[i])
+			if err != nil {
+				return nil, fmt.Errorf("can not encode value of column (name = %v): %w", column.Name, err)
+			}
+			buffer.Write(name)
+			buffer.WriteByte(':')
+			buffer.Write(value)
+		}
+		buffer.WriteString("}\n")
+	}
+	return buffer.Bytes(), nil
+}
+
+func (j *JSONLWriter) End() ([]byte, error) {
+	return nil, nil
+}
+
+func NewJSONLWriter(columns []*Column, opts ...Option) *JSONLWriter {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &JSONLWriter{
+		verbose: baseOpts.verbose,
+		columns: columns,
+	}
+}
+
+// CSVWriter writes names of columns as header line and a line per row
+type CSVWriter struct {
+	verbose bool
+	columns []*Column
+}
+
+func (c *CSVWriter) writeRecords(records [][]string) ([]byte, error) {
+	var buffer bytes.Buffer
+	csvWriter := csv.NewWriter(&buffer)
+	if err := csvWriter.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("can not write csv: %w", err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func (c *CSVWriter) Begin() ([]byte, error) {
+	header := make([]string, 0, len(c.columns))
+	for _, column := range c.columns {
+		header = append(header, column.Name)
+	}
+	return c.writeRecords([][]string{header})
+}
+
+func (c *CSVWriter) Write(rows [][]interface{}) ([]byte, error) {
+	records := make([][]string, 0, len(rows))
+	for _, row := range rows {
+		if len(row) != len(c.columns) {
+			return nil, fmt.Errorf("length of row does not match columns (row = %v, columns = %v)", len(row), len(c.columns))
+		}
+		record := make([]string, 0, len(row))
+		for i, column := range c.columns {
+			switch value := row[i].(type) {
+			case string:
+				record = append(record, value)
+			case int64:
+				record = append(record, strconv.FormatInt(value, 10))
+			case bool:
+				record = append(record, strconv.FormatBool(value))
+			default:
+				return nil, fmt.Errorf("unsupported value of column (name = %v, value = %v)", column.Name, value)
+			}
+		}
+		records = append(records, record)
+	}
+	return c.writeRecords(records)
+}
+
+func (c *CSVWriter) End() ([]byte, error) {
+	return nil, nil
+}
+
+func NewCSVWriter(columns []*Column, opts ...Option) *CSVWriter {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &CSVWriter{
+		verbose: baseOpts.verbose,
+		columns: columns,
+	}
+}
@@ -0,0 +1,354 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"sort"
+)
+
+const (
+	parquetMagic     = "PAR1"
+	parquetCreatedBy = "ylcc"
+)
+
+// physical types of parquet
+const (
+	parquetBoolean   int32 = 0
+	parquetInt64     int32 = 2
+	parquetByteArray int32 = 6
+)
+
+const (
+	parquetRequired          int32 = 0
+	parquetConvertedTypeUtf8 int32 = 0
+	parquetEncodingPlain     int32 = 0
+	parquetEncodingRle       int32 = 3
+	parquetUncompressed      int32 = 0
+	parquetDataPage          int32 = 0
+)
+
+// types of thrift compact protocol
+const (
+	thriftI32    byte = 5
+	thriftI64    byte = 6
+	thriftBinary byte = 8
+	thriftList   byte = 9
+	thriftStruct byte = 12
+)
+
+// thriftWriter encodes struct by thrift compact protocol
+type thriftWriter struct {
+	buffer       bytes.Buffer
+	lastFieldIds []int16
+}
+
+func (t *thriftWriter) writeVarint(value uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], value)
+	t.buffer.Write(b[:n])
+}
+
+func (t *thriftWriter) writeZigzag(value int64) {
+	t.writeVarint(uint64((value << 1) ^ (value >> 63)))
+}
+
+func (t *thriftWriter) fieldHeader(fieldId int16, fieldType byte) {
+	lastFieldId := t.lastFieldIds[len(t.lastFieldIds)-1]
+	delta := fieldId - lastFieldId
+	if delta > 0 && delta <= 15 {
+		t.buffer.WriteByte(byte(delta)<<4 | fieldType)
+	} else {
+		t.buffer.WriteByte(fieldType)
+		t.writeZigzag(int64(fieldId))
+	}
+	t.lastFieldIds[len(t.lastFieldIds)-1] = fieldId
+}
+
+func (t *thriftWriter) beginStruct() {
+	t.lastFieldIds = append(t.lastFieldIds, 0)
+}
+
+func (t *thriftWriter) endStruct() {
+	t.buffer.WriteByte(0)
+	t.lastFieldIds = t.lastFieldIds[:len(t.lastFieldIds)-1]
+}
+
+func (t *thriftWriter) structField(fieldId int16) {
+	t.fieldHeader(fieldId, thriftStruct)
+	t.beginStruct()
+}
+
+func (t *thriftWriter) i32Field(fieldId int16, value int32) {
+	t.fieldHeader(fieldId, thriftI32)
+	t.writeZigzag(int64(value))
+}
+
+func (t *thriftWriter) i64Field(fieldId int16, value int64) {
+	t.fieldHeader(fieldId, thriftI64)
+	t.writeZigzag(value)
+}
+
+func (t *thriftWriter) stringField(fieldId int16, value string) {
+	t.fieldHeader(fieldId, thriftBinary)
+	t.writeString(value)
+}
+
+func (t *thriftWriter) writeString(value string) {
+	t.writeVarint(uint64(len(value)))
+	t.buffer.WriteString(value)
+}
+
+func (t *thriftWriter) listField(fieldId int16, elementType byte, size int) {
+	t.fieldHeader(fieldId, thriftList)
+	if size < 15 {
+		t.buffer.WriteByte(byte(size)<<4 | elementType)
+	} else {
+		t.buffer.WriteByte(0xf0 | elementType)
+		t.writeVarint(uint64(size))
+	}
+}
+
+func newThriftWriter() *thriftWriter {
+	return &thriftWriter{
+		lastFieldIds: []int16{0},
+	}
+}
+
+type parquetColumnChunk struct {
+	physicalType int32
+	name         string
+	numValues    int64
+	offset       int64
+	size         int64
+}
+
+type parquetRowGroup struct {
+	columnChunks  []*parquetColumnChunk
+	numRows       int64
+	totalByteSize int64
+}
+
+// ParquetWriter writes uncompressed parquet with plain encoding,
+// all columns are required and each Write becomes a row group
+// see https://github.com/apache/parquet-format
+type ParquetWriter struct {
+	verbose   bool
+	metadata  map[string]string
+	columns   []*Column
+	offset    int64
+	numRows   int64
+	rowGroups []*parquetRowGroup
+}
+
+func (p *ParquetWriter) physicalType(column *Column) int32 {
+	switch column.Type {
+	case Int64Column:
+		return parquetInt64
+	case BoolColumn:
+		return parquetBoolean
+	}
+	return parquetByteArray
+}
+
+func (p *ParquetWriter) encodeValues(column *Column, index int, rows [][]interface{}) ([]byte, error) {
+	var buffer bytes.Buffer
+	var bits byte
+	for n, row := range rows {
+		switch column.Type {
+		case StringColumn:
+			value, ok := row[index].(string)
+			if !ok {
+				return nil, fmt.Errorf("value of column is not string (name = %v, value = %v)", column.Name, row[index])
+			}
+			var length [4]byte
+			binary.LittleEndian.PutUint32(length[:], uint32(len(value)))
+			buffer.Write(length[:])
+			buffer.WriteString(value)
+		case Int64Column:
+			value, ok := row[index].(int64)
+			if !ok {
+				return nil, fmt.Errorf("value of column is not int64 (name = %v, value = %v)", column.Name, row[index])
+			}
+			var b [8]byte
+			binary.LittleEndian.PutUint64(b[:], uint64(value))
+			buffer.Write(b[:])
+		case BoolColumn:
+			value, ok := row[index].(bool)
+			if !ok {
+				return nil, fmt.Errorf("value of column is not bool (name = %v, value = %v)", column.Name, row[index])
+			}
+			// booleans are packed from least significant bit
+			if value {
+				bits |= 1 << (n % 8)
+			}
+			if n%8 == 7 || n == len(rows)-1 {
+				buffer.WriteByte(bits)
+				bits = 0
+			}
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+func (p *ParquetWriter) encodePageHeader(numValues int, pageSize int) []byte {
+	t := newThriftWriter()
+	t.i32Field(1, parquetDataPage)
+	t.i32Field(2, int32(pageSize))
+	t.i32Field(3, int32(pageSize))
+	t.structField(5)
+	t.i32Field(1, int32(numValues))
+	t.i32Field(2, parquetEncodingPlain)
+	t.i32Field(3, parquetEncodingRle)
+	t.i32Field(4, parquetEncodingRle)
+	t.endStruct()
+	t.buffer.WriteByte(0)
+	return t.buffer.Bytes()
+}
+
+func (p *ParquetWriter) Begin() ([]byte, error) {
+	p.offset = int64(len(parquetMagic))
+	return []byte(parquetMagic), nil
+}
+
+// Write writes rows as a row group
+func (p *ParquetWriter) Write(rows [][]interface{}) ([]byte, error) {
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	for _, row := range rows {
+		if len(row) != len(p.columns) {
+			return nil, fmt.Errorf("length of row does not match columns (row = %v, columns = %v)", len(row), len(p.columns))
+		}
+	}
+	var buffer bytes.Buffer
+	rowGroup := &parquetRowGroup{
+		columnChunks:  make([]*parquetColumnChunk, 0, len(p.columns)),
+		numRows:       int64(len(rows)),
+		totalByteSize: 0,
+	}
+	for i, column := range p.columns {
+		// required columns have neither repetition levels nor definition levels
+		values, err := p.encodeValues(column, i, rows)
+		if err != nil {
+			return nil, err
+		}
+		pageHeader := p.encodePageHeader(len(rows), len(values))
+		columnChunk := &parquetColumnChunk{
+			physicalType: p.physicalType(column),
+			name:         column.Name,
+			numValues:    int64(len(rows)),
+			offset:       p.offset + int64(buffer.Len()),
+			size:         int64(len(pageHeader) + len(values)),
+		}
+		buffer.Write(pageHeader)
+		buffer.Write(values)
+		rowGroup.columnChunks = append(rowGroup.columnChunks, columnChunk)
+		rowGroup.totalByteSize += columnChunk.size
+	}
+	p.offset += int64(buffer.Len())
+	p.numRows += int64(len(rows))
+	p.rowGroups = append(p.rowGroups, rowGroup)
+	return buffer.Bytes(), nil
+}
+
+func (p *ParquetWriter) encodeFileMetaData() []byte {
+	t := newThriftWriter()
+	t.i32Field(1, 1)
+	// schema
+	t.listField(2, thriftStruct, len(p.columns)+1)
+	t.beginStruct()
+	t.stringField(4, "schema")
+	t.i32Field(5, int32(len(p.columns)))
+	t.endStruct()
+	for _, column := range p.columns {
+		t.beginStruct()
+		t.i32Field(1, p.physicalType(column))
+		t.i32Field(3, parquetRequired)
+		t.stringField(4, column.Name)
+		if column.Type == StringColumn {
+			t.i32Field(6, parquetConvertedTypeUtf8)
+		}
+		t.endStruct()
+	}
+	t.i64Field(3, p.numRows)
+	// row groups
+	t.listField(4, thriftStruct, len(p.rowGroups))
+	for _, rowGroup := range p.rowGroups {
+		t.beginStruct()
+		t.listField(1, thriftStruct, len(rowGroup.columnChunks))
+		for _, columnChunk := range rowGroup.columnChunks {
+			t.beginStruct()
+			t.i64Field(2, columnChunk.offset)
+			t.structField(3)
+			t.i32Field(1, columnChunk.physicalType)
+			t.listField(2, thriftI32, 2)
+			t.writeZigzag(int64(parquetEncodingPlain))
+			t.writeZigzag(int64(parquetEncodingRle))
+			t.listField(3, thriftBinary, 1)
+			t.writeString(columnChunk.name)
+			t.i32Field(4, parquetUncompressed)
+			t.i64Field(5, columnChunk.numValues)
+			t.i64Field(6, columnChunk.size)
+			t.i64Field(7, columnChunk.size)
+			t.i64Field(9, columnChunk.offset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64Field(2, rowGroup.totalByteSize)
+		t.i64Field(3, rowGroup.numRows)
+		t.endStruct()
+	}
+	// key value metadata
+	if len(p.metadata) > 0 {
+		keys := make([]string, 0, len(p.metadata))
+		for key := range p.metadata {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		t.listField(5, thriftStruct, len(keys))
+		for _, key := range keys {
+			t.beginStruct()
+			t.stringField(1, key)
+			t.stringField(2, p.metadata[key])
+			t.endStruct()
+		}
+	}
+	t.stringField(6, parquetCreatedBy)
+	t.buffer.WriteByte(0)
+	return t.buffer.Bytes()
+}
+
+// End writes file metadata as footer
+func (p *ParquetWriter) End() ([]byte, error) {
+	var buffer bytes.Buffer
+	fileMetaData := p.encodeFileMetaData()
+	buffer.Write(fileMetaData)
+	var length [4]byte
+	binary.LittleEndian.PutUint32(length[:], uint32(len(fileMetaData)))
+	buffer.Write(length[:])
+	buffer.WriteString(parquetMagic)
+	if p.verbose {
+		log.Printf("write parquet footer (rowGroups = %v, rows = %v, size = %v)", len(p.rowGroups), p.numRows, p.offset+int64(buffer.Len()))
+	}
+	return buffer.Bytes(), nil
+}
+
+func NewParquetWriter(columns []*Column, opts ...Option) *ParquetWriter {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &ParquetWriter{
+		verbose:   baseOpts.verbose,
+		metadata:  baseOpts.metadata,
+		columns:   columns,
+		offset:    0,
+		numRows:   0,
+		rowGroups: make([]*parquetRowGroup, 0),
+	}
+}
@@ -0,0 +1,364 @@
+package exporter
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// thriftStructValue is struct decoded by thrift compact protocol, values are
+// bool, int64, []byte, []interface{} or thriftStructValue
+type thriftStructValue map[int16]interface{}
+
+// thriftReader decodes thrift compact protocol without knowing structs of parquet,
+// so test does not share assumptions of thriftWriter
+type thriftReader struct {
+	data     []byte
+	position int
+}
+
+func (t *thriftReader) readByte() (byte, error) {
+	if t.position >= len(t.data) {
+		return 0, fmt.Errorf("unexpected end of thrift (position = %v)", t.position)
+	}
+	b := t.data[t.position]
+	t.position += 1
+	return b, nil
+}
+
+func (t *thriftReader) readVarint() (uint64, error) {
+	value, n := binary.Uvarint(t.data[t.position:])
+	if n <= 0 {
+		return 0, fmt.Errorf("invalid varint (position = %v)", t.position)
+	}
+	t.position += n
+	return value, nil
+}
+
+func (t *thriftReader) readZigzag() (int64, error) {
+	value, err := t.readVarint()
+	if err != nil {
+		return 0, err
+	}
+	return int64(value>>1) ^ -int64(value&1), nil
+}
+
+func (t *thriftReader) readValue(valueType byte) (interface{}, error) {
+	switch valueType {
+	case 1, 2:
+		// boolean in field header
+		return valueType == 1, nil
+	case 3:
+		b, err := t.readByte()
+		return int64(int8(b)), err
+	case 4, 5, 6:
+		return t.readZigzag()
+	case 7:
+		if t.position+8 > len(t.data) {
+			return nil, fmt.Errorf("unexpected end of double (position = %v)", t.position)
+		}
+		value := binary.LittleEndian.Uint64(t.data[t.position:])
+		t.position += 8
+		return value, nil
+	case thriftBinary:
+		length, err := t.readVarint()
+		if err != nil {
+			return nil, err
+		}
+		if t.position+int(length) > len(t.data) {
+			return nil, fmt.Errorf("unexpected end of binary (position = %v)", t.position)
+		}
+		value := t.data[t.position : t.position+int(length)]
+		t.position += int(length)
+		return value, nil
+	case thriftList, 10:
+		header, err := t.readByte()
+		if err != nil {
+			return nil, err
+		}
+		size := int(header >> 4)
+		if size == 15 {
+			longSize, err := t.readVarint()
+			if err != nil {
+				return nil, err
+			}
+			size = int(longSize)
+		}
+		elementType := header & 0x0f
+		elements := make([]interface{}, 0, size)
+		for i := 0; i < size; i++ {
+			var element interface{}
+			if elementType == 1 || elementType == 2 {
+				// boolean element is a byte
+				b, err := t.readByte()
+				if err != nil {
+					return nil, err
+				}
+				element = b == 1
+			} else {
+				element, err = t.readValue(elementType)
+				if err != nil {
+					return nil, err
+				}
+			}
+			elements = append(elements, element)
+		}
+		return elements, nil
+	case thriftStruct:
+		return t.readStruct()
+	}
+	return nil, fmt.Errorf("unsupported thrift type (type = %v, position = %v)", valueType, t.position)
+}
+
+func (t *thriftReader) readStruct() (thriftStructValue, error) {
+	value := make(thriftStructValue)
+	var lastFieldId int16
+	for {
+		header, err := t.readByte()
+		if err != nil {
+			return nil, err
+		}
+		fieldType := header & 0x0f
+		if fieldType == 0 {
+			return value, nil
+		}
+		fieldId := lastFieldId + int16(header>>4)
+		if header>>4 == 0 {
+			id, err := t.readZigzag()
+			if err != nil {
+				return nil, err
+			}
+			fieldId = int16(id)
+		}
+		fieldValue, err := t.readValue(fieldType)
+		if err != nil {
+			return nil, err
+		}
+		value[fieldId] = fieldValue
+		lastFieldId = fieldId
+	}
+}
+
+func (s thriftStructValue) int64Field(t *testing.T, fieldId int16) int64 {
+	t.Helper()
+	value, ok := s[fieldId].(int64)
+	if !ok {
+		t.Fatalf("field %v is not integer: %v", fieldId, s[fieldId])
+	}
+	return value
+}
+
+func (s thriftStructValue) stringField(t *testing.T, fieldId int16) string {
+	t.Helper()
+	value, ok := s[fieldId].([]byte)
+	if !ok {
+		t.Fatalf("field %v is not binary: %v", fieldId, s[fieldId])
+	}
+	return string(value)
+}
+
+func (s thriftStructValue) structField(t *testing.T, fieldId int16) thriftStructValue {
+	t.Helper()
+	value, ok := s[fieldId].(thriftStructValue)
+	if !ok {
+		t.Fatalf("field %v is not struct: %v", fieldId, s[fieldId])
+	}
+	return value
+}
+
+func (s thriftStructValue) structListField(t *testing.T, fieldId int16) []thriftStructValue {
+	t.Helper()
+	elements, ok := s[fieldId].([]interface{})
+	if !ok {
+		t.Fatalf("field %v is not list: %v", fieldId, s[fieldId])
+	}
+	values := make([]thriftStructValue, 0, len(elements))
+	for _, element := range elements {
+		value, ok := element.(thriftStructValue)
+		if !ok {
+			t.Fatalf("element of field %v is not struct: %v", fieldId, element)
+		}
+		values = append(values, value)
+	}
+	return values
+}
+
+// readPlainValues decodes values of data page in plain encoding of physical type
+func readPlainValues(t *testing.T, physicalType int64, data []byte, numValues int) []interface{} {
+	t.Helper()
+	values := make([]interface{}, 0, numValues)
+	position := 0
+	for i := 0; i < numValues; i++ {
+		switch int32(physicalType) {
+		case parquetBoolean:
+			values = append(values, data[i/8]&(1<<(i%8)) != 0)
+		case parquetInt64:
+			values = append(values, int64(binary.LittleEndian.Uint64(data[position:])))
+			position += 8
+		case parquetByteArray:
+			length := int(binary.LittleEndian.Uint32(data[position:]))
+			position += 4
+			values = append(values, string(data[position:position+length]))
+			position += length
+		default:
+			t.Fatalf("unexpected physical type: %v", physicalType)
+		}
+	}
+	return values
+}
+
+type parquetTestFile struct {
+	columnNames []string
+	rows        [][]interface{}
+	metadata    map[string]string
+}
+
+// readParquetTestFile reads required columns of uncompressed parquet in plain encoding
+func readParquetTestFile(t *testing.T, file []byte) *parquetTestFile {
+	t.Helper()
+	if !bytes.HasPrefix(file, []byte(parquetMagic)) || !bytes.HasSuffix(file, []byte(parquetMagic)) {
+		t.Fatalf("no magic of parquet")
+	}
+	footerLength := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footerStart := len(file) - 8 - footerLength
+	if footerStart < len(parquetMagic) {
+		t.Fatalf("invalid length of footer: %v", footerLength)
+	}
+	footerReader := &thriftReader{data: file[footerStart : len(file)-8]}
+	fileMetaData, err := footerReader.readStruct()
+	if err != nil {
+		t.Fatalf("can not decode file metadata: %v", err)
+	}
+	if footerReader.position != footerLength {
+		t.Fatalf("file metadata has trailing bytes (decoded = %v, length = %v)", footerReader.position, footerLength)
+	}
+
+	parquetFile := &parquetTestFile{
+		columnNames: make([]string, 0),
+		rows:        make([][]interface{}, 0),
+		metadata:    make(map[string]string),
+	}
+	schema := fileMetaData.structListField(t, 2)
+	if numChildren := schema[0].int64Field(t, 5); int(numChildren) != len(schema)-1 {
+		t.Fatalf("num_children of root = %v, want %v", numChildren, len(schema)-1)
+	}
+	physicalTypes := make([]int64, 0, len(schema)-1)
+	for _, element := range schema[1:] {
+		if repetition := element.int64Field(t, 3); repetition != int64(parquetRequired) {
+			t.Fatalf("repetition of %v = %v, want required", element.stringField(t, 4), repetition)
+		}
+		parquetFile.columnNames = append(parquetFile.columnNames, element.stringField(t, 4))
+		physicalTypes = append(physicalTypes, element.int64Field(t, 1))
+	}
+
+	for _, rowGroup := range fileMetaData.structListField(t, 4) {
+		numRows := int(rowGroup.int64Field(t, 3))
+		rows := make([][]interface{}, numRows)
+		for i := range rows {
+			rows[i] = make([]interface{}, len(physicalTypes))
+		}
+		columnChunks := rowGroup.structListField(t, 1)
+		if len(columnChunks) != len(physicalTypes) {
+			t.Fatalf("count of column chunks = %v, want %v", len(columnChunks), len(physicalTypes))
+		}
+		for i, columnChunk := range columnChunks {
+			columnMetaData := columnChunk.structField(t, 3)
+			if physicalType := columnMetaData.int64Field(t, 1); physicalType != physicalTypes[i] {
+				t.Fatalf("type of column chunk = %v, want %v", physicalType, physicalTypes[i])
+			}
+			if codec := columnMetaData.int64Field(t, 4); codec != int64(parquetUncompressed) {
+				t.Fatalf("codec of column chunk = %v, want uncompressed", codec)
+			}
+			pageReader := &thriftReader{data: file, position: int(columnMetaData.int64Field(t, 9))}
+			pageHeader, err := pageReader.readStruct()
+			if err != nil {
+				t.Fatalf("can not decode page header: %v", err)
+			}
+			if pageType := pageHeader.int64Field(t, 1); pageType != int64(parquetDataPage) {
+				t.Fatalf("type of page = %v, want data page", pageType)
+			}
+			dataPageHeader := pageHeader.structField(t, 5)
+			if encoding := dataPageHeader.int64Field(t, 2); encoding != int64(parquetEncodingPlain) {
+				t.Fatalf("encoding of page = %v, want plain", encoding)
+			}
+			numValues := int(dataPageHeader.int64Field(t, 1))
+			if numValues != numRows {
+				t.Fatalf("count of values = %v, want %v", numValues, numRows)
+			}
+			pageSize := int(pageHeader.int64Field(t, 3))
+			if pageReader.position+pageSize > footerStart {
+				t.Fatalf("page overlaps footer")
+			}
+			values := readPlainValues(t, physicalTypes[i], file[pageReader.position:pageReader.position+pageSize], numValues)
+			for j, value := range values {
+				rows[j][i] = value
+			}
+		}
+		parquetFile.rows = append(parquetFile.rows, rows...)
+	}
+	if numRows := fileMetaData.int64Field(t, 3); int(numRows) != len(parquetFile.rows) {
+		t.Fatalf("num_rows = %v, want %v", numRows, len(parquetFile.rows))
+	}
+	if _, ok := fileMetaData[5]; ok {
+		for _, keyValue := range fileMetaData.structListField(t, 5) {
+			parquetFile.metadata[keyValue.stringField(t, 1)] = keyValue.stringField(t, 2)
+		}
+	}
+	return parquetFile
+}
+
+func TestParquetWriterCanBeReadBack(t *testing.T) {
+	columns := []*Column{
+		{Name: "messageId", Type: StringColumn},
+		{Name: "memberMonth", Type: Int64Column},
+		{Name: "isSuperChat", Type: BoolColumn},
+	}
+	rowGroups := [][][]interface{}{
+		{
+			{"message01", int64(0), false},
+			{"メッセージ02", int64(-1), true},
+			{"", int64(1 << 40), false},
+		},
+		{},
+	}
+	// more than 8 rows to pack booleans to several bytes
+	for i := 0; i < 10; i++ {
+		rowGroups[1] = append(rowGroups[1], []interface{}{fmt.Sprintf("message%02d", i+4), int64(i), i%3 == 0})
+	}
+	metadata := map[string]string{"ylcc.videos": `[{"videoId":"video01"}]`}
+	writer := NewParquetWriter(columns, Metadata(metadata))
+
+	var file bytes.Buffer
+	chunk, err := writer.Begin()
+	if err != nil {
+		t.Fatalf("can not begin parquet: %v", err)
+	}
+	file.Write(chunk)
+	wantRows := make([][]interface{}, 0)
+	for _, rows := range rowGroups {
+		chunk, err := writer.Write(rows)
+		if err != nil {
+			t.Fatalf("can not write rows: %v", err)
+		}
+		file.Write(chunk)
+		wantRows = append(wantRows, rows...)
+	}
+	chunk, err = writer.End()
+	if err != nil {
+		t.Fatalf("can not end parquet: %v", err)
+	}
+	file.Write(chunk)
+
+	parquetFile := readParquetTestFile(t, file.Bytes())
+	if want := []string{"messageId", "memberMonth", "isSuperChat"}; !reflect.DeepEqual(parquetFile.columnNames, want) {
+		t.Errorf("columns = %v, want %v", parquetFile.columnNames, want)
+	}
+	if !reflect.DeepEqual(parquetFile.rows, wantRows) {
+		t.Errorf("rows = %v, want %v", parquetFile.rows, wantRows)
+	}
+	if !reflect.DeepEqual(parquetFile.metadata, metadata) {
+		t.Errorf("metadata = %v, want %v", parquetFile.metadata, metadata)
+	}
+}
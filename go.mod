@@ -3,9 +3,11 @@ module github.com/potix/ylcc
 go 1.16
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/golang/groupcache v0.0.0-20210331224755-41bb18bfe9da // indirect
 	github.com/golang/protobuf v1.5.2
 	github.com/google/uuid v1.3.0
+	github.com/lib/pq v1.10.7
 	github.com/mattn/go-sqlite3 v1.14.16
 	github.com/potix/utils/configurator v0.0.0-20230227071827-76c10ec5df3c
 	github.com/potix/utils/signal v0.0.0-20230227071827-76c10ec5df3c
@@ -78,6 +78,14 @@ func (h *Handler) GetCachedActiveLiveChat(ctx context.Context, request *pb.GetCa
 	return h.collector.GetCachedActiveLiveChat(request)
 }
 
+func (h *Handler) FollowActiveLiveChat(request *pb.FollowActiveLiveChatRequest, server pb.Ylcc_FollowActiveLiveChatServer) error {
+	return h.collector.FollowActiveLiveChat(request, server.Send)
+}
+
+func (h *Handler) StopCollectionActiveLiveChat(ctx context.Context, request *pb.StopCollectionActiveLiveChatRequest) (*pb.StopCollectionActiveLiveChatResponse, error) {
+	return h.collector.StopCollectionActiveLiveChat(request)
+}
+
 func (h *Handler) StartCollectionArchiveLiveChat(ctx context.Context, request *pb.StartCollectionArchiveLiveChatRequest) (*pb.StartCollectionArchiveLiveChatResponse, error) {
 	return h.collector.StartCollectionArchiveLiveChat(request)
 }
@@ -86,6 +94,53 @@ func (h *Handler) GetArchiveLiveChat(ctx context.Context, request *pb.GetArchive
 	return h.collector.GetArchiveLiveChat(request)
 }
 
+func (h *Handler) StopCollectionArchiveLiveChat(ctx context.Context, request *pb.StopCollectionArchiveLiveChatRequest) (*pb.StopCollectionArchiveLiveChatResponse, error) {
+	return h.collector.StopCollectionArchiveLiveChat(request)
+}
+
+func (h *Handler) PollArchiveLiveChatProgress(request *pb.PollArchiveLiveChatProgressRequest, server pb.Ylcc_PollArchiveLiveChatProgressServer) error {
+	subscribeArchiveLiveChatProgressParams, err := h.collector.SubscribeArchiveLiveChatProgress(request.VideoId)
+	if err != nil {
+		return fmt.Errorf("can not subscribe: %w", err)
+	}
+	defer h.collector.UnsubscribeArchiveLiveChatProgress(subscribeArchiveLiveChatProgressParams)
+	for {
+		response, ok := <-subscribeArchiveLiveChatProgressParams.GetSubscriberCh()
+		if !ok {
+			return nil
+		}
+		if err := server.Send(response); err != nil {
+			return fmt.Errorf("can not send response: %w", err)
+		}
+	}
+}
+
+func (h *Handler) ReplayArchiveLiveChat(request *pb.ReplayArchiveLiveChatRequest, server pb.Ylcc_ReplayArchiveLiveChatServer) error {
+	replayCtx, err := h.collector.StartReplayArchiveLiveChat(request)
+	if err != nil {
+		return fmt.Errorf("can not start replay: %w", err)
+	}
+	defer h.collector.StopReplayArchiveLiveChat(replayCtx)
+	for {
+		select {
+		case response, ok := <-replayCtx.GetSubscriberCh():
+			if !ok {
+				return nil
+			}
+			if err := server.Send(response); err != nil {
+				return fmt.Errorf("can not send response: %w", err)
+			}
+		case <-server.Context().Done():
+			// nothing is sent while replay is paused, so disconnection of client is noticed here
+			return nil
+		}
+	}
+}
+
+func (h *Handler) ControlReplayArchiveLiveChat(ctx context.Context, request *pb.ControlReplayArchiveLiveChatRequest) (*pb.ControlReplayArchiveLiveChatResponse, error) {
+	return h.collector.ControlReplayArchiveLiveChat(request)
+}
+
 func (h *Handler) StartCollectionWordCloudMessages(ctx context.Context, request *pb.StartCollectionWordCloudMessagesRequest) (*pb.StartCollectionWordCloudMessagesResponse, error) {
 	return h.processor.StartCollectionWordCloudMessages(request)
 }
@@ -131,6 +186,49 @@ func (h *Handler) PollGroupingActiveLiveChat(request *pb.PollGroupingActiveLiveC
 	}
 }
 
+func (h *Handler) SearchLiveChat(ctx context.Context, request *pb.SearchLiveChatRequest) (*pb.SearchLiveChatResponse, error) {
+	return h.collector.SearchLiveChat(request)
+}
+
+func (h *Handler) ExportLiveChat(request *pb.ExportLiveChatRequest, server pb.Ylcc_ExportLiveChatServer) error {
+	return h.collector.ExportLiveChat(request, server.Send)
+}
+
+func (h *Handler) ImportArchiveLiveChat(server pb.Ylcc_ImportArchiveLiveChatServer) error {
+	response, err := h.collector.ImportArchiveLiveChat(server.Recv)
+	if err != nil {
+		return fmt.Errorf("can not import: %w", err)
+	}
+	if err := server.SendAndClose(response); err != nil {
+		return fmt.Errorf("can not send response: %w", err)
+	}
+	return nil
+}
+
+func (h *Handler) GetChatStatistics(ctx context.Context, request *pb.GetChatStatisticsRequest) (*pb.GetChatStatisticsResponse, error) {
+	return h.collector.GetChatStatistics(request)
+}
+
+func (h *Handler) ListCollections(ctx context.Context, request *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	return h.processor.ListCollections(request)
+}
+
+func (h *Handler) WatchChannel(ctx context.Context, request *pb.WatchChannelRequest) (*pb.WatchChannelResponse, error) {
+	return h.collector.WatchChannel(request)
+}
+
+func (h *Handler) PinVideo(ctx context.Context, request *pb.PinVideoRequest) (*pb.PinVideoResponse, error) {
+	return h.collector.PinVideo(request)
+}
+
+func (h *Handler) GetCleanerReports(ctx context.Context, request *pb.GetCleanerReportsRequest) (*pb.GetCleanerReportsResponse, error) {
+	return h.collector.GetCleanerReports(request)
+}
+
+func (h *Handler) GetApiKeyUsage(ctx context.Context, request *pb.GetApiKeyUsageRequest) (*pb.GetApiKeyUsageResponse, error) {
+	return h.collector.GetApiKeyUsage(request)
+}
+
 func NewHandler(processor *processor.Processor, collector *collector.Collector, opts ...Option) *Handler {
 	baseOpts :=  defaultOptions()
 	for _, opt := range opts {
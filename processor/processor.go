@@ -15,6 +15,7 @@ import (
 	"image/color"
 	"image/png"
 	"sync"
+	"sync/atomic"
 	"github.com/google/uuid"
 	"crypto/sha1"
 	"golang.org/x/text/unicode/norm"
@@ -44,9 +45,9 @@ type Processor struct {
 	mecabrc                      string
 	font                         string
 	requestedVideoWordCloudMutex *sync.Mutex
-	requestedVideoWordCloud      map[string]bool
+	requestedVideoWordCloud      map[string]time.Time
 	videoWordCloudMessagesMutex  *sync.Mutex
-	videoWordCloudMessages       map[string][]*pb.ActiveLiveChatMessage
+	videoWordCloudMessages       map[string][]*collector.ChatMessage
 	requestedVoteMutex           *sync.Mutex
 	requestedVote                map[string]*voteContext
 	requestedGroupingMutex       *sync.Mutex
@@ -61,7 +62,7 @@ func (p *Processor) registerRequestedVideoWordCloud(videoId string) bool {
         if ok {
                 return false
         }
-        p.requestedVideoWordCloud[videoId] = true
+        p.requestedVideoWordCloud[videoId] = time.Now()
         return true
 }
 
@@ -86,48 +87,78 @@ func (p *Processor) unregisterRequestedVideoWordCloud(videoId string) bool {
         return true
 }
 
-func (p *Processor) addWordCloudMessage(videoId string, activeLiveChatMessage *pb.ActiveLiveChatMessage) {
+func (p *Processor) isTargetAuthor(target pb.Target, chatMessage *collector.ChatMessage) bool {
+	if target == pb.Target_OWNER_MODERATOR {
+		return chatMessage.AuthorIsChatModerator ||
+			chatMessage.AuthorIsChatOwner
+	} else if target == pb.Target_OWNER_MODERATOR_SPONSOR {
+		return chatMessage.AuthorIsChatModerator ||
+			chatMessage.AuthorIsChatOwner ||
+			chatMessage.AuthorIsChatSponsor
+	}
+	return true
+}
+
+func (p *Processor) addWordCloudMessage(videoId string, chatMessage *collector.ChatMessage) {
 	p.videoWordCloudMessagesMutex.Lock()
 	defer p.videoWordCloudMessagesMutex.Unlock()
-	activeLiveChatMessages, ok := p.videoWordCloudMessages[videoId]
+	chatMessages, ok := p.videoWordCloudMessages[videoId]
 	if !ok {
-		activeLiveChatMessages := make([]*pb.ActiveLiveChatMessage, 0, 2000)
-		activeLiveChatMessages = append(activeLiveChatMessages, activeLiveChatMessage)
-		p.videoWordCloudMessages[videoId] = activeLiveChatMessages
+		chatMessages := make([]*collector.ChatMessage, 0, 2000)
+		chatMessages = append(chatMessages, chatMessage)
+		p.videoWordCloudMessages[videoId] = chatMessages
 		return
 	}
-	p.videoWordCloudMessages[videoId] = append(activeLiveChatMessages, activeLiveChatMessage)
+	p.videoWordCloudMessages[videoId] = append(chatMessages, chatMessage)
+}
+
+func (p *Processor) selectWordCloudMessages(chatMessages []*collector.ChatMessage, target pb.Target, messageLimit int) []string {
+	messages := make([]string, 0, len(chatMessages))
+	for i := len(chatMessages) - 1; i >= 0 && i >= len(chatMessages) - 1 - messageLimit; i -= 1  {
+		chatMessage := chatMessages[i];
+		if !p.isTargetAuthor(target, chatMessage) {
+			continue
+		}
+		// XXX TODO 連投防止
+		messages = append(messages, chatMessage.Message)
+	}
+	return messages
 }
 
 func (p *Processor) getWordCloudMessages(videoId string, target pb.Target, messageLimit int) ([]string, bool) {
 	p.videoWordCloudMessagesMutex.Lock()
 	defer p.videoWordCloudMessagesMutex.Unlock()
-	activeLiveChatMessages, ok := p.videoWordCloudMessages[videoId]
+	chatMessages, ok := p.videoWordCloudMessages[videoId]
 	if !ok {
 		if p.verbose {
 			log.Printf("not found word cloud message (videoId = %v)", videoId)
 		}
 		return nil, false
 	}
-	messages := make([]string, 0, len(activeLiveChatMessages))
-	for i := len(activeLiveChatMessages) - 1; i >= 0 && i >= len(activeLiveChatMessages) - 1 - messageLimit; i -= 1  {
-		activeLiveChatMessage := activeLiveChatMessages[i];
-		if target == pb.Target_OWNER_MODERATOR {
-			if !(activeLiveChatMessage.AuthorIsChatModerator ||
-				activeLiveChatMessage.AuthorIsChatOwner) {
-				continue
-			}
-		} else if target == pb.Target_OWNER_MODERATOR_SPONSOR {
-			if !(activeLiveChatMessage.AuthorIsChatModerator ||
-				activeLiveChatMessage.AuthorIsChatOwner ||
-				activeLiveChatMessage.AuthorIsChatSponsor) {
+	return p.selectWordCloudMessages(chatMessages, target, messageLimit), true
+}
+
+func (p *Processor) getArchiveWordCloudMessages(videoId string, target pb.Target, messageLimit int) ([]string, *pb.Status) {
+	status := p.collector.CheckArchiveLiveChat(videoId)
+	if status.Code != pb.Code_SUCCESS {
+		return nil, status
+	}
+	chatMessages := make([]*collector.ChatMessage, 0, 2000)
+	err := p.collector.ReadArchiveChatMessages(videoId, 0, func(archiveChatMessages []*collector.ChatMessage) bool {
+		for _, chatMessage := range archiveChatMessages {
+			if !chatMessage.IsChat {
 				continue
 			}
+			chatMessages = append(chatMessages, chatMessage)
 		}
-		// XXX TODO 連投防止
-		messages = append(messages, activeLiveChatMessage.DisplayMessage)
+		return false
+	})
+	if err != nil {
+		status.Code = pb.Code_INTERNAL_ERROR
+		status.Message = fmt.Sprintf("%v (videoId = %v)", err, videoId)
+		return nil, status
 	}
-	return messages, true
+	return p.selectWordCloudMessages(chatMessages, target, messageLimit), status
 }
 
 func (p *Processor) deleteWordCloudMessages(videoId string) {
@@ -159,18 +190,51 @@ func (p *Processor) storeWordCloudMessages(videoId string) {
 			return
 		}
 		for _, activeLiveChatMessage := range response.ActiveLiveChatMessages {
-			if activeLiveChatMessage.DisplayMessage == "" {
+			chatMessage := collector.NewChatMessageFromActiveLiveChatMessage(activeLiveChatMessage)
+			if !chatMessage.IsChat {
 				continue
 			}
 			if p.verbose {
-				log.Printf("add message for word cloud (videoId = %v,  message = %v)", videoId, activeLiveChatMessage.DisplayMessage)
+				log.Printf("add message for word cloud (videoId = %v,  message = %v)", videoId, chatMessage.Message)
 			}
-			p.addWordCloudMessage(videoId, activeLiveChatMessage)
+			p.addWordCloudMessage(videoId, chatMessage)
 		}
 	}
 }
 
+func (p *Processor) startCollectionArchiveWordCloudMessages(request *pb.StartCollectionWordCloudMessagesRequest) (*pb.StartCollectionWordCloudMessagesResponse, error) {
+	// word cloud of archive is created from stored archive live chat at GetWordCloud
+	status := new(pb.Status)
+	startCollectionArchiveLiveChatRequest := &pb.StartCollectionArchiveLiveChatRequest {
+		VideoId: request.VideoId,
+	}
+	startCollectionArchiveLiveChatResponse, err := p.collector.StartCollectionArchiveLiveChat(startCollectionArchiveLiveChatRequest)
+	if err != nil {
+                status.Code = pb.Code_INTERNAL_ERROR
+                status.Message = fmt.Sprintf("%v (videoId = %v)", err, request.VideoId)
+		return &pb.StartCollectionWordCloudMessagesResponse{
+			Status: status,
+			Video: nil,
+		}, nil
+	}
+	if startCollectionArchiveLiveChatResponse.Status.Code != pb.Code_SUCCESS && startCollectionArchiveLiveChatResponse.Status.Code != pb.Code_IN_PROGRESS {
+		return &pb.StartCollectionWordCloudMessagesResponse{
+			Status: startCollectionArchiveLiveChatResponse.Status,
+			Video: startCollectionArchiveLiveChatResponse.Video,
+		}, nil
+	}
+	status.Code = pb.Code_SUCCESS
+        status.Message = fmt.Sprintf("success (videoId = %v)", request.VideoId)
+        return &pb.StartCollectionWordCloudMessagesResponse{
+                Status: status,
+                Video:  startCollectionArchiveLiveChatResponse.Video,
+        }, nil
+}
+
 func (p *Processor) StartCollectionWordCloudMessages(request *pb.StartCollectionWordCloudMessagesRequest) (*pb.StartCollectionWordCloudMessagesResponse, error) {
+	if request.Source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+		return p.startCollectionArchiveWordCloudMessages(request)
+	}
 	status := new(pb.Status)
 	ok := p.registerRequestedVideoWordCloud(request.VideoId)
 	if !ok {
@@ -213,25 +277,39 @@ func (p *Processor) StartCollectionWordCloudMessages(request *pb.StartCollection
 
 func (p *Processor) GetWordCloud(request *pb.GetWordCloudRequest) (*pb.GetWordCloudResponse, error) {
 	status := new(pb.Status)
-	progress := p.checkRequestedVideoWordCloud(request.VideoId)
-	if !progress {
-		status.Code = pb.Code_NOT_FOUND
-		status.Message = fmt.Sprintf("not found word cloud messages (videoId = %v)", request.VideoId)
-		return &pb.GetWordCloudResponse{
-			Status:   status,
-			MimeType: "",
-			Data:     nil,
-		}, nil
-	}
-	messages, ok := p.getWordCloudMessages(request.VideoId, request.Target, int(request.MessageLimit))
-	if !ok {
-		status.Code = pb.Code_IN_PROGRESS
-		status.Message = fmt.Sprintf("not found word cloud messages (videoId = %v)", request.VideoId)
-		return &pb.GetWordCloudResponse{
-			Status:   status,
-			MimeType: "",
-			Data:     nil,
-		}, nil
+	var messages []string
+	if request.Source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+		archiveMessages, archiveStatus := p.getArchiveWordCloudMessages(request.VideoId, request.Target, int(request.MessageLimit))
+		if archiveStatus.Code != pb.Code_SUCCESS {
+			return &pb.GetWordCloudResponse{
+				Status:   archiveStatus,
+				MimeType: "",
+				Data:     nil,
+			}, nil
+		}
+		messages = archiveMessages
+	} else {
+		progress := p.checkRequestedVideoWordCloud(request.VideoId)
+		if !progress {
+			status.Code = pb.Code_NOT_FOUND
+			status.Message = fmt.Sprintf("not found word cloud messages (videoId = %v)", request.VideoId)
+			return &pb.GetWordCloudResponse{
+				Status:   status,
+				MimeType: "",
+				Data:     nil,
+			}, nil
+		}
+		activeMessages, ok := p.getWordCloudMessages(request.VideoId, request.Target, int(request.MessageLimit))
+		if !ok {
+			status.Code = pb.Code_IN_PROGRESS
+			status.Message = fmt.Sprintf("not found word cloud messages (videoId = %v)", request.VideoId)
+			return &pb.GetWordCloudResponse{
+				Status:   status,
+				MimeType: "",
+				Data:     nil,
+			}, nil
+		}
+		messages = activeMessages
 	}
 	verboseOpt := counter.Verbose(p.verbose)
 	wordCounter := counter.NewWordCounter(p.mecabrc, verboseOpt)
@@ -290,6 +368,8 @@ func (p *Processor) GetWordCloud(request *pb.GetWordCloudRequest) (*pb.GetWordCl
 type voteContext struct {
 	voteId              string
 	videoId             string
+	source              pb.ChatMessageSource
+	startOffsetMsec     int64
 	target              pb.Target
 	duration            int32
 	choices             []*pb.VoteChoice
@@ -300,6 +380,7 @@ type voteContext struct {
 	counts              []*pb.VoteCount
 	voted               map[string]bool
 	stopped             bool
+	startedAt           time.Time
 }
 
 func (v *voteContext) setStopTimer() {
@@ -383,6 +464,8 @@ func (p *Processor) createVoteContext(request *pb.OpenVoteRequest) (*voteContext
 	voteCtx := &voteContext {
 		voteId: voteId,
 		videoId: request.VideoId,
+		source: request.Source,
+		startOffsetMsec: request.StartOffsetMsec,
 		target: request.Target,
 		duration: request.Duration,
 		choices: request.Choices,
@@ -393,6 +476,7 @@ func (p *Processor) createVoteContext(request *pb.OpenVoteRequest) (*voteContext
 		counts: counts,
 		voted: make(map[string]bool),
 		stopped: false,
+		startedAt: time.Now(),
 	}
 	return voteCtx, nil
 }
@@ -402,6 +486,72 @@ type match struct {
 	messageIdx int
 }
 
+func (p *Processor) countVote(voteCtx *voteContext, chatMessage *collector.ChatMessage) {
+	if !chatMessage.IsChat {
+		return
+	}
+	_, ok := voteCtx.voted[chatMessage.AuthorChannelId]
+	if ok {
+		// already voted
+		return
+	}
+	if !p.isTargetAuthor(voteCtx.target, chatMessage) {
+		return
+	}
+	normDisplayMessage := norm.NFKC.String(chatMessage.Message)
+	normDisplayMessage = p.stampRe.ReplaceAllString(normDisplayMessage, "")
+
+	matches := make([]*match, 0, len(voteCtx.choices))
+	for choiceIdx := 0; choiceIdx < len(voteCtx.choices); choiceIdx += 1 {
+		choice := voteCtx.choices[choiceIdx]
+		messageIdx := strings.Index(normDisplayMessage, choice.Label)
+		if messageIdx == -1 {
+			continue
+		}
+		matches = append(matches, &match{ choiceIdx: choiceIdx, messageIdx: messageIdx })
+	}
+	if len(matches) == 0 {
+		// not match label
+		return
+	}
+	sort.Slice(matches , func(i, j int) bool { return matches[i].messageIdx < matches[j].messageIdx })
+	m := matches[0]
+	voteCtx.total += 1
+	voteCtx.counts[m.choiceIdx].Count += 1
+	voteCtx.voted[chatMessage.AuthorChannelId] = true
+}
+
+func (p *Processor) archiveVoteWatcher(voteCtx *voteContext) {
+	if p.verbose {
+		log.Printf("archive vote count start (voteId = %v)", voteCtx.voteId)
+	}
+	endOffsetMsec := int64(-1)
+	if voteCtx.duration > 0 {
+		endOffsetMsec = voteCtx.startOffsetMsec + int64(voteCtx.duration) * 1000
+	}
+	err := p.collector.ReadArchiveChatMessages(voteCtx.videoId, voteCtx.startOffsetMsec, func(chatMessages []*collector.ChatMessage) bool {
+		for _, chatMessage := range chatMessages {
+			if endOffsetMsec >= 0 && chatMessage.VideoOffsetTimeMsec >= endOffsetMsec {
+				return true
+			}
+			select {
+			case <-voteCtx.watcherCloseEventCh:
+				return true
+			default:
+			}
+			p.countVote(voteCtx, chatMessage)
+		}
+		return false
+	})
+	if err != nil {
+		log.Printf("can not count archive vote (voteId = %v): %v", voteCtx.voteId, err)
+	}
+	voteCtx.stopped = true
+	if p.verbose {
+		log.Printf("archive vote count end (voteId = %v)", voteCtx.voteId)
+	}
+}
+
 func (p *Processor) voteWatcher(voteCtx *voteContext) {
 	if p.verbose {
 		log.Printf("vote watch start (voteId = %v)", voteCtx.voteId)
@@ -432,47 +582,7 @@ func (p *Processor) voteWatcher(voteCtx *voteContext) {
 				break
 			}
 			for _, activeLiveChatMessage := range response.ActiveLiveChatMessages {
-				if activeLiveChatMessage.DisplayMessage == "" {
-					continue
-				}
-				_, ok := voteCtx.voted[activeLiveChatMessage.AuthorChannelId]
-				if ok {
-					// already voted
-					continue
-				}
-				if voteCtx.target == pb.Target_OWNER_MODERATOR {
-					if !(activeLiveChatMessage.AuthorIsChatModerator ||
-						activeLiveChatMessage.AuthorIsChatOwner) {
-						continue
-					}
-				} else if voteCtx.target == pb.Target_OWNER_MODERATOR_SPONSOR {
-					if !(activeLiveChatMessage.AuthorIsChatModerator ||
-						activeLiveChatMessage.AuthorIsChatOwner ||
-						activeLiveChatMessage.AuthorIsChatSponsor) {
-						continue
-					}
-				}
-				normDisplayMessage := norm.NFKC.String(activeLiveChatMessage.DisplayMessage)
-				normDisplayMessage = p.stampRe.ReplaceAllString(normDisplayMessage, "")
-
-				matches := make([]*match, 0, len(voteCtx.choices))
-				for choiceIdx := 0; choiceIdx < len(voteCtx.choices); choiceIdx += 1 {
-					choice := voteCtx.choices[choiceIdx]
-					messageIdx := strings.Index(normDisplayMessage, choice.Label)
-					if messageIdx == -1 {
-						continue
-					}
-					matches = append(matches, &match{ choiceIdx: choiceIdx, messageIdx: messageIdx })
-				}
-				if len(matches) == 0 {
-					// not match label
-					continue
-				}
-				sort.Slice(matches , func(i, j int) bool { return matches[i].messageIdx < matches[j].messageIdx })
-				m := matches[0]
-				voteCtx.total += 1
-				voteCtx.counts[m.choiceIdx].Count += 1
-				voteCtx.voted[activeLiveChatMessage.AuthorChannelId] = true
+				p.countVote(voteCtx, collector.NewChatMessageFromActiveLiveChatMessage(activeLiveChatMessage))
 			}
 		case duration := <-voteCtx.watcherResetEventCh:
 			if p.verbose {
@@ -497,6 +607,36 @@ func (p *Processor) voteWatcher(voteCtx *voteContext) {
 	}
 }
 
+func (p *Processor) openArchiveVote(voteCtx *voteContext) (*pb.OpenVoteResponse, error) {
+	status := p.collector.CheckArchiveLiveChat(voteCtx.videoId)
+	if status.Code != pb.Code_SUCCESS {
+		return &pb.OpenVoteResponse{
+			Status: status,
+			VoteId: "",
+			Video: nil,
+		}, nil
+	}
+	getVideoResponse, err := p.collector.GetVideo(&pb.GetVideoRequest{ VideoId: voteCtx.videoId })
+	if err != nil {
+                status.Code = pb.Code_INTERNAL_ERROR
+                status.Message = fmt.Sprintf("%v (videoId = %v)", err, voteCtx.videoId)
+		return &pb.OpenVoteResponse{
+			Status: status,
+			VoteId: "",
+			Video: nil,
+		}, nil
+	}
+	p.registerRequestedVote(voteCtx)
+	go p.archiveVoteWatcher(voteCtx)
+	status.Code = pb.Code_SUCCESS
+        status.Message = fmt.Sprintf("success (videoId = %v, voteId = %v)", voteCtx.videoId, voteCtx.voteId)
+        return &pb.OpenVoteResponse{
+                Status: status,
+		VoteId: voteCtx.voteId,
+                Video:  getVideoResponse.Video,
+        }, nil
+}
+
 func (p *Processor) OpenVote(request *pb.OpenVoteRequest) (*pb.OpenVoteResponse, error) {
 	status := new(pb.Status)
 	voteCtx, err := p.createVoteContext(request)
@@ -509,6 +649,9 @@ func (p *Processor) OpenVote(request *pb.OpenVoteRequest) (*pb.OpenVoteResponse,
 			Video: nil,
 		}, nil
 	}
+	if voteCtx.source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+		return p.openArchiveVote(voteCtx)
+	}
 	startCollectionActiveLiveChatRequest := &pb.StartCollectionActiveLiveChatRequest {
 		VideoId: request.VideoId,
 	}
@@ -551,6 +694,13 @@ func (p *Processor) UpdateVoteDuration(request *pb.UpdateVoteDurationRequest) (*
 			Status: status,
 		}, nil
 	}
+	if voteCtx.source == pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE {
+                status.Code = pb.Code_NOT_PERMITTED
+                status.Message = fmt.Sprintf("can not update duration of archive vote (voteId = %v)", request.VoteId)
+		return &pb.UpdateVoteDurationResponse{
+			Status: status,
+		}, nil
+	}
 	voteCtx.emitWatcherResetEvent(request.Duration)
 	status.Code = pb.Code_SUCCESS
         status.Message = fmt.Sprintf("success (videoId = %v, voteId = %v)", voteCtx.videoId, voteCtx.voteId)
@@ -608,6 +758,8 @@ type groupingContext struct {
 	group                               map[string]int
 	watcherCloseEventCh                 chan int
 	subscriberCh                        chan *pb.PollGroupingActiveLiveChatResponse
+	startedAt                           time.Time
+	messageCount                        int64
 }
 
 func (g *groupingContext) emitWatcherCloseEvent() {
@@ -684,6 +836,8 @@ func (p *Processor) createGroupingContext(request *pb.StartGroupingActiveLiveCha
 		group:                            make(map[string]int),
 		watcherCloseEventCh:              make(chan int),
 		subscriberCh:                     make(chan *pb.PollGroupingActiveLiveChatResponse),
+		startedAt:                        time.Now(),
+		messageCount:                     0,
 	}
 	return groupingCtx, nil
 }
@@ -714,22 +868,23 @@ func (p *Processor) groupingWatcher(groupingCtx *groupingContext) {
 				return
 			}
 			for _, activeLiveChatMessage := range response.ActiveLiveChatMessages {
-				if activeLiveChatMessage.DisplayMessage == "" {
+				chatMessage := collector.NewChatMessageFromActiveLiveChatMessage(activeLiveChatMessage)
+				if !chatMessage.IsChat {
 					continue
 				}
-				groupIdx, ok := groupingCtx.group[activeLiveChatMessage.AuthorChannelId]
+				groupIdx, ok := groupingCtx.group[chatMessage.AuthorChannelId]
 				if ok {
 					// already grouping
 
 					// leave group
-					normDisplayMessage := norm.NFKC.String(activeLiveChatMessage.DisplayMessage)
+					normDisplayMessage := norm.NFKC.String(chatMessage.Message)
 					normDisplayMessage = p.stampRe.ReplaceAllString(normDisplayMessage, "")
 					messageIdx := strings.Index(normDisplayMessage, ";;;")
 					if !(messageIdx == -1)  {
 						if p.verbose {
-							log.Printf("leave group (id = %v, index = %v)", activeLiveChatMessage.AuthorChannelId, groupIdx)
+							log.Printf("leave group (id = %v, index = %v)", chatMessage.AuthorChannelId, groupIdx)
 						}
-						delete(groupingCtx.group, activeLiveChatMessage.AuthorChannelId)
+						delete(groupingCtx.group, chatMessage.AuthorChannelId)
 					}
 
                                         groupingCtx.subscriberCh <- &pb.PollGroupingActiveLiveChatResponse{
@@ -746,19 +901,10 @@ func (p *Processor) groupingWatcher(groupingCtx *groupingContext) {
 					}
 					continue
 				}
-				if groupingCtx.target == pb.Target_OWNER_MODERATOR {
-					if !(activeLiveChatMessage.AuthorIsChatModerator ||
-						activeLiveChatMessage.AuthorIsChatOwner) {
-						continue
-					}
-				} else if groupingCtx.target == pb.Target_OWNER_MODERATOR_SPONSOR {
-					if !(activeLiveChatMessage.AuthorIsChatModerator ||
-						activeLiveChatMessage.AuthorIsChatOwner ||
-						activeLiveChatMessage.AuthorIsChatSponsor) {
-						continue
-					}
+				if !p.isTargetAuthor(groupingCtx.target, chatMessage) {
+					continue
 				}
-				normDisplayMessage := norm.NFKC.String(activeLiveChatMessage.DisplayMessage)
+				normDisplayMessage := norm.NFKC.String(chatMessage.Message)
 				normDisplayMessage = p.stampRe.ReplaceAllString(normDisplayMessage, "")
 				matches := make([]*match, 0, len(groupingCtx.choices))
 				for choiceIdx := 0; choiceIdx < len(groupingCtx.choices); choiceIdx += 1 {
@@ -777,10 +923,11 @@ func (p *Processor) groupingWatcher(groupingCtx *groupingContext) {
 				sort.Slice(matches , func(i, j int) bool { return matches[i].messageIdx < matches[j].messageIdx })
 				m := matches[0]
 				groupIdx = m.choiceIdx
-				groupingCtx.group[activeLiveChatMessage.AuthorChannelId] = groupIdx
+				groupingCtx.group[chatMessage.AuthorChannelId] = groupIdx
 				if p.verbose {
-					log.Printf("join group (id = %v, index = %v)", activeLiveChatMessage.AuthorChannelId, groupIdx)
+					log.Printf("join group (id = %v, index = %v)", chatMessage.AuthorChannelId, groupIdx)
 				}
+				atomic.AddInt64(&groupingCtx.messageCount, 1)
 				groupingCtx.subscriberCh <- &pb.PollGroupingActiveLiveChatResponse{
 					Status: &pb.Status{
 						Code:    pb.Code_SUCCESS,
@@ -883,7 +1030,54 @@ func (p *Processor) discardGroupingActiveLiveChatUntilClosed(groupingCtx *groupi
         }
 }
 
-func NewProcessor(collector *collector.Collector, mecabrc string, font string, opts ...Option) *Processor {
+func (p *Processor) ListCollections(request *pb.ListCollectionsRequest) (*pb.ListCollectionsResponse, error) {
+	response, err := p.collector.ListCollections(request)
+	if err != nil {
+		return nil, fmt.Errorf("can not list collections: %w", err)
+	}
+	p.requestedVideoWordCloudMutex.Lock()
+	for videoId, startedAt := range p.requestedVideoWordCloud {
+		p.videoWordCloudMessagesMutex.Lock()
+		messageCount := len(p.videoWordCloudMessages[videoId])
+		p.videoWordCloudMessagesMutex.Unlock()
+		response.Collections = append(response.Collections, &pb.Collection{
+			Kind:         pb.CollectionKind_WORD_CLOUD,
+			Id:           videoId,
+			VideoId:      videoId,
+			State:        pb.CollectionState_RUNNING,
+			StartedAt:    startedAt.Format(time.RFC3339),
+			MessageCount: int64(messageCount),
+		})
+	}
+	p.requestedVideoWordCloudMutex.Unlock()
+	p.requestedVoteMutex.Lock()
+	for _, voteCtx := range p.requestedVote {
+		response.Collections = append(response.Collections, &pb.Collection{
+			Kind:         pb.CollectionKind_VOTE,
+			Id:           voteCtx.voteId,
+			VideoId:      voteCtx.videoId,
+			State:        pb.CollectionState_RUNNING,
+			StartedAt:    voteCtx.startedAt.Format(time.RFC3339),
+			MessageCount: int64(voteCtx.total),
+		})
+	}
+	p.requestedVoteMutex.Unlock()
+	p.requestedGroupingMutex.Lock()
+	for _, groupingCtx := range p.requestedGrouping {
+		response.Collections = append(response.Collections, &pb.Collection{
+			Kind:         pb.CollectionKind_GROUPING,
+			Id:           groupingCtx.groupingId,
+			VideoId:      groupingCtx.videoId,
+			State:        pb.CollectionState_RUNNING,
+			StartedAt:    groupingCtx.startedAt.Format(time.RFC3339),
+			MessageCount: atomic.LoadInt64(&groupingCtx.messageCount),
+		})
+	}
+	p.requestedGroupingMutex.Unlock()
+	return response, nil
+}
+
+func NewProcessor(c *collector.Collector, mecabrc string, font string, opts ...Option) *Processor {
 	baseOpts := defaultOptions()
 	for _, opt := range opts {
 		if opt == nil {
@@ -893,13 +1087,13 @@ func NewProcessor(collector *collector.Collector, mecabrc string, font string, o
 	}
 	return &Processor{
 		verbose:                      baseOpts.verbose,
-		collector:                    collector,
+		collector:                    c,
 		mecabrc:                      mecabrc,
 		font:                         font,
 		requestedVideoWordCloudMutex: new(sync.Mutex),
-		requestedVideoWordCloud:      make(map[string]bool),
+		requestedVideoWordCloud:      make(map[string]time.Time),
 		videoWordCloudMessagesMutex:  new(sync.Mutex),
-		videoWordCloudMessages:       make(map[string][]*pb.ActiveLiveChatMessage),
+		videoWordCloudMessages:       make(map[string][]*collector.ChatMessage),
 		requestedVoteMutex:           new(sync.Mutex),
 		requestedVote:                make(map[string]*voteContext),
 		requestedGroupingMutex:       new(sync.Mutex),
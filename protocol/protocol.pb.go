@@ -80,560 +80,743 @@ func (Code) EnumDescriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{0}
 }
 
-type Target int32
+type CollectionState int32
 
 const (
-	Target_ALL_USER                Target = 0
-	Target_OWNER_MODERATOR_SPONSOR Target = 1
-	Target_OWNER_MODERATOR         Target = 2
+	CollectionState_RUNNING  CollectionState = 0
+	CollectionState_RETRYING CollectionState = 1
+	CollectionState_FINISHED CollectionState = 2
+	CollectionState_FAILED   CollectionState = 3
+	CollectionState_STOPPED  CollectionState = 4
+	CollectionState_WAITING  CollectionState = 5
 )
 
-// Enum value maps for Target.
+// Enum value maps for CollectionState.
 var (
-	Target_name = map[int32]string{
-		0: "ALL_USER",
-		1: "OWNER_MODERATOR_SPONSOR",
-		2: "OWNER_MODERATOR",
-	}
-	Target_value = map[string]int32{
-		"ALL_USER":                0,
-		"OWNER_MODERATOR_SPONSOR": 1,
-		"OWNER_MODERATOR":         2,
+	CollectionState_name = map[int32]string{
+		0: "RUNNING",
+		1: "RETRYING",
+		2: "FINISHED",
+		3: "FAILED",
+		4: "STOPPED",
+		5: "WAITING",
+	}
+	CollectionState_value = map[string]int32{
+		"RUNNING":  0,
+		"RETRYING": 1,
+		"FINISHED": 2,
+		"FAILED":   3,
+		"STOPPED":  4,
+		"WAITING":  5,
 	}
 )
 
-func (x Target) Enum() *Target {
-	p := new(Target)
+func (x CollectionState) Enum() *CollectionState {
+	p := new(CollectionState)
 	*p = x
 	return p
 }
 
-func (x Target) String() string {
+func (x CollectionState) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Target) Descriptor() protoreflect.EnumDescriptor {
+func (CollectionState) Descriptor() protoreflect.EnumDescriptor {
 	return file_protocol_proto_enumTypes[1].Descriptor()
 }
 
-func (Target) Type() protoreflect.EnumType {
+func (CollectionState) Type() protoreflect.EnumType {
 	return &file_protocol_proto_enumTypes[1]
 }
 
-func (x Target) Number() protoreflect.EnumNumber {
+func (x CollectionState) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Target.Descriptor instead.
-func (Target) EnumDescriptor() ([]byte, []int) {
+// Deprecated: Use CollectionState.Descriptor instead.
+func (CollectionState) EnumDescriptor() ([]byte, []int) {
 	return file_protocol_proto_rawDescGZIP(), []int{1}
 }
 
-type Status struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type CollectionKind int32
 
-	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=Code" json:"code,omitempty"`
-	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-}
+const (
+	CollectionKind_ACTIVE_LIVE_CHAT  CollectionKind = 0
+	CollectionKind_ARCHIVE_LIVE_CHAT CollectionKind = 1
+	CollectionKind_WORD_CLOUD        CollectionKind = 2
+	CollectionKind_VOTE              CollectionKind = 3
+	CollectionKind_GROUPING          CollectionKind = 4
+)
 
-func (x *Status) Reset() {
-	*x = Status{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for CollectionKind.
+var (
+	CollectionKind_name = map[int32]string{
+		0: "ACTIVE_LIVE_CHAT",
+		1: "ARCHIVE_LIVE_CHAT",
+		2: "WORD_CLOUD",
+		3: "VOTE",
+		4: "GROUPING",
+	}
+	CollectionKind_value = map[string]int32{
+		"ACTIVE_LIVE_CHAT":  0,
+		"ARCHIVE_LIVE_CHAT": 1,
+		"WORD_CLOUD":        2,
+		"VOTE":              3,
+		"GROUPING":          4,
 	}
-}
+)
 
-func (x *Status) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x CollectionKind) Enum() *CollectionKind {
+	p := new(CollectionKind)
+	*p = x
+	return p
 }
 
-func (*Status) ProtoMessage() {}
+func (x CollectionKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (CollectionKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[2].Descriptor()
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{0}
+func (CollectionKind) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[2]
 }
 
-func (x *Status) GetCode() Code {
-	if x != nil {
-		return x.Code
-	}
-	return Code_SUCCESS
+func (x CollectionKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *Status) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
-	return ""
+// Deprecated: Use CollectionKind.Descriptor instead.
+func (CollectionKind) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{2}
 }
 
-type GetVideoRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ReplayState int32
 
-	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-}
+const (
+	ReplayState_PLAYING ReplayState = 0
+	ReplayState_PAUSED  ReplayState = 1
+	ReplayState_ENDED   ReplayState = 2
+)
 
-func (x *GetVideoRequest) Reset() {
-	*x = GetVideoRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ReplayState.
+var (
+	ReplayState_name = map[int32]string{
+		0: "PLAYING",
+		1: "PAUSED",
+		2: "ENDED",
+	}
+	ReplayState_value = map[string]int32{
+		"PLAYING": 0,
+		"PAUSED":  1,
+		"ENDED":   2,
 	}
+)
+
+func (x ReplayState) Enum() *ReplayState {
+	p := new(ReplayState)
+	*p = x
+	return p
 }
 
-func (x *GetVideoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ReplayState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetVideoRequest) ProtoMessage() {}
+func (ReplayState) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[3].Descriptor()
+}
 
-func (x *GetVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ReplayState) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[3]
 }
 
-// Deprecated: Use GetVideoRequest.ProtoReflect.Descriptor instead.
-func (*GetVideoRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{1}
+func (x ReplayState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetVideoRequest) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
+// Deprecated: Use ReplayState.Descriptor instead.
+func (ReplayState) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{3}
 }
 
-type GetVideoResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ReplayControl int32
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
-}
+const (
+	ReplayControl_PAUSE        ReplayControl = 0
+	ReplayControl_RESUME       ReplayControl = 1
+	ReplayControl_SEEK         ReplayControl = 2
+	ReplayControl_CHANGE_SPEED ReplayControl = 3
+)
 
-func (x *GetVideoResponse) Reset() {
-	*x = GetVideoResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ReplayControl.
+var (
+	ReplayControl_name = map[int32]string{
+		0: "PAUSE",
+		1: "RESUME",
+		2: "SEEK",
+		3: "CHANGE_SPEED",
+	}
+	ReplayControl_value = map[string]int32{
+		"PAUSE":        0,
+		"RESUME":       1,
+		"SEEK":         2,
+		"CHANGE_SPEED": 3,
 	}
-}
+)
 
-func (x *GetVideoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ReplayControl) Enum() *ReplayControl {
+	p := new(ReplayControl)
+	*p = x
+	return p
 }
 
-func (*GetVideoResponse) ProtoMessage() {}
+func (x ReplayControl) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-func (x *GetVideoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ReplayControl) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[4].Descriptor()
 }
 
-// Deprecated: Use GetVideoResponse.ProtoReflect.Descriptor instead.
-func (*GetVideoResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{2}
+func (ReplayControl) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[4]
 }
 
-func (x *GetVideoResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+func (x ReplayControl) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetVideoResponse) GetVideo() *Video {
-	if x != nil {
-		return x.Video
-	}
-	return nil
+// Deprecated: Use ReplayControl.Descriptor instead.
+func (ReplayControl) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4}
 }
 
-type StartCollectionActiveLiveChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ActiveLiveChatEventType int32
 
-	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-}
+const (
+	ActiveLiveChatEventType_TEXT_MESSAGE_EVENT              ActiveLiveChatEventType = 0
+	ActiveLiveChatEventType_SUPER_CHAT_EVENT                ActiveLiveChatEventType = 1
+	ActiveLiveChatEventType_SUPER_STICKER_EVENT             ActiveLiveChatEventType = 2
+	ActiveLiveChatEventType_FAN_FUNDING_EVENT               ActiveLiveChatEventType = 3
+	ActiveLiveChatEventType_NEW_SPONSOR_EVENT               ActiveLiveChatEventType = 4
+	ActiveLiveChatEventType_MEMBER_MILESTONE_CHAT_EVENT     ActiveLiveChatEventType = 5
+	ActiveLiveChatEventType_MEMBERSHIP_GIFTING_EVENT        ActiveLiveChatEventType = 6
+	ActiveLiveChatEventType_GIFT_MEMBERSHIP_RECEIVED_EVENT  ActiveLiveChatEventType = 7
+	ActiveLiveChatEventType_MESSAGE_DELETED_EVENT           ActiveLiveChatEventType = 8
+	ActiveLiveChatEventType_MESSAGE_RETRACTED_EVENT         ActiveLiveChatEventType = 9
+	ActiveLiveChatEventType_USER_BANNED_EVENT               ActiveLiveChatEventType = 10
+	ActiveLiveChatEventType_CHAT_ENDED_EVENT                ActiveLiveChatEventType = 11
+	ActiveLiveChatEventType_SPONSOR_ONLY_MODE_STARTED_EVENT ActiveLiveChatEventType = 12
+	ActiveLiveChatEventType_SPONSOR_ONLY_MODE_ENDED_EVENT   ActiveLiveChatEventType = 13
+)
 
-func (x *StartCollectionActiveLiveChatRequest) Reset() {
-	*x = StartCollectionActiveLiveChatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ActiveLiveChatEventType.
+var (
+	ActiveLiveChatEventType_name = map[int32]string{
+		0:  "TEXT_MESSAGE_EVENT",
+		1:  "SUPER_CHAT_EVENT",
+		2:  "SUPER_STICKER_EVENT",
+		3:  "FAN_FUNDING_EVENT",
+		4:  "NEW_SPONSOR_EVENT",
+		5:  "MEMBER_MILESTONE_CHAT_EVENT",
+		6:  "MEMBERSHIP_GIFTING_EVENT",
+		7:  "GIFT_MEMBERSHIP_RECEIVED_EVENT",
+		8:  "MESSAGE_DELETED_EVENT",
+		9:  "MESSAGE_RETRACTED_EVENT",
+		10: "USER_BANNED_EVENT",
+		11: "CHAT_ENDED_EVENT",
+		12: "SPONSOR_ONLY_MODE_STARTED_EVENT",
+		13: "SPONSOR_ONLY_MODE_ENDED_EVENT",
+	}
+	ActiveLiveChatEventType_value = map[string]int32{
+		"TEXT_MESSAGE_EVENT":              0,
+		"SUPER_CHAT_EVENT":                1,
+		"SUPER_STICKER_EVENT":             2,
+		"FAN_FUNDING_EVENT":               3,
+		"NEW_SPONSOR_EVENT":               4,
+		"MEMBER_MILESTONE_CHAT_EVENT":     5,
+		"MEMBERSHIP_GIFTING_EVENT":        6,
+		"GIFT_MEMBERSHIP_RECEIVED_EVENT":  7,
+		"MESSAGE_DELETED_EVENT":           8,
+		"MESSAGE_RETRACTED_EVENT":         9,
+		"USER_BANNED_EVENT":               10,
+		"CHAT_ENDED_EVENT":                11,
+		"SPONSOR_ONLY_MODE_STARTED_EVENT": 12,
+		"SPONSOR_ONLY_MODE_ENDED_EVENT":   13,
 	}
+)
+
+func (x ActiveLiveChatEventType) Enum() *ActiveLiveChatEventType {
+	p := new(ActiveLiveChatEventType)
+	*p = x
+	return p
 }
 
-func (x *StartCollectionActiveLiveChatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ActiveLiveChatEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*StartCollectionActiveLiveChatRequest) ProtoMessage() {}
+func (ActiveLiveChatEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[5].Descriptor()
+}
 
-func (x *StartCollectionActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ActiveLiveChatEventType) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[5]
 }
 
-// Deprecated: Use StartCollectionActiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*StartCollectionActiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{3}
+func (x ActiveLiveChatEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *StartCollectionActiveLiveChatRequest) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
+// Deprecated: Use ActiveLiveChatEventType.Descriptor instead.
+func (ActiveLiveChatEventType) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{5}
 }
 
-type StartCollectionActiveLiveChatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type ArchiveLiveChatMessageType int32
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
-}
+const (
+	ArchiveLiveChatMessageType_TEXT_MESSAGE                 ArchiveLiveChatMessageType = 0
+	ArchiveLiveChatMessageType_PAID_MESSAGE                 ArchiveLiveChatMessageType = 1
+	ArchiveLiveChatMessageType_PAID_STICKER                 ArchiveLiveChatMessageType = 2
+	ArchiveLiveChatMessageType_MEMBERSHIP_ITEM              ArchiveLiveChatMessageType = 3
+	ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_PURCHASE   ArchiveLiveChatMessageType = 4
+	ArchiveLiveChatMessageType_SPONSORSHIPS_GIFT_REDEMPTION ArchiveLiveChatMessageType = 5
+)
 
-func (x *StartCollectionActiveLiveChatResponse) Reset() {
-	*x = StartCollectionActiveLiveChatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Enum value maps for ArchiveLiveChatMessageType.
+var (
+	ArchiveLiveChatMessageType_name = map[int32]string{
+		0: "TEXT_MESSAGE",
+		1: "PAID_MESSAGE",
+		2: "PAID_STICKER",
+		3: "MEMBERSHIP_ITEM",
+		4: "SPONSORSHIPS_GIFT_PURCHASE",
+		5: "SPONSORSHIPS_GIFT_REDEMPTION",
+	}
+	ArchiveLiveChatMessageType_value = map[string]int32{
+		"TEXT_MESSAGE":                 0,
+		"PAID_MESSAGE":                 1,
+		"PAID_STICKER":                 2,
+		"MEMBERSHIP_ITEM":              3,
+		"SPONSORSHIPS_GIFT_PURCHASE":   4,
+		"SPONSORSHIPS_GIFT_REDEMPTION": 5,
 	}
+)
+
+func (x ArchiveLiveChatMessageType) Enum() *ArchiveLiveChatMessageType {
+	p := new(ArchiveLiveChatMessageType)
+	*p = x
+	return p
 }
 
-func (x *StartCollectionActiveLiveChatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ArchiveLiveChatMessageType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*StartCollectionActiveLiveChatResponse) ProtoMessage() {}
+func (ArchiveLiveChatMessageType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[6].Descriptor()
+}
 
-func (x *StartCollectionActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (ArchiveLiveChatMessageType) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[6]
 }
 
-// Deprecated: Use StartCollectionActiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*StartCollectionActiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{4}
+func (x ArchiveLiveChatMessageType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *StartCollectionActiveLiveChatResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+// Deprecated: Use ArchiveLiveChatMessageType.Descriptor instead.
+func (ArchiveLiveChatMessageType) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StartCollectionActiveLiveChatResponse) GetVideo() *Video {
-	if x != nil {
-		return x.Video
+type ActiveLiveChatBackend int32
+
+const (
+	ActiveLiveChatBackend_DEFAULT_BACKEND ActiveLiveChatBackend = 0
+	// YouTube Data APIのliveChatMessages.list (クォータを消費する)
+	ActiveLiveChatBackend_DATA_API_BACKEND ActiveLiveChatBackend = 1
+	// innertubeのget_live_chat (クォータを消費しない)
+	ActiveLiveChatBackend_INNERTUBE_BACKEND ActiveLiveChatBackend = 2
+)
+
+// Enum value maps for ActiveLiveChatBackend.
+var (
+	ActiveLiveChatBackend_name = map[int32]string{
+		0: "DEFAULT_BACKEND",
+		1: "DATA_API_BACKEND",
+		2: "INNERTUBE_BACKEND",
 	}
-	return nil
+	ActiveLiveChatBackend_value = map[string]int32{
+		"DEFAULT_BACKEND":   0,
+		"DATA_API_BACKEND":  1,
+		"INNERTUBE_BACKEND": 2,
+	}
+)
+
+func (x ActiveLiveChatBackend) Enum() *ActiveLiveChatBackend {
+	p := new(ActiveLiveChatBackend)
+	*p = x
+	return p
 }
 
-type PollActiveLiveChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x ActiveLiveChatBackend) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
 
-	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+func (ActiveLiveChatBackend) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[7].Descriptor()
 }
 
-func (x *PollActiveLiveChatRequest) Reset() {
-	*x = PollActiveLiveChatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (ActiveLiveChatBackend) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[7]
 }
 
-func (x *PollActiveLiveChatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x ActiveLiveChatBackend) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (*PollActiveLiveChatRequest) ProtoMessage() {}
+// Deprecated: Use ActiveLiveChatBackend.Descriptor instead.
+func (ActiveLiveChatBackend) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{7}
+}
 
-func (x *PollActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+type ChatMessageSource int32
+
+const (
+	ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE  ChatMessageSource = 0
+	ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE ChatMessageSource = 1
+)
+
+// Enum value maps for ChatMessageSource.
+var (
+	ChatMessageSource_name = map[int32]string{
+		0: "ACTIVE_LIVE_CHAT_SOURCE",
+		1: "ARCHIVE_LIVE_CHAT_SOURCE",
 	}
-	return mi.MessageOf(x)
-}
+	ChatMessageSource_value = map[string]int32{
+		"ACTIVE_LIVE_CHAT_SOURCE":  0,
+		"ARCHIVE_LIVE_CHAT_SOURCE": 1,
+	}
+)
 
-// Deprecated: Use PollActiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*PollActiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{5}
+func (x ChatMessageSource) Enum() *ChatMessageSource {
+	p := new(ChatMessageSource)
+	*p = x
+	return p
 }
 
-func (x *PollActiveLiveChatRequest) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
+func (x ChatMessageSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type PollActiveLiveChatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (ChatMessageSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[8].Descriptor()
+}
 
-	Status                 *Status                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ActiveLiveChatMessages []*ActiveLiveChatMessage `protobuf:"bytes,2,rep,name=activeLiveChatMessages,proto3" json:"activeLiveChatMessages,omitempty"`
+func (ChatMessageSource) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[8]
 }
 
-func (x *PollActiveLiveChatResponse) Reset() {
-	*x = PollActiveLiveChatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x ChatMessageSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *PollActiveLiveChatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use ChatMessageSource.Descriptor instead.
+func (ChatMessageSource) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{8}
 }
 
-func (*PollActiveLiveChatResponse) ProtoMessage() {}
+type ErrorClass int32
 
-func (x *PollActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ErrorClass_NO_ERROR       ErrorClass = 0
+	ErrorClass_TRANSIENT      ErrorClass = 1
+	ErrorClass_RATE_LIMITED   ErrorClass = 2
+	ErrorClass_QUOTA_EXCEEDED ErrorClass = 3
+	ErrorClass_CHAT_ENDED     ErrorClass = 4
+	ErrorClass_FORBIDDEN      ErrorClass = 5
+	ErrorClass_UNKNOWN_ERROR  ErrorClass = 6
+)
+
+// Enum value maps for ErrorClass.
+var (
+	ErrorClass_name = map[int32]string{
+		0: "NO_ERROR",
+		1: "TRANSIENT",
+		2: "RATE_LIMITED",
+		3: "QUOTA_EXCEEDED",
+		4: "CHAT_ENDED",
+		5: "FORBIDDEN",
+		6: "UNKNOWN_ERROR",
+	}
+	ErrorClass_value = map[string]int32{
+		"NO_ERROR":       0,
+		"TRANSIENT":      1,
+		"RATE_LIMITED":   2,
+		"QUOTA_EXCEEDED": 3,
+		"CHAT_ENDED":     4,
+		"FORBIDDEN":      5,
+		"UNKNOWN_ERROR":  6,
 	}
-	return mi.MessageOf(x)
+)
+
+func (x ErrorClass) Enum() *ErrorClass {
+	p := new(ErrorClass)
+	*p = x
+	return p
 }
 
-// Deprecated: Use PollActiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*PollActiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{6}
+func (x ErrorClass) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *PollActiveLiveChatResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+func (ErrorClass) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[9].Descriptor()
 }
 
-func (x *PollActiveLiveChatResponse) GetActiveLiveChatMessages() []*ActiveLiveChatMessage {
-	if x != nil {
-		return x.ActiveLiveChatMessages
-	}
-	return nil
+func (ErrorClass) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[9]
 }
 
-type GetCachedActiveLiveChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x ErrorClass) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Count   int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+// Deprecated: Use ErrorClass.Descriptor instead.
+func (ErrorClass) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *GetCachedActiveLiveChatRequest) Reset() {
-	*x = GetCachedActiveLiveChatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+type Target int32
+
+const (
+	Target_ALL_USER                Target = 0
+	Target_OWNER_MODERATOR_SPONSOR Target = 1
+	Target_OWNER_MODERATOR         Target = 2
+)
+
+// Enum value maps for Target.
+var (
+	Target_name = map[int32]string{
+		0: "ALL_USER",
+		1: "OWNER_MODERATOR_SPONSOR",
+		2: "OWNER_MODERATOR",
+	}
+	Target_value = map[string]int32{
+		"ALL_USER":                0,
+		"OWNER_MODERATOR_SPONSOR": 1,
+		"OWNER_MODERATOR":         2,
 	}
+)
+
+func (x Target) Enum() *Target {
+	p := new(Target)
+	*p = x
+	return p
 }
 
-func (x *GetCachedActiveLiveChatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x Target) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (*GetCachedActiveLiveChatRequest) ProtoMessage() {}
+func (Target) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[10].Descriptor()
+}
 
-func (x *GetCachedActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (Target) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[10]
 }
 
-// Deprecated: Use GetCachedActiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*GetCachedActiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{7}
+func (x Target) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetCachedActiveLiveChatRequest) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
+// Deprecated: Use Target.Descriptor instead.
+func (Target) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *GetCachedActiveLiveChatRequest) GetOffset() int64 {
-	if x != nil {
-		return x.Offset
+type WatchedVideoState int32
+
+const (
+	WatchedVideoState_UPCOMING_VIDEO WatchedVideoState = 0
+	WatchedVideoState_LIVE_VIDEO     WatchedVideoState = 1
+	WatchedVideoState_ENDED_VIDEO    WatchedVideoState = 2
+	// 収集が停止された配信中の動画、配信終了まで収集は再開しない
+	WatchedVideoState_STOPPED_VIDEO WatchedVideoState = 3
+)
+
+// Enum value maps for WatchedVideoState.
+var (
+	WatchedVideoState_name = map[int32]string{
+		0: "UPCOMING_VIDEO",
+		1: "LIVE_VIDEO",
+		2: "ENDED_VIDEO",
+		3: "STOPPED_VIDEO",
+	}
+	WatchedVideoState_value = map[string]int32{
+		"UPCOMING_VIDEO": 0,
+		"LIVE_VIDEO":     1,
+		"ENDED_VIDEO":    2,
+		"STOPPED_VIDEO":  3,
 	}
-	return 0
+)
+
+func (x WatchedVideoState) Enum() *WatchedVideoState {
+	p := new(WatchedVideoState)
+	*p = x
+	return p
 }
 
-func (x *GetCachedActiveLiveChatRequest) GetCount() int64 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
+func (x WatchedVideoState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-type GetCachedActiveLiveChatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (WatchedVideoState) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[11].Descriptor()
+}
 
-	Status                 *Status                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ActiveLiveChatMessages []*ActiveLiveChatMessage `protobuf:"bytes,3,rep,name=activeLiveChatMessages,proto3" json:"activeLiveChatMessages,omitempty"`
+func (WatchedVideoState) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[11]
 }
 
-func (x *GetCachedActiveLiveChatResponse) Reset() {
-	*x = GetCachedActiveLiveChatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x WatchedVideoState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
 }
 
-func (x *GetCachedActiveLiveChatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use WatchedVideoState.Descriptor instead.
+func (WatchedVideoState) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11}
 }
 
-func (*GetCachedActiveLiveChatResponse) ProtoMessage() {}
+type ExportFormat int32
 
-func (x *GetCachedActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+const (
+	ExportFormat_JSONL_EXPORT_FORMAT   ExportFormat = 0
+	ExportFormat_CSV_EXPORT_FORMAT     ExportFormat = 1
+	ExportFormat_PARQUET_EXPORT_FORMAT ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "JSONL_EXPORT_FORMAT",
+		1: "CSV_EXPORT_FORMAT",
+		2: "PARQUET_EXPORT_FORMAT",
 	}
-	return mi.MessageOf(x)
+	ExportFormat_value = map[string]int32{
+		"JSONL_EXPORT_FORMAT":   0,
+		"CSV_EXPORT_FORMAT":     1,
+		"PARQUET_EXPORT_FORMAT": 2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
 }
 
-// Deprecated: Use GetCachedActiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*GetCachedActiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{8}
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (x *GetCachedActiveLiveChatResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[12].Descriptor()
 }
 
-func (x *GetCachedActiveLiveChatResponse) GetActiveLiveChatMessages() []*ActiveLiveChatMessage {
-	if x != nil {
-		return x.ActiveLiveChatMessages
-	}
-	return nil
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[12]
 }
 
-type StartCollectionArchiveLiveChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
 
-	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	Replace bool   `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StartCollectionArchiveLiveChatRequest) Reset() {
-	*x = StartCollectionArchiveLiveChatRequest{}
+type ImportFormat int32
+
+const (
+	// yt-dlpの.live_chat.json (1行に1つのreplayChatItemAction)
+	ImportFormat_YT_DLP_IMPORT_FORMAT ImportFormat = 0
+	// chat-downloaderのJSON (配列か1行に1つのメッセージ)
+	ImportFormat_CHAT_DOWNLOADER_IMPORT_FORMAT ImportFormat = 1
+)
+
+// Enum value maps for ImportFormat.
+var (
+	ImportFormat_name = map[int32]string{
+		0: "YT_DLP_IMPORT_FORMAT",
+		1: "CHAT_DOWNLOADER_IMPORT_FORMAT",
+	}
+	ImportFormat_value = map[string]int32{
+		"YT_DLP_IMPORT_FORMAT":          0,
+		"CHAT_DOWNLOADER_IMPORT_FORMAT": 1,
+	}
+)
+
+func (x ImportFormat) Enum() *ImportFormat {
+	p := new(ImportFormat)
+	*p = x
+	return p
+}
+
+func (x ImportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ImportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_protocol_proto_enumTypes[13].Descriptor()
+}
+
+func (ImportFormat) Type() protoreflect.EnumType {
+	return &file_protocol_proto_enumTypes[13]
+}
+
+func (x ImportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ImportFormat.Descriptor instead.
+func (ImportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{13}
+}
+
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    Code   `protobuf:"varint,1,opt,name=code,proto3,enum=Code" json:"code,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[9]
+		mi := &file_protocol_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartCollectionArchiveLiveChatRequest) String() string {
+func (x *Status) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartCollectionArchiveLiveChatRequest) ProtoMessage() {}
+func (*Status) ProtoMessage() {}
 
-func (x *StartCollectionArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[9]
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -644,26 +827,73 @@ func (x *StartCollectionArchiveLiveChatRequest) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartCollectionArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*StartCollectionArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *StartCollectionArchiveLiveChatRequest) GetVideoId() string {
+func (x *Status) GetCode() Code {
 	if x != nil {
-		return x.VideoId
+		return x.Code
+	}
+	return Code_SUCCESS
+}
+
+func (x *Status) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-func (x *StartCollectionArchiveLiveChatRequest) GetReplace() bool {
+type GetVideoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+}
+
+func (x *GetVideoRequest) Reset() {
+	*x = GetVideoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVideoRequest) ProtoMessage() {}
+
+func (x *GetVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVideoRequest.ProtoReflect.Descriptor instead.
+func (*GetVideoRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetVideoRequest) GetVideoId() string {
 	if x != nil {
-		return x.Replace
+		return x.VideoId
 	}
-	return false
+	return ""
 }
 
-type StartCollectionArchiveLiveChatResponse struct {
+type GetVideoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -672,23 +902,23 @@ type StartCollectionArchiveLiveChatResponse struct {
 	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
 }
 
-func (x *StartCollectionArchiveLiveChatResponse) Reset() {
-	*x = StartCollectionArchiveLiveChatResponse{}
+func (x *GetVideoResponse) Reset() {
+	*x = GetVideoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[10]
+		mi := &file_protocol_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartCollectionArchiveLiveChatResponse) String() string {
+func (x *GetVideoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartCollectionArchiveLiveChatResponse) ProtoMessage() {}
+func (*GetVideoResponse) ProtoMessage() {}
 
-func (x *StartCollectionArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[10]
+func (x *GetVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -699,52 +929,59 @@ func (x *StartCollectionArchiveLiveChatResponse) ProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartCollectionArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*StartCollectionArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use GetVideoResponse.ProtoReflect.Descriptor instead.
+func (*GetVideoResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *StartCollectionArchiveLiveChatResponse) GetStatus() *Status {
+func (x *GetVideoResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *StartCollectionArchiveLiveChatResponse) GetVideo() *Video {
+func (x *GetVideoResponse) GetVideo() *Video {
 	if x != nil {
 		return x.Video
 	}
 	return nil
 }
 
-type GetArchiveLiveChatRequest struct {
+type StartCollectionActiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	Offset  int64  `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
-	Count   int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// 最後の購読者がいなくなってから自動停止するまでの秒数
+	// 購読者がいないまま開始された収集も開始からこの秒数で自動停止する
+	// 0の場合はサーバーの設定値を使う
+	AutoStopGracePeriod int32 `protobuf:"varint,2,opt,name=autoStopGracePeriod,proto3" json:"autoStopGracePeriod,omitempty"`
+	// ライブチャットの取得方法
+	// DEFAULT_BACKENDの場合はサーバーの設定値を使う
+	Backend ActiveLiveChatBackend `protobuf:"varint,3,opt,name=backend,proto3,enum=ActiveLiveChatBackend" json:"backend,omitempty"`
+	// trueの場合はライブチャットが始まっていない配信予定の動画でも待機して開始後に収集する
+	WaitForLive bool `protobuf:"varint,4,opt,name=waitForLive,proto3" json:"waitForLive,omitempty"`
 }
 
-func (x *GetArchiveLiveChatRequest) Reset() {
-	*x = GetArchiveLiveChatRequest{}
+func (x *StartCollectionActiveLiveChatRequest) Reset() {
+	*x = StartCollectionActiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[11]
+		mi := &file_protocol_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArchiveLiveChatRequest) String() string {
+func (x *StartCollectionActiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArchiveLiveChatRequest) ProtoMessage() {}
+func (*StartCollectionActiveLiveChatRequest) ProtoMessage() {}
 
-func (x *GetArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[11]
+func (x *StartCollectionActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,58 +992,65 @@ func (x *GetArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*GetArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use StartCollectionActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*StartCollectionActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetArchiveLiveChatRequest) GetVideoId() string {
+func (x *StartCollectionActiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
 		return x.VideoId
 	}
 	return ""
 }
 
-func (x *GetArchiveLiveChatRequest) GetOffset() int64 {
+func (x *StartCollectionActiveLiveChatRequest) GetAutoStopGracePeriod() int32 {
 	if x != nil {
-		return x.Offset
+		return x.AutoStopGracePeriod
 	}
 	return 0
 }
 
-func (x *GetArchiveLiveChatRequest) GetCount() int64 {
+func (x *StartCollectionActiveLiveChatRequest) GetBackend() ActiveLiveChatBackend {
 	if x != nil {
-		return x.Count
+		return x.Backend
 	}
-	return 0
+	return ActiveLiveChatBackend_DEFAULT_BACKEND
 }
 
-type GetArchiveLiveChatResponse struct {
+func (x *StartCollectionActiveLiveChatRequest) GetWaitForLive() bool {
+	if x != nil {
+		return x.WaitForLive
+	}
+	return false
+}
+
+type StartCollectionActiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status                  *Status                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	ArchiveLiveChatMessages []*ArchiveLiveChatMessage `protobuf:"bytes,3,rep,name=ArchiveLiveChatMessages,proto3" json:"ArchiveLiveChatMessages,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
 }
 
-func (x *GetArchiveLiveChatResponse) Reset() {
-	*x = GetArchiveLiveChatResponse{}
+func (x *StartCollectionActiveLiveChatResponse) Reset() {
+	*x = StartCollectionActiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[12]
+		mi := &file_protocol_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetArchiveLiveChatResponse) String() string {
+func (x *StartCollectionActiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetArchiveLiveChatResponse) ProtoMessage() {}
+func (*StartCollectionActiveLiveChatResponse) ProtoMessage() {}
 
-func (x *GetArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[12]
+func (x *StartCollectionActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -817,64 +1061,50 @@ func (x *GetArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*GetArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use StartCollectionActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*StartCollectionActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetArchiveLiveChatResponse) GetStatus() *Status {
+func (x *StartCollectionActiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *GetArchiveLiveChatResponse) GetArchiveLiveChatMessages() []*ArchiveLiveChatMessage {
+func (x *StartCollectionActiveLiveChatResponse) GetVideo() *Video {
 	if x != nil {
-		return x.ArchiveLiveChatMessages
+		return x.Video
 	}
 	return nil
 }
 
-type Video struct {
+type PollActiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoId            string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	ChannelId          string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
-	CategoryId         string `protobuf:"bytes,3,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
-	Title              string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
-	Description        string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	PublishedAt        string `protobuf:"bytes,6,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
-	Duration           string `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
-	ActiveLiveChatId   string `protobuf:"bytes,8,opt,name=activeLiveChatId,proto3" json:"activeLiveChatId,omitempty"`
-	ActualStartTime    string `protobuf:"bytes,9,opt,name=actualStartTime,proto3" json:"actualStartTime,omitempty"`
-	ActualEndTime      string `protobuf:"bytes,10,opt,name=actualEndTime,proto3" json:"actualEndTime,omitempty"`
-	ScheduledStartTime string `protobuf:"bytes,11,opt,name=scheduledStartTime,proto3" json:"scheduledStartTime,omitempty"`
-	ScheduledEndTime   string `protobuf:"bytes,12,opt,name=scheduledEndTime,proto3" json:"scheduledEndTime,omitempty"`
-	PrivacyStatus      string `protobuf:"bytes,13,opt,name=privacyStatus,proto3" json:"privacyStatus,omitempty"`
-	UploadStatus       string `protobuf:"bytes,14,opt,name=uploadStatus,proto3" json:"uploadStatus,omitempty"`
-	Embeddable         bool   `protobuf:"varint,15,opt,name=embeddable,proto3" json:"embeddable,omitempty"`
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
 }
 
-func (x *Video) Reset() {
-	*x = Video{}
+func (x *PollActiveLiveChatRequest) Reset() {
+	*x = PollActiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[13]
+		mi := &file_protocol_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Video) String() string {
+func (x *PollActiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Video) ProtoMessage() {}
+func (*PollActiveLiveChatRequest) ProtoMessage() {}
 
-func (x *Video) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[13]
+func (x *PollActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -885,161 +1115,133 @@ func (x *Video) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Video.ProtoReflect.Descriptor instead.
-func (*Video) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use PollActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*PollActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Video) GetVideoId() string {
+func (x *PollActiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
 		return x.VideoId
 	}
 	return ""
 }
 
-func (x *Video) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
-
-func (x *Video) GetCategoryId() string {
-	if x != nil {
-		return x.CategoryId
-	}
-	return ""
-}
+type ActiveLiveChatCollectionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Video) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+	State       CollectionState `protobuf:"varint,1,opt,name=state,proto3,enum=CollectionState" json:"state,omitempty"`
+	ErrorClass  ErrorClass      `protobuf:"varint,2,opt,name=errorClass,proto3,enum=ErrorClass" json:"errorClass,omitempty"`
+	RetryCount  int32           `protobuf:"varint,3,opt,name=retryCount,proto3" json:"retryCount,omitempty"`
+	NextRetryAt string          `protobuf:"bytes,4,opt,name=nextRetryAt,proto3" json:"nextRetryAt,omitempty"`
+	LastError   string          `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	PageToken   string          `protobuf:"bytes,6,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
 }
 
-func (x *Video) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *ActiveLiveChatCollectionStatus) Reset() {
+	*x = ActiveLiveChatCollectionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Video) GetPublishedAt() string {
-	if x != nil {
-		return x.PublishedAt
-	}
-	return ""
+func (x *ActiveLiveChatCollectionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Video) GetDuration() string {
-	if x != nil {
-		return x.Duration
-	}
-	return ""
-}
+func (*ActiveLiveChatCollectionStatus) ProtoMessage() {}
 
-func (x *Video) GetActiveLiveChatId() string {
-	if x != nil {
-		return x.ActiveLiveChatId
+func (x *ActiveLiveChatCollectionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Video) GetActualStartTime() string {
-	if x != nil {
-		return x.ActualStartTime
-	}
-	return ""
+// Deprecated: Use ActiveLiveChatCollectionStatus.ProtoReflect.Descriptor instead.
+func (*ActiveLiveChatCollectionStatus) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Video) GetActualEndTime() string {
+func (x *ActiveLiveChatCollectionStatus) GetState() CollectionState {
 	if x != nil {
-		return x.ActualEndTime
+		return x.State
 	}
-	return ""
+	return CollectionState_RUNNING
 }
 
-func (x *Video) GetScheduledStartTime() string {
+func (x *ActiveLiveChatCollectionStatus) GetErrorClass() ErrorClass {
 	if x != nil {
-		return x.ScheduledStartTime
+		return x.ErrorClass
 	}
-	return ""
+	return ErrorClass_NO_ERROR
 }
 
-func (x *Video) GetScheduledEndTime() string {
+func (x *ActiveLiveChatCollectionStatus) GetRetryCount() int32 {
 	if x != nil {
-		return x.ScheduledEndTime
+		return x.RetryCount
 	}
-	return ""
+	return 0
 }
 
-func (x *Video) GetPrivacyStatus() string {
+func (x *ActiveLiveChatCollectionStatus) GetNextRetryAt() string {
 	if x != nil {
-		return x.PrivacyStatus
+		return x.NextRetryAt
 	}
 	return ""
 }
 
-func (x *Video) GetUploadStatus() string {
+func (x *ActiveLiveChatCollectionStatus) GetLastError() string {
 	if x != nil {
-		return x.UploadStatus
+		return x.LastError
 	}
 	return ""
 }
 
-func (x *Video) GetEmbeddable() bool {
+func (x *ActiveLiveChatCollectionStatus) GetPageToken() string {
 	if x != nil {
-		return x.Embeddable
+		return x.PageToken
 	}
-	return false
+	return ""
 }
 
-type ActiveLiveChatMessage struct {
+type PollActiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageId             string `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
-	ChannelId             string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
-	VideoId               string `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	ApiEtag               string `protobuf:"bytes,4,opt,name=apiEtag,proto3" json:"apiEtag,omitempty"`
-	AuthorChannelId       string `protobuf:"bytes,5,opt,name=authorChannelId,proto3" json:"authorChannelId,omitempty"`
-	AuthorChannelUrl      string `protobuf:"bytes,6,opt,name=authorChannelUrl,proto3" json:"authorChannelUrl,omitempty"`
-	AuthorDisplayName     string `protobuf:"bytes,7,opt,name=authorDisplayName,proto3" json:"authorDisplayName,omitempty"`
-	AuthorIsChatModerator bool   `protobuf:"varint,8,opt,name=authorIsChatModerator,proto3" json:"authorIsChatModerator,omitempty"`
-	AuthorIsChatOwner     bool   `protobuf:"varint,9,opt,name=authorIsChatOwner,proto3" json:"authorIsChatOwner,omitempty"`
-	AuthorIsChatSponsor   bool   `protobuf:"varint,10,opt,name=authorIsChatSponsor,proto3" json:"authorIsChatSponsor,omitempty"`
-	AuthorIsVerified      bool   `protobuf:"varint,11,opt,name=authorIsVerified,proto3" json:"authorIsVerified,omitempty"`
-	LiveChatId            string `protobuf:"bytes,12,opt,name=liveChatId,proto3" json:"liveChatId,omitempty"`
-	DisplayMessage        string `protobuf:"bytes,13,opt,name=displayMessage,proto3" json:"displayMessage,omitempty"`
-	PublishedAt           string `protobuf:"bytes,14,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
-	IsSuperChat           bool   `protobuf:"varint,15,opt,name=isSuperChat,proto3" json:"isSuperChat,omitempty"`
-	IsSuperSticker        bool   `protobuf:"varint,16,opt,name=isSuperSticker,proto3" json:"isSuperSticker,omitempty"`
-	IsFanFundingEvent     bool   `protobuf:"varint,17,opt,name=isFanFundingEvent,proto3" json:"isFanFundingEvent,omitempty"`
-	AmountMicros          string `protobuf:"bytes,18,opt,name=amountMicros,proto3" json:"amountMicros,omitempty"`
-	AmountDisplayString   string `protobuf:"bytes,19,opt,name=amountDisplayString,proto3" json:"amountDisplayString,omitempty"`
-	Currency              string `protobuf:"bytes,20,opt,name=currency,proto3" json:"currency,omitempty"`
-	PageToken             string `protobuf:"bytes,21,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	Status                 *Status                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveLiveChatMessages []*ActiveLiveChatMessage `protobuf:"bytes,2,rep,name=activeLiveChatMessages,proto3" json:"activeLiveChatMessages,omitempty"`
+	// 収集状態が変化した場合にのみ設定される
+	CollectionStatus *ActiveLiveChatCollectionStatus `protobuf:"bytes,3,opt,name=collectionStatus,proto3" json:"collectionStatus,omitempty"`
 }
 
-func (x *ActiveLiveChatMessage) Reset() {
-	*x = ActiveLiveChatMessage{}
+func (x *PollActiveLiveChatResponse) Reset() {
+	*x = PollActiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[14]
+		mi := &file_protocol_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ActiveLiveChatMessage) String() string {
+func (x *PollActiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActiveLiveChatMessage) ProtoMessage() {}
+func (*PollActiveLiveChatResponse) ProtoMessage() {}
 
-func (x *ActiveLiveChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[14]
+func (x *PollActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1050,195 +1252,161 @@ func (x *ActiveLiveChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActiveLiveChatMessage.ProtoReflect.Descriptor instead.
-func (*ActiveLiveChatMessage) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *ActiveLiveChatMessage) GetMessageId() string {
-	if x != nil {
-		return x.MessageId
-	}
-	return ""
-}
-
-func (x *ActiveLiveChatMessage) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
-}
-
-func (x *ActiveLiveChatMessage) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
+// Deprecated: Use PollActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*PollActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ActiveLiveChatMessage) GetApiEtag() string {
+func (x *PollActiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
-		return x.ApiEtag
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *ActiveLiveChatMessage) GetAuthorChannelId() string {
+func (x *PollActiveLiveChatResponse) GetActiveLiveChatMessages() []*ActiveLiveChatMessage {
 	if x != nil {
-		return x.AuthorChannelId
+		return x.ActiveLiveChatMessages
 	}
-	return ""
+	return nil
 }
 
-func (x *ActiveLiveChatMessage) GetAuthorChannelUrl() string {
+func (x *PollActiveLiveChatResponse) GetCollectionStatus() *ActiveLiveChatCollectionStatus {
 	if x != nil {
-		return x.AuthorChannelUrl
+		return x.CollectionStatus
 	}
-	return ""
+	return nil
 }
 
-func (x *ActiveLiveChatMessage) GetAuthorDisplayName() string {
-	if x != nil {
-		return x.AuthorDisplayName
-	}
-	return ""
-}
+type GetCachedActiveLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *ActiveLiveChatMessage) GetAuthorIsChatModerator() bool {
-	if x != nil {
-		return x.AuthorIsChatModerator
-	}
-	return false
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// cursorが空の場合だけ使われる
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count  int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// 前のレスポンスのnextCursor (空の場合は先頭から返す)
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 投稿時刻の範囲 (unix時間の秒数, 0の場合は制限しない)
+	Since int64 `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`
+	// trueの場合はtotalを数える (全件を数えるためページの取得より遅い)
+	WithTotal bool `protobuf:"varint,7,opt,name=withTotal,proto3" json:"withTotal,omitempty"`
 }
 
-func (x *ActiveLiveChatMessage) GetAuthorIsChatOwner() bool {
-	if x != nil {
-		return x.AuthorIsChatOwner
+func (x *GetCachedActiveLiveChatRequest) Reset() {
+	*x = GetCachedActiveLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return false
 }
 
-func (x *ActiveLiveChatMessage) GetAuthorIsChatSponsor() bool {
-	if x != nil {
-		return x.AuthorIsChatSponsor
-	}
-	return false
+func (x *GetCachedActiveLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ActiveLiveChatMessage) GetAuthorIsVerified() bool {
-	if x != nil {
-		return x.AuthorIsVerified
-	}
-	return false
-}
+func (*GetCachedActiveLiveChatRequest) ProtoMessage() {}
 
-func (x *ActiveLiveChatMessage) GetLiveChatId() string {
-	if x != nil {
-		return x.LiveChatId
+func (x *GetCachedActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ActiveLiveChatMessage) GetDisplayMessage() string {
-	if x != nil {
-		return x.DisplayMessage
-	}
-	return ""
+// Deprecated: Use GetCachedActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*GetCachedActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ActiveLiveChatMessage) GetPublishedAt() string {
+func (x *GetCachedActiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
-		return x.PublishedAt
+		return x.VideoId
 	}
 	return ""
 }
 
-func (x *ActiveLiveChatMessage) GetIsSuperChat() bool {
-	if x != nil {
-		return x.IsSuperChat
-	}
-	return false
-}
-
-func (x *ActiveLiveChatMessage) GetIsSuperSticker() bool {
+func (x *GetCachedActiveLiveChatRequest) GetOffset() int64 {
 	if x != nil {
-		return x.IsSuperSticker
+		return x.Offset
 	}
-	return false
+	return 0
 }
 
-func (x *ActiveLiveChatMessage) GetIsFanFundingEvent() bool {
+func (x *GetCachedActiveLiveChatRequest) GetCount() int64 {
 	if x != nil {
-		return x.IsFanFundingEvent
+		return x.Count
 	}
-	return false
+	return 0
 }
 
-func (x *ActiveLiveChatMessage) GetAmountMicros() string {
+func (x *GetCachedActiveLiveChatRequest) GetCursor() string {
 	if x != nil {
-		return x.AmountMicros
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *ActiveLiveChatMessage) GetAmountDisplayString() string {
+func (x *GetCachedActiveLiveChatRequest) GetSince() int64 {
 	if x != nil {
-		return x.AmountDisplayString
+		return x.Since
 	}
-	return ""
+	return 0
 }
 
-func (x *ActiveLiveChatMessage) GetCurrency() string {
+func (x *GetCachedActiveLiveChatRequest) GetUntil() int64 {
 	if x != nil {
-		return x.Currency
+		return x.Until
 	}
-	return ""
+	return 0
 }
 
-func (x *ActiveLiveChatMessage) GetPageToken() string {
+func (x *GetCachedActiveLiveChatRequest) GetWithTotal() bool {
 	if x != nil {
-		return x.PageToken
+		return x.WithTotal
 	}
-	return ""
+	return false
 }
 
-type ArchiveLiveChatMessage struct {
+type GetCachedActiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MessageId               string `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
-	ChannelId               string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
-	VideoId                 string `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	ClientId                string `protobuf:"bytes,4,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	AuthorName              string `protobuf:"bytes,5,opt,name=authorName,proto3" json:"authorName,omitempty"`
-	AuthorExternalChannelId string `protobuf:"bytes,6,opt,name=authorExternalChannelId,proto3" json:"authorExternalChannelId,omitempty"`
-	MessageText             string `protobuf:"bytes,7,opt,name=messageText,proto3" json:"messageText,omitempty"`
-	PurchaseAmountText      string `protobuf:"bytes,8,opt,name=purchaseAmountText,proto3" json:"purchaseAmountText,omitempty"`
-	IsPaid                  bool   `protobuf:"varint,9,opt,name=isPaid,proto3" json:"isPaid,omitempty"`
-	TimestampUsec           string `protobuf:"bytes,10,opt,name=timestampUsec,proto3" json:"timestampUsec,omitempty"`
-	TimestampText           string `protobuf:"bytes,11,opt,name=timestampText,proto3" json:"timestampText,omitempty"`
-	VideoOffsetTimeMsec     string `protobuf:"bytes,12,opt,name=videoOffsetTimeMsec,proto3" json:"videoOffsetTimeMsec,omitempty"`
-	Continuation            string `protobuf:"bytes,13,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	Status                 *Status                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveLiveChatMessages []*ActiveLiveChatMessage `protobuf:"bytes,3,rep,name=activeLiveChatMessages,proto3" json:"activeLiveChatMessages,omitempty"`
+	// 次のページのcursor (最後のページの場合は空)
+	NextCursor string `protobuf:"bytes,4,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	// 時刻の範囲に一致するメッセージの総数 (withTotalがfalseの場合は-1)
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *ArchiveLiveChatMessage) Reset() {
-	*x = ArchiveLiveChatMessage{}
+func (x *GetCachedActiveLiveChatResponse) Reset() {
+	*x = GetCachedActiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[15]
+		mi := &file_protocol_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ArchiveLiveChatMessage) String() string {
+func (x *GetCachedActiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ArchiveLiveChatMessage) ProtoMessage() {}
+func (*GetCachedActiveLiveChatResponse) ProtoMessage() {}
 
-func (x *ArchiveLiveChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[15]
+func (x *GetCachedActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1249,103 +1417,187 @@ func (x *ArchiveLiveChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ArchiveLiveChatMessage.ProtoReflect.Descriptor instead.
-func (*ArchiveLiveChatMessage) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use GetCachedActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*GetCachedActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ArchiveLiveChatMessage) GetMessageId() string {
+func (x *GetCachedActiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
-		return x.MessageId
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *ArchiveLiveChatMessage) GetChannelId() string {
+func (x *GetCachedActiveLiveChatResponse) GetActiveLiveChatMessages() []*ActiveLiveChatMessage {
 	if x != nil {
-		return x.ChannelId
+		return x.ActiveLiveChatMessages
 	}
-	return ""
+	return nil
 }
 
-func (x *ArchiveLiveChatMessage) GetVideoId() string {
+func (x *GetCachedActiveLiveChatResponse) GetNextCursor() string {
 	if x != nil {
-		return x.VideoId
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *ArchiveLiveChatMessage) GetClientId() string {
+func (x *GetCachedActiveLiveChatResponse) GetTotal() int64 {
 	if x != nil {
-		return x.ClientId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *ArchiveLiveChatMessage) GetAuthorName() string {
-	if x != nil {
-		return x.AuthorName
+type FollowActiveLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// GetCachedActiveLiveChatのnextCursorかFollowActiveLiveChatのcursor (空の場合は先頭から返す)
+	Cursor string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// キャッシュしたメッセージを返す場合の1レスポンスあたりの最大件数 (0の場合は1000)
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *FollowActiveLiveChatRequest) Reset() {
+	*x = FollowActiveLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *ArchiveLiveChatMessage) GetAuthorExternalChannelId() string {
+func (x *FollowActiveLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowActiveLiveChatRequest) ProtoMessage() {}
+
+func (x *FollowActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*FollowActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FollowActiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
-		return x.AuthorExternalChannelId
+		return x.VideoId
 	}
 	return ""
 }
 
-func (x *ArchiveLiveChatMessage) GetMessageText() string {
+func (x *FollowActiveLiveChatRequest) GetCursor() string {
 	if x != nil {
-		return x.MessageText
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *ArchiveLiveChatMessage) GetPurchaseAmountText() string {
+func (x *FollowActiveLiveChatRequest) GetCount() int64 {
 	if x != nil {
-		return x.PurchaseAmountText
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *ArchiveLiveChatMessage) GetIsPaid() bool {
+type FollowActiveLiveChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status                 *Status                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ActiveLiveChatMessages []*ActiveLiveChatMessage `protobuf:"bytes,2,rep,name=activeLiveChatMessages,proto3" json:"activeLiveChatMessages,omitempty"`
+	// trueの場合はキャッシュしたメッセージ, falseの場合はリアルタイムのメッセージ
+	Backfill bool `protobuf:"varint,3,opt,name=backfill,proto3" json:"backfill,omitempty"`
+	// 最後のメッセージの後を指すcursor (再接続する場合に使う)
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 収集状態が変化した場合にのみ設定される
+	CollectionStatus *ActiveLiveChatCollectionStatus `protobuf:"bytes,5,opt,name=collectionStatus,proto3" json:"collectionStatus,omitempty"`
+}
+
+func (x *FollowActiveLiveChatResponse) Reset() {
+	*x = FollowActiveLiveChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FollowActiveLiveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowActiveLiveChatResponse) ProtoMessage() {}
+
+func (x *FollowActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*FollowActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *FollowActiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
-		return x.IsPaid
+		return x.Status
 	}
-	return false
+	return nil
 }
 
-func (x *ArchiveLiveChatMessage) GetTimestampUsec() string {
+func (x *FollowActiveLiveChatResponse) GetActiveLiveChatMessages() []*ActiveLiveChatMessage {
 	if x != nil {
-		return x.TimestampUsec
+		return x.ActiveLiveChatMessages
 	}
-	return ""
+	return nil
 }
 
-func (x *ArchiveLiveChatMessage) GetTimestampText() string {
+func (x *FollowActiveLiveChatResponse) GetBackfill() bool {
 	if x != nil {
-		return x.TimestampText
+		return x.Backfill
 	}
-	return ""
+	return false
 }
 
-func (x *ArchiveLiveChatMessage) GetVideoOffsetTimeMsec() string {
+func (x *FollowActiveLiveChatResponse) GetCursor() string {
 	if x != nil {
-		return x.VideoOffsetTimeMsec
+		return x.Cursor
 	}
 	return ""
 }
 
-func (x *ArchiveLiveChatMessage) GetContinuation() string {
+func (x *FollowActiveLiveChatResponse) GetCollectionStatus() *ActiveLiveChatCollectionStatus {
 	if x != nil {
-		return x.Continuation
+		return x.CollectionStatus
 	}
-	return ""
+	return nil
 }
 
-type StartCollectionWordCloudMessagesRequest struct {
+type StopCollectionActiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -1353,23 +1605,23 @@ type StartCollectionWordCloudMessagesRequest struct {
 	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
 }
 
-func (x *StartCollectionWordCloudMessagesRequest) Reset() {
-	*x = StartCollectionWordCloudMessagesRequest{}
+func (x *StopCollectionActiveLiveChatRequest) Reset() {
+	*x = StopCollectionActiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[16]
+		mi := &file_protocol_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartCollectionWordCloudMessagesRequest) String() string {
+func (x *StopCollectionActiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartCollectionWordCloudMessagesRequest) ProtoMessage() {}
+func (*StopCollectionActiveLiveChatRequest) ProtoMessage() {}
 
-func (x *StartCollectionWordCloudMessagesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[16]
+func (x *StopCollectionActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1380,44 +1632,43 @@ func (x *StartCollectionWordCloudMessagesRequest) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartCollectionWordCloudMessagesRequest.ProtoReflect.Descriptor instead.
-func (*StartCollectionWordCloudMessagesRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use StopCollectionActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*StopCollectionActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *StartCollectionWordCloudMessagesRequest) GetVideoId() string {
+func (x *StopCollectionActiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
 		return x.VideoId
 	}
 	return ""
 }
 
-type StartCollectionWordCloudMessagesResponse struct {
+type StopCollectionActiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
 }
 
-func (x *StartCollectionWordCloudMessagesResponse) Reset() {
-	*x = StartCollectionWordCloudMessagesResponse{}
+func (x *StopCollectionActiveLiveChatResponse) Reset() {
+	*x = StopCollectionActiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[17]
+		mi := &file_protocol_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartCollectionWordCloudMessagesResponse) String() string {
+func (x *StopCollectionActiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartCollectionWordCloudMessagesResponse) ProtoMessage() {}
+func (*StopCollectionActiveLiveChatResponse) ProtoMessage() {}
 
-func (x *StartCollectionWordCloudMessagesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[17]
+func (x *StopCollectionActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1428,53 +1679,44 @@ func (x *StartCollectionWordCloudMessagesResponse) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartCollectionWordCloudMessagesResponse.ProtoReflect.Descriptor instead.
-func (*StartCollectionWordCloudMessagesResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use StopCollectionActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*StopCollectionActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *StartCollectionWordCloudMessagesResponse) GetStatus() *Status {
+func (x *StopCollectionActiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *StartCollectionWordCloudMessagesResponse) GetVideo() *Video {
-	if x != nil {
-		return x.Video
-	}
-	return nil
-}
-
-type Color struct {
+type StartCollectionArchiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	R uint32 `protobuf:"varint,1,opt,name=r,proto3" json:"r,omitempty"`
-	G uint32 `protobuf:"varint,2,opt,name=g,proto3" json:"g,omitempty"`
-	B uint32 `protobuf:"varint,3,opt,name=b,proto3" json:"b,omitempty"`
-	A uint32 `protobuf:"varint,4,opt,name=a,proto3" json:"a,omitempty"`
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	Replace bool   `protobuf:"varint,2,opt,name=replace,proto3" json:"replace,omitempty"`
 }
 
-func (x *Color) Reset() {
-	*x = Color{}
+func (x *StartCollectionArchiveLiveChatRequest) Reset() {
+	*x = StartCollectionArchiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[18]
+		mi := &file_protocol_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Color) String() string {
+func (x *StartCollectionArchiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Color) ProtoMessage() {}
+func (*StartCollectionArchiveLiveChatRequest) ProtoMessage() {}
 
-func (x *Color) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[18]
+func (x *StartCollectionArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1485,72 +1727,51 @@ func (x *Color) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Color.ProtoReflect.Descriptor instead.
-func (*Color) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{18}
-}
-
-func (x *Color) GetR() uint32 {
-	if x != nil {
-		return x.R
-	}
-	return 0
-}
-
-func (x *Color) GetG() uint32 {
-	if x != nil {
-		return x.G
-	}
-	return 0
+// Deprecated: Use StartCollectionArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*StartCollectionArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Color) GetB() uint32 {
+func (x *StartCollectionArchiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
-		return x.B
+		return x.VideoId
 	}
-	return 0
+	return ""
 }
 
-func (x *Color) GetA() uint32 {
+func (x *StartCollectionArchiveLiveChatRequest) GetReplace() bool {
 	if x != nil {
-		return x.A
+		return x.Replace
 	}
-	return 0
+	return false
 }
 
-type GetWordCloudRequest struct {
+type StartCollectionArchiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoId         string   `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	Target          Target   `protobuf:"varint,2,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
-	MessageLimit    int32    `protobuf:"varint,3,opt,name=messageLimit,proto3" json:"messageLimit,omitempty"`
-	Width           int32    `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
-	Height          int32    `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	FontMaxSize     int32    `protobuf:"varint,6,opt,name=fontMaxSize,proto3" json:"fontMaxSize,omitempty"`
-	FontMinSize     int32    `protobuf:"varint,7,opt,name=fontMinSize,proto3" json:"fontMinSize,omitempty"`
-	Colors          []*Color `protobuf:"bytes,8,rep,name=colors,proto3" json:"colors,omitempty"`
-	BackgroundColor *Color   `protobuf:"bytes,9,opt,name=backgroundColor,proto3" json:"backgroundColor,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
 }
 
-func (x *GetWordCloudRequest) Reset() {
-	*x = GetWordCloudRequest{}
+func (x *StartCollectionArchiveLiveChatResponse) Reset() {
+	*x = StartCollectionArchiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[19]
+		mi := &file_protocol_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWordCloudRequest) String() string {
+func (x *StartCollectionArchiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWordCloudRequest) ProtoMessage() {}
+func (*StartCollectionArchiveLiveChatResponse) ProtoMessage() {}
 
-func (x *GetWordCloudRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[19]
+func (x *StartCollectionArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1561,101 +1782,154 @@ func (x *GetWordCloudRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWordCloudRequest.ProtoReflect.Descriptor instead.
-func (*GetWordCloudRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use StartCollectionArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*StartCollectionArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetWordCloudRequest) GetVideoId() string {
+func (x *StartCollectionArchiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
-		return x.VideoId
+		return x.Status
 	}
-	return ""
+	return nil
 }
 
-func (x *GetWordCloudRequest) GetTarget() Target {
+func (x *StartCollectionArchiveLiveChatResponse) GetVideo() *Video {
 	if x != nil {
-		return x.Target
+		return x.Video
 	}
-	return Target_ALL_USER
+	return nil
 }
 
-func (x *GetWordCloudRequest) GetMessageLimit() int32 {
-	if x != nil {
-		return x.MessageLimit
-	}
-	return 0
+type GetArchiveLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// cursorが空の場合だけ使われる
+	Offset int64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count  int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// 前のレスポンスのnextCursor (空の場合は先頭から返す)
+	Cursor string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 投稿時刻の範囲 (unix時間の秒数, 0の場合は制限しない)
+	Since int64 `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,6,opt,name=until,proto3" json:"until,omitempty"`
+	// trueの場合はtotalを数える (全件を数えるためページの取得より遅い)
+	WithTotal bool `protobuf:"varint,7,opt,name=withTotal,proto3" json:"withTotal,omitempty"`
 }
 
-func (x *GetWordCloudRequest) GetWidth() int32 {
-	if x != nil {
-		return x.Width
-	}
-	return 0
+func (x *GetArchiveLiveChatRequest) Reset() {
+	*x = GetArchiveLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (x *GetWordCloudRequest) GetHeight() int32 {
+func (x *GetArchiveLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetArchiveLiveChatRequest) ProtoMessage() {}
+
+func (x *GetArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*GetArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetArchiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
-		return x.Height
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetArchiveLiveChatRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
 	}
 	return 0
 }
 
-func (x *GetWordCloudRequest) GetFontMaxSize() int32 {
+func (x *GetArchiveLiveChatRequest) GetCount() int64 {
 	if x != nil {
-		return x.FontMaxSize
+		return x.Count
 	}
 	return 0
 }
 
-func (x *GetWordCloudRequest) GetFontMinSize() int32 {
+func (x *GetArchiveLiveChatRequest) GetCursor() string {
 	if x != nil {
-		return x.FontMinSize
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *GetArchiveLiveChatRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
 	}
 	return 0
 }
 
-func (x *GetWordCloudRequest) GetColors() []*Color {
+func (x *GetArchiveLiveChatRequest) GetUntil() int64 {
 	if x != nil {
-		return x.Colors
+		return x.Until
 	}
-	return nil
+	return 0
 }
 
-func (x *GetWordCloudRequest) GetBackgroundColor() *Color {
+func (x *GetArchiveLiveChatRequest) GetWithTotal() bool {
 	if x != nil {
-		return x.BackgroundColor
+		return x.WithTotal
 	}
-	return nil
+	return false
 }
 
-type GetWordCloudResponse struct {
+type GetArchiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status   *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	MimeType string  `protobuf:"bytes,2,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
-	Data     []byte  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Status                  *Status                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ArchiveLiveChatMessages []*ArchiveLiveChatMessage `protobuf:"bytes,3,rep,name=ArchiveLiveChatMessages,proto3" json:"ArchiveLiveChatMessages,omitempty"`
+	// 次のページのcursor (最後のページの場合は空)
+	NextCursor string `protobuf:"bytes,4,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	// 時刻の範囲に一致するメッセージの総数 (withTotalがfalseの場合は-1)
+	Total int64 `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (x *GetWordCloudResponse) Reset() {
-	*x = GetWordCloudResponse{}
+func (x *GetArchiveLiveChatResponse) Reset() {
+	*x = GetArchiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[20]
+		mi := &file_protocol_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetWordCloudResponse) String() string {
+func (x *GetArchiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWordCloudResponse) ProtoMessage() {}
+func (*GetArchiveLiveChatResponse) ProtoMessage() {}
 
-func (x *GetWordCloudResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[20]
+func (x *GetArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1666,58 +1940,64 @@ func (x *GetWordCloudResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWordCloudResponse.ProtoReflect.Descriptor instead.
-func (*GetWordCloudResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*GetArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetWordCloudResponse) GetStatus() *Status {
+func (x *GetArchiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-func (x *GetWordCloudResponse) GetMimeType() string {
+func (x *GetArchiveLiveChatResponse) GetArchiveLiveChatMessages() []*ArchiveLiveChatMessage {
 	if x != nil {
-		return x.MimeType
+		return x.ArchiveLiveChatMessages
+	}
+	return nil
+}
+
+func (x *GetArchiveLiveChatResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *GetWordCloudResponse) GetData() []byte {
+func (x *GetArchiveLiveChatResponse) GetTotal() int64 {
 	if x != nil {
-		return x.Data
+		return x.Total
 	}
-	return nil
+	return 0
 }
 
-type VoteChoice struct {
+type StopCollectionArchiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Choice string `protobuf:"bytes,2,opt,name=choice,proto3" json:"choice,omitempty"`
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
 }
 
-func (x *VoteChoice) Reset() {
-	*x = VoteChoice{}
+func (x *StopCollectionArchiveLiveChatRequest) Reset() {
+	*x = StopCollectionArchiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[21]
+		mi := &file_protocol_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VoteChoice) String() string {
+func (x *StopCollectionArchiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteChoice) ProtoMessage() {}
+func (*StopCollectionArchiveLiveChatRequest) ProtoMessage() {}
 
-func (x *VoteChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[21]
+func (x *StopCollectionArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,53 +2008,43 @@ func (x *VoteChoice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteChoice.ProtoReflect.Descriptor instead.
-func (*VoteChoice) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *VoteChoice) GetLabel() string {
-	if x != nil {
-		return x.Label
-	}
-	return ""
+// Deprecated: Use StopCollectionArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*StopCollectionArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *VoteChoice) GetChoice() string {
+func (x *StopCollectionArchiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
-		return x.Choice
+		return x.VideoId
 	}
 	return ""
 }
 
-type OpenVoteRequest struct {
+type StopCollectionArchiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoId  string        `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	Target   Target        `protobuf:"varint,2,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
-	Duration int32         `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	Choices  []*VoteChoice `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *OpenVoteRequest) Reset() {
-	*x = OpenVoteRequest{}
+func (x *StopCollectionArchiveLiveChatResponse) Reset() {
+	*x = StopCollectionArchiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[22]
+		mi := &file_protocol_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenVoteRequest) String() string {
+func (x *StopCollectionArchiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenVoteRequest) ProtoMessage() {}
+func (*StopCollectionArchiveLiveChatResponse) ProtoMessage() {}
 
-func (x *OpenVoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[22]
+func (x *StopCollectionArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1785,66 +2055,43 @@ func (x *OpenVoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenVoteRequest.ProtoReflect.Descriptor instead.
-func (*OpenVoteRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *OpenVoteRequest) GetVideoId() string {
-	if x != nil {
-		return x.VideoId
-	}
-	return ""
-}
-
-func (x *OpenVoteRequest) GetTarget() Target {
-	if x != nil {
-		return x.Target
-	}
-	return Target_ALL_USER
-}
-
-func (x *OpenVoteRequest) GetDuration() int32 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+// Deprecated: Use StopCollectionArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*StopCollectionArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *OpenVoteRequest) GetChoices() []*VoteChoice {
+func (x *StopCollectionArchiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
-		return x.Choices
+		return x.Status
 	}
 	return nil
 }
 
-type OpenVoteResponse struct {
+type PollArchiveLiveChatProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	VoteId string  `protobuf:"bytes,2,opt,name=VoteId,proto3" json:"VoteId,omitempty"`
-	Video  *Video  `protobuf:"bytes,3,opt,name=video,proto3" json:"video,omitempty"`
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
 }
 
-func (x *OpenVoteResponse) Reset() {
-	*x = OpenVoteResponse{}
+func (x *PollArchiveLiveChatProgressRequest) Reset() {
+	*x = PollArchiveLiveChatProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[23]
+		mi := &file_protocol_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OpenVoteResponse) String() string {
+func (x *PollArchiveLiveChatProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenVoteResponse) ProtoMessage() {}
+func (*PollArchiveLiveChatProgressRequest) ProtoMessage() {}
 
-func (x *OpenVoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[23]
+func (x *PollArchiveLiveChatProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1855,58 +2102,47 @@ func (x *OpenVoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenVoteResponse.ProtoReflect.Descriptor instead.
-func (*OpenVoteResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *OpenVoteResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
+// Deprecated: Use PollArchiveLiveChatProgressRequest.ProtoReflect.Descriptor instead.
+func (*PollArchiveLiveChatProgressRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *OpenVoteResponse) GetVoteId() string {
+func (x *PollArchiveLiveChatProgressRequest) GetVideoId() string {
 	if x != nil {
-		return x.VoteId
+		return x.VideoId
 	}
 	return ""
 }
 
-func (x *OpenVoteResponse) GetVideo() *Video {
-	if x != nil {
-		return x.Video
-	}
-	return nil
-}
-
-type UpdateVoteDurationRequest struct {
+type ArchiveLiveChatProgress struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VoteId   string `protobuf:"bytes,1,opt,name=voteId,proto3" json:"voteId,omitempty"`
-	Duration int32  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	State               CollectionState `protobuf:"varint,1,opt,name=state,proto3,enum=CollectionState" json:"state,omitempty"`
+	VideoOffsetTimeMsec int64           `protobuf:"varint,2,opt,name=videoOffsetTimeMsec,proto3" json:"videoOffsetTimeMsec,omitempty"`
+	DurationMsec        int64           `protobuf:"varint,3,opt,name=durationMsec,proto3" json:"durationMsec,omitempty"`
+	MessageCount        int64           `protobuf:"varint,4,opt,name=messageCount,proto3" json:"messageCount,omitempty"`
+	LastError           string          `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
 }
 
-func (x *UpdateVoteDurationRequest) Reset() {
-	*x = UpdateVoteDurationRequest{}
+func (x *ArchiveLiveChatProgress) Reset() {
+	*x = ArchiveLiveChatProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[24]
+		mi := &file_protocol_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateVoteDurationRequest) String() string {
+func (x *ArchiveLiveChatProgress) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVoteDurationRequest) ProtoMessage() {}
+func (*ArchiveLiveChatProgress) ProtoMessage() {}
 
-func (x *UpdateVoteDurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[24]
+func (x *ArchiveLiveChatProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1917,50 +2153,72 @@ func (x *UpdateVoteDurationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVoteDurationRequest.ProtoReflect.Descriptor instead.
-func (*UpdateVoteDurationRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ArchiveLiveChatProgress.ProtoReflect.Descriptor instead.
+func (*ArchiveLiveChatProgress) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *UpdateVoteDurationRequest) GetVoteId() string {
+func (x *ArchiveLiveChatProgress) GetState() CollectionState {
 	if x != nil {
-		return x.VoteId
+		return x.State
 	}
-	return ""
+	return CollectionState_RUNNING
 }
 
-func (x *UpdateVoteDurationRequest) GetDuration() int32 {
+func (x *ArchiveLiveChatProgress) GetVideoOffsetTimeMsec() int64 {
 	if x != nil {
-		return x.Duration
+		return x.VideoOffsetTimeMsec
 	}
 	return 0
 }
 
-type UpdateVoteDurationResponse struct {
+func (x *ArchiveLiveChatProgress) GetDurationMsec() int64 {
+	if x != nil {
+		return x.DurationMsec
+	}
+	return 0
+}
+
+func (x *ArchiveLiveChatProgress) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *ArchiveLiveChatProgress) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type PollArchiveLiveChatProgressResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Status   *Status                  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Progress *ArchiveLiveChatProgress `protobuf:"bytes,2,opt,name=progress,proto3" json:"progress,omitempty"`
 }
 
-func (x *UpdateVoteDurationResponse) Reset() {
-	*x = UpdateVoteDurationResponse{}
+func (x *PollArchiveLiveChatProgressResponse) Reset() {
+	*x = PollArchiveLiveChatProgressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[25]
+		mi := &file_protocol_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateVoteDurationResponse) String() string {
+func (x *PollArchiveLiveChatProgressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateVoteDurationResponse) ProtoMessage() {}
+func (*PollArchiveLiveChatProgressResponse) ProtoMessage() {}
 
-func (x *UpdateVoteDurationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[25]
+func (x *PollArchiveLiveChatProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1971,45 +2229,53 @@ func (x *UpdateVoteDurationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateVoteDurationResponse.ProtoReflect.Descriptor instead.
-func (*UpdateVoteDurationResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PollArchiveLiveChatProgressResponse.ProtoReflect.Descriptor instead.
+func (*PollArchiveLiveChatProgressResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateVoteDurationResponse) GetStatus() *Status {
+func (x *PollArchiveLiveChatProgressResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type VoteCount struct {
+func (x *PollArchiveLiveChatProgressResponse) GetProgress() *ArchiveLiveChatProgress {
+	if x != nil {
+		return x.Progress
+	}
+	return nil
+}
+
+type ReplayArchiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Choice string `protobuf:"bytes,2,opt,name=choice,proto3" json:"choice,omitempty"`
-	Count  int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	VideoId         string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	StartOffsetMsec int64  `protobuf:"varint,2,opt,name=startOffsetMsec,proto3" json:"startOffsetMsec,omitempty"`
+	// 0以下の場合は1倍速
+	Speed float64 `protobuf:"fixed64,3,opt,name=speed,proto3" json:"speed,omitempty"`
 }
 
-func (x *VoteCount) Reset() {
-	*x = VoteCount{}
+func (x *ReplayArchiveLiveChatRequest) Reset() {
+	*x = ReplayArchiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[26]
+		mi := &file_protocol_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VoteCount) String() string {
+func (x *ReplayArchiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VoteCount) ProtoMessage() {}
+func (*ReplayArchiveLiveChatRequest) ProtoMessage() {}
 
-func (x *VoteCount) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[26]
+func (x *ReplayArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2020,57 +2286,62 @@ func (x *VoteCount) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VoteCount.ProtoReflect.Descriptor instead.
-func (*VoteCount) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ReplayArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*ReplayArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *VoteCount) GetLabel() string {
+func (x *ReplayArchiveLiveChatRequest) GetVideoId() string {
 	if x != nil {
-		return x.Label
+		return x.VideoId
 	}
 	return ""
 }
 
-func (x *VoteCount) GetChoice() string {
+func (x *ReplayArchiveLiveChatRequest) GetStartOffsetMsec() int64 {
 	if x != nil {
-		return x.Choice
+		return x.StartOffsetMsec
 	}
-	return ""
+	return 0
 }
 
-func (x *VoteCount) GetCount() int32 {
+func (x *ReplayArchiveLiveChatRequest) GetSpeed() float64 {
 	if x != nil {
-		return x.Count
+		return x.Speed
 	}
 	return 0
 }
 
-type GetVoteResultRequest struct {
+type ReplayArchiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VoteId string `protobuf:"bytes,1,opt,name=voteId,proto3" json:"voteId,omitempty"`
+	Status                  *Status                   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ReplayId                string                    `protobuf:"bytes,2,opt,name=replayId,proto3" json:"replayId,omitempty"`
+	State                   ReplayState               `protobuf:"varint,3,opt,name=state,proto3,enum=ReplayState" json:"state,omitempty"`
+	VideoOffsetTimeMsec     int64                     `protobuf:"varint,4,opt,name=videoOffsetTimeMsec,proto3" json:"videoOffsetTimeMsec,omitempty"`
+	Speed                   float64                   `protobuf:"fixed64,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	ArchiveLiveChatMessages []*ArchiveLiveChatMessage `protobuf:"bytes,6,rep,name=archiveLiveChatMessages,proto3" json:"archiveLiveChatMessages,omitempty"`
 }
 
-func (x *GetVoteResultRequest) Reset() {
-	*x = GetVoteResultRequest{}
+func (x *ReplayArchiveLiveChatResponse) Reset() {
+	*x = ReplayArchiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[27]
+		mi := &file_protocol_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetVoteResultRequest) String() string {
+func (x *ReplayArchiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetVoteResultRequest) ProtoMessage() {}
+func (*ReplayArchiveLiveChatResponse) ProtoMessage() {}
 
-func (x *GetVoteResultRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[27]
+func (x *ReplayArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,106 +2352,83 @@ func (x *GetVoteResultRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetVoteResultRequest.ProtoReflect.Descriptor instead.
-func (*GetVoteResultRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ReplayArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*ReplayArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetVoteResultRequest) GetVoteId() string {
+func (x *ReplayArchiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
-		return x.VoteId
+		return x.Status
 	}
-	return ""
-}
-
-type GetVoteResultResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Status *Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Total  int32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Counts []*VoteCount `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty"`
+	return nil
 }
 
-func (x *GetVoteResultResponse) Reset() {
-	*x = GetVoteResultResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[28]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ReplayArchiveLiveChatResponse) GetReplayId() string {
+	if x != nil {
+		return x.ReplayId
 	}
+	return ""
 }
 
-func (x *GetVoteResultResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*GetVoteResultResponse) ProtoMessage() {}
-
-func (x *GetVoteResultResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[28]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ReplayArchiveLiveChatResponse) GetState() ReplayState {
+	if x != nil {
+		return x.State
 	}
-	return mi.MessageOf(x)
+	return ReplayState_PLAYING
 }
 
-// Deprecated: Use GetVoteResultResponse.ProtoReflect.Descriptor instead.
-func (*GetVoteResultResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{28}
-}
-
-func (x *GetVoteResultResponse) GetStatus() *Status {
+func (x *ReplayArchiveLiveChatResponse) GetVideoOffsetTimeMsec() int64 {
 	if x != nil {
-		return x.Status
+		return x.VideoOffsetTimeMsec
 	}
-	return nil
+	return 0
 }
 
-func (x *GetVoteResultResponse) GetTotal() int32 {
+func (x *ReplayArchiveLiveChatResponse) GetSpeed() float64 {
 	if x != nil {
-		return x.Total
+		return x.Speed
 	}
 	return 0
 }
 
-func (x *GetVoteResultResponse) GetCounts() []*VoteCount {
+func (x *ReplayArchiveLiveChatResponse) GetArchiveLiveChatMessages() []*ArchiveLiveChatMessage {
 	if x != nil {
-		return x.Counts
+		return x.ArchiveLiveChatMessages
 	}
 	return nil
 }
 
-type CloseVoteRequest struct {
+type ControlReplayArchiveLiveChatRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VoteId string `protobuf:"bytes,1,opt,name=voteId,proto3" json:"voteId,omitempty"`
+	ReplayId string        `protobuf:"bytes,1,opt,name=replayId,proto3" json:"replayId,omitempty"`
+	Control  ReplayControl `protobuf:"varint,2,opt,name=control,proto3,enum=ReplayControl" json:"control,omitempty"`
+	// SEEKの場合のみ
+	OffsetMsec int64 `protobuf:"varint,3,opt,name=offsetMsec,proto3" json:"offsetMsec,omitempty"`
+	// CHANGE_SPEEDの場合のみ
+	Speed float64 `protobuf:"fixed64,4,opt,name=speed,proto3" json:"speed,omitempty"`
 }
 
-func (x *CloseVoteRequest) Reset() {
-	*x = CloseVoteRequest{}
+func (x *ControlReplayArchiveLiveChatRequest) Reset() {
+	*x = ControlReplayArchiveLiveChatRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[29]
+		mi := &file_protocol_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseVoteRequest) String() string {
+func (x *ControlReplayArchiveLiveChatRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseVoteRequest) ProtoMessage() {}
+func (*ControlReplayArchiveLiveChatRequest) ProtoMessage() {}
 
-func (x *CloseVoteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[29]
+func (x *ControlReplayArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2191,19 +2439,40 @@ func (x *CloseVoteRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseVoteRequest.ProtoReflect.Descriptor instead.
-func (*CloseVoteRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ControlReplayArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*ControlReplayArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CloseVoteRequest) GetVoteId() string {
+func (x *ControlReplayArchiveLiveChatRequest) GetReplayId() string {
 	if x != nil {
-		return x.VoteId
+		return x.ReplayId
 	}
 	return ""
 }
 
-type CloseVoteResponse struct {
+func (x *ControlReplayArchiveLiveChatRequest) GetControl() ReplayControl {
+	if x != nil {
+		return x.Control
+	}
+	return ReplayControl_PAUSE
+}
+
+func (x *ControlReplayArchiveLiveChatRequest) GetOffsetMsec() int64 {
+	if x != nil {
+		return x.OffsetMsec
+	}
+	return 0
+}
+
+func (x *ControlReplayArchiveLiveChatRequest) GetSpeed() float64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+type ControlReplayArchiveLiveChatResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -2211,23 +2480,23 @@ type CloseVoteResponse struct {
 	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *CloseVoteResponse) Reset() {
-	*x = CloseVoteResponse{}
+func (x *ControlReplayArchiveLiveChatResponse) Reset() {
+	*x = ControlReplayArchiveLiveChatResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[30]
+		mi := &file_protocol_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloseVoteResponse) String() string {
+func (x *ControlReplayArchiveLiveChatResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloseVoteResponse) ProtoMessage() {}
+func (*ControlReplayArchiveLiveChatResponse) ProtoMessage() {}
 
-func (x *CloseVoteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[30]
+func (x *ControlReplayArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2238,46 +2507,57 @@ func (x *CloseVoteResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloseVoteResponse.ProtoReflect.Descriptor instead.
-func (*CloseVoteResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ControlReplayArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*ControlReplayArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *CloseVoteResponse) GetStatus() *Status {
+func (x *ControlReplayArchiveLiveChatResponse) GetStatus() *Status {
 	if x != nil {
 		return x.Status
 	}
 	return nil
 }
 
-type GroupingActiveLiveChatMessage struct {
+type Video struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	GroupIdx              int32                  `protobuf:"varint,1,opt,name=groupIdx,proto3" json:"groupIdx,omitempty"`
-	Label                 string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
-	Choice                string                 `protobuf:"bytes,3,opt,name=choice,proto3" json:"choice,omitempty"`
-	ActiveLiveChatMessage *ActiveLiveChatMessage `protobuf:"bytes,4,opt,name=activeLiveChatMessage,proto3" json:"activeLiveChatMessage,omitempty"`
+	VideoId            string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	ChannelId          string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	CategoryId         string `protobuf:"bytes,3,opt,name=categoryId,proto3" json:"categoryId,omitempty"`
+	Title              string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	PublishedAt        string `protobuf:"bytes,6,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	Duration           string `protobuf:"bytes,7,opt,name=duration,proto3" json:"duration,omitempty"`
+	ActiveLiveChatId   string `protobuf:"bytes,8,opt,name=activeLiveChatId,proto3" json:"activeLiveChatId,omitempty"`
+	ActualStartTime    string `protobuf:"bytes,9,opt,name=actualStartTime,proto3" json:"actualStartTime,omitempty"`
+	ActualEndTime      string `protobuf:"bytes,10,opt,name=actualEndTime,proto3" json:"actualEndTime,omitempty"`
+	ScheduledStartTime string `protobuf:"bytes,11,opt,name=scheduledStartTime,proto3" json:"scheduledStartTime,omitempty"`
+	ScheduledEndTime   string `protobuf:"bytes,12,opt,name=scheduledEndTime,proto3" json:"scheduledEndTime,omitempty"`
+	PrivacyStatus      string `protobuf:"bytes,13,opt,name=privacyStatus,proto3" json:"privacyStatus,omitempty"`
+	UploadStatus       string `protobuf:"bytes,14,opt,name=uploadStatus,proto3" json:"uploadStatus,omitempty"`
+	Embeddable         bool   `protobuf:"varint,15,opt,name=embeddable,proto3" json:"embeddable,omitempty"`
 }
 
-func (x *GroupingActiveLiveChatMessage) Reset() {
-	*x = GroupingActiveLiveChatMessage{}
+func (x *Video) Reset() {
+	*x = Video{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[31]
+		mi := &file_protocol_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GroupingActiveLiveChatMessage) String() string {
+func (x *Video) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GroupingActiveLiveChatMessage) ProtoMessage() {}
+func (*Video) ProtoMessage() {}
 
-func (x *GroupingActiveLiveChatMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[31]
+func (x *Video) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2288,121 +2568,184 @@ func (x *GroupingActiveLiveChatMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GroupingActiveLiveChatMessage.ProtoReflect.Descriptor instead.
-func (*GroupingActiveLiveChatMessage) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use Video.ProtoReflect.Descriptor instead.
+func (*Video) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GroupingActiveLiveChatMessage) GetGroupIdx() int32 {
+func (x *Video) GetVideoId() string {
 	if x != nil {
-		return x.GroupIdx
+		return x.VideoId
 	}
-	return 0
+	return ""
 }
 
-func (x *GroupingActiveLiveChatMessage) GetLabel() string {
+func (x *Video) GetChannelId() string {
 	if x != nil {
-		return x.Label
+		return x.ChannelId
 	}
 	return ""
 }
 
-func (x *GroupingActiveLiveChatMessage) GetChoice() string {
+func (x *Video) GetCategoryId() string {
 	if x != nil {
-		return x.Choice
+		return x.CategoryId
 	}
 	return ""
 }
 
-func (x *GroupingActiveLiveChatMessage) GetActiveLiveChatMessage() *ActiveLiveChatMessage {
+func (x *Video) GetTitle() string {
 	if x != nil {
-		return x.ActiveLiveChatMessage
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-type GroupingChoice struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
-	Choice string `protobuf:"bytes,2,opt,name=choice,proto3" json:"choice,omitempty"`
+func (x *Video) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *GroupingChoice) Reset() {
-	*x = GroupingChoice{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[32]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Video) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
 	}
+	return ""
 }
 
-func (x *GroupingChoice) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Video) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
 }
 
-func (*GroupingChoice) ProtoMessage() {}
-
-func (x *GroupingChoice) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[32]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Video) GetActiveLiveChatId() string {
+	if x != nil {
+		return x.ActiveLiveChatId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GroupingChoice.ProtoReflect.Descriptor instead.
-func (*GroupingChoice) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{32}
+func (x *Video) GetActualStartTime() string {
+	if x != nil {
+		return x.ActualStartTime
+	}
+	return ""
 }
 
-func (x *GroupingChoice) GetLabel() string {
+func (x *Video) GetActualEndTime() string {
 	if x != nil {
-		return x.Label
+		return x.ActualEndTime
 	}
 	return ""
 }
 
-func (x *GroupingChoice) GetChoice() string {
+func (x *Video) GetScheduledStartTime() string {
 	if x != nil {
-		return x.Choice
+		return x.ScheduledStartTime
 	}
 	return ""
 }
 
-type StartGroupingActiveLiveChatRequest struct {
+func (x *Video) GetScheduledEndTime() string {
+	if x != nil {
+		return x.ScheduledEndTime
+	}
+	return ""
+}
+
+func (x *Video) GetPrivacyStatus() string {
+	if x != nil {
+		return x.PrivacyStatus
+	}
+	return ""
+}
+
+func (x *Video) GetUploadStatus() string {
+	if x != nil {
+		return x.UploadStatus
+	}
+	return ""
+}
+
+func (x *Video) GetEmbeddable() bool {
+	if x != nil {
+		return x.Embeddable
+	}
+	return false
+}
+
+type ActiveLiveChatMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	VideoId string            `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
-	Target  Target            `protobuf:"varint,2,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
-	Choices []*GroupingChoice `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+	MessageId             string                  `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	ChannelId             string                  `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	VideoId               string                  `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	ApiEtag               string                  `protobuf:"bytes,4,opt,name=apiEtag,proto3" json:"apiEtag,omitempty"`
+	AuthorChannelId       string                  `protobuf:"bytes,5,opt,name=authorChannelId,proto3" json:"authorChannelId,omitempty"`
+	AuthorChannelUrl      string                  `protobuf:"bytes,6,opt,name=authorChannelUrl,proto3" json:"authorChannelUrl,omitempty"`
+	AuthorDisplayName     string                  `protobuf:"bytes,7,opt,name=authorDisplayName,proto3" json:"authorDisplayName,omitempty"`
+	AuthorIsChatModerator bool                    `protobuf:"varint,8,opt,name=authorIsChatModerator,proto3" json:"authorIsChatModerator,omitempty"`
+	AuthorIsChatOwner     bool                    `protobuf:"varint,9,opt,name=authorIsChatOwner,proto3" json:"authorIsChatOwner,omitempty"`
+	AuthorIsChatSponsor   bool                    `protobuf:"varint,10,opt,name=authorIsChatSponsor,proto3" json:"authorIsChatSponsor,omitempty"`
+	AuthorIsVerified      bool                    `protobuf:"varint,11,opt,name=authorIsVerified,proto3" json:"authorIsVerified,omitempty"`
+	LiveChatId            string                  `protobuf:"bytes,12,opt,name=liveChatId,proto3" json:"liveChatId,omitempty"`
+	DisplayMessage        string                  `protobuf:"bytes,13,opt,name=displayMessage,proto3" json:"displayMessage,omitempty"`
+	PublishedAt           string                  `protobuf:"bytes,14,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	IsSuperChat           bool                    `protobuf:"varint,15,opt,name=isSuperChat,proto3" json:"isSuperChat,omitempty"`
+	IsSuperSticker        bool                    `protobuf:"varint,16,opt,name=isSuperSticker,proto3" json:"isSuperSticker,omitempty"`
+	IsFanFundingEvent     bool                    `protobuf:"varint,17,opt,name=isFanFundingEvent,proto3" json:"isFanFundingEvent,omitempty"`
+	AmountMicros          string                  `protobuf:"bytes,18,opt,name=amountMicros,proto3" json:"amountMicros,omitempty"`
+	AmountDisplayString   string                  `protobuf:"bytes,19,opt,name=amountDisplayString,proto3" json:"amountDisplayString,omitempty"`
+	Currency              string                  `protobuf:"bytes,20,opt,name=currency,proto3" json:"currency,omitempty"`
+	PageToken             string                  `protobuf:"bytes,21,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	EventType             ActiveLiveChatEventType `protobuf:"varint,22,opt,name=eventType,proto3,enum=ActiveLiveChatEventType" json:"eventType,omitempty"`
+	// NEW_SPONSOR_EVENT, MEMBER_MILESTONE_CHAT_EVENT, MEMBERSHIP_GIFTING_EVENT, GIFT_MEMBERSHIP_RECEIVED_EVENT
+	MemberLevelName string `protobuf:"bytes,23,opt,name=memberLevelName,proto3" json:"memberLevelName,omitempty"`
+	// MEMBER_MILESTONE_CHAT_EVENT
+	MemberMonth int64 `protobuf:"varint,24,opt,name=memberMonth,proto3" json:"memberMonth,omitempty"`
+	// NEW_SPONSOR_EVENT
+	IsUpgrade bool `protobuf:"varint,25,opt,name=isUpgrade,proto3" json:"isUpgrade,omitempty"`
+	// MEMBERSHIP_GIFTING_EVENT
+	GiftMembershipsCount int64 `protobuf:"varint,26,opt,name=giftMembershipsCount,proto3" json:"giftMembershipsCount,omitempty"`
+	// GIFT_MEMBERSHIP_RECEIVED_EVENT
+	GifterChannelId                      string `protobuf:"bytes,27,opt,name=gifterChannelId,proto3" json:"gifterChannelId,omitempty"`
+	AssociatedMembershipGiftingMessageId string `protobuf:"bytes,28,opt,name=associatedMembershipGiftingMessageId,proto3" json:"associatedMembershipGiftingMessageId,omitempty"`
+	// MESSAGE_DELETED_EVENT, MESSAGE_RETRACTED_EVENT
+	TargetMessageId string `protobuf:"bytes,29,opt,name=targetMessageId,proto3" json:"targetMessageId,omitempty"`
+	// USER_BANNED_EVENT
+	BannedUserChannelId   string `protobuf:"bytes,30,opt,name=bannedUserChannelId,proto3" json:"bannedUserChannelId,omitempty"`
+	BannedUserDisplayName string `protobuf:"bytes,31,opt,name=bannedUserDisplayName,proto3" json:"bannedUserDisplayName,omitempty"`
+	BanType               string `protobuf:"bytes,32,opt,name=banType,proto3" json:"banType,omitempty"`
+	BanDurationSeconds    int64  `protobuf:"varint,33,opt,name=banDurationSeconds,proto3" json:"banDurationSeconds,omitempty"`
+	// 削除されたメッセージ
+	IsDeleted bool `protobuf:"varint,34,opt,name=isDeleted,proto3" json:"isDeleted,omitempty"`
+	// 投稿者がBANされたメッセージ
+	IsAuthorBanned bool `protobuf:"varint,35,opt,name=isAuthorBanned,proto3" json:"isAuthorBanned,omitempty"`
 }
 
-func (x *StartGroupingActiveLiveChatRequest) Reset() {
-	*x = StartGroupingActiveLiveChatRequest{}
+func (x *ActiveLiveChatMessage) Reset() {
+	*x = ActiveLiveChatMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[33]
+		mi := &file_protocol_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StartGroupingActiveLiveChatRequest) String() string {
+func (x *ActiveLiveChatMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartGroupingActiveLiveChatRequest) ProtoMessage() {}
+func (*ActiveLiveChatMessage) ProtoMessage() {}
 
-func (x *StartGroupingActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[33]
+func (x *ActiveLiveChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2413,761 +2756,6033 @@ func (x *StartGroupingActiveLiveChatRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartGroupingActiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*StartGroupingActiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ActiveLiveChatMessage.ProtoReflect.Descriptor instead.
+func (*ActiveLiveChatMessage) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *StartGroupingActiveLiveChatRequest) GetVideoId() string {
+func (x *ActiveLiveChatMessage) GetMessageId() string {
 	if x != nil {
-		return x.VideoId
+		return x.MessageId
 	}
 	return ""
 }
 
-func (x *StartGroupingActiveLiveChatRequest) GetTarget() Target {
+func (x *ActiveLiveChatMessage) GetChannelId() string {
 	if x != nil {
-		return x.Target
+		return x.ChannelId
 	}
-	return Target_ALL_USER
+	return ""
 }
 
-func (x *StartGroupingActiveLiveChatRequest) GetChoices() []*GroupingChoice {
+func (x *ActiveLiveChatMessage) GetVideoId() string {
 	if x != nil {
-		return x.Choices
+		return x.VideoId
 	}
-	return nil
+	return ""
 }
 
-type StartGroupingActiveLiveChatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ActiveLiveChatMessage) GetApiEtag() string {
+	if x != nil {
+		return x.ApiEtag
+	}
+	return ""
+}
 
-	Status     *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	GroupingId string  `protobuf:"bytes,2,opt,name=groupingId,proto3" json:"groupingId,omitempty"`
-	Video      *Video  `protobuf:"bytes,3,opt,name=video,proto3" json:"video,omitempty"`
+func (x *ActiveLiveChatMessage) GetAuthorChannelId() string {
+	if x != nil {
+		return x.AuthorChannelId
+	}
+	return ""
 }
 
-func (x *StartGroupingActiveLiveChatResponse) Reset() {
-	*x = StartGroupingActiveLiveChatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ActiveLiveChatMessage) GetAuthorChannelUrl() string {
+	if x != nil {
+		return x.AuthorChannelUrl
 	}
+	return ""
 }
 
-func (x *StartGroupingActiveLiveChatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActiveLiveChatMessage) GetAuthorDisplayName() string {
+	if x != nil {
+		return x.AuthorDisplayName
+	}
+	return ""
 }
 
-func (*StartGroupingActiveLiveChatResponse) ProtoMessage() {}
+func (x *ActiveLiveChatMessage) GetAuthorIsChatModerator() bool {
+	if x != nil {
+		return x.AuthorIsChatModerator
+	}
+	return false
+}
 
-func (x *StartGroupingActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ActiveLiveChatMessage) GetAuthorIsChatOwner() bool {
+	if x != nil {
+		return x.AuthorIsChatOwner
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use StartGroupingActiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*StartGroupingActiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{34}
+func (x *ActiveLiveChatMessage) GetAuthorIsChatSponsor() bool {
+	if x != nil {
+		return x.AuthorIsChatSponsor
+	}
+	return false
 }
 
-func (x *StartGroupingActiveLiveChatResponse) GetStatus() *Status {
+func (x *ActiveLiveChatMessage) GetAuthorIsVerified() bool {
 	if x != nil {
-		return x.Status
+		return x.AuthorIsVerified
 	}
-	return nil
+	return false
 }
 
-func (x *StartGroupingActiveLiveChatResponse) GetGroupingId() string {
+func (x *ActiveLiveChatMessage) GetLiveChatId() string {
 	if x != nil {
-		return x.GroupingId
+		return x.LiveChatId
 	}
 	return ""
 }
 
-func (x *StartGroupingActiveLiveChatResponse) GetVideo() *Video {
+func (x *ActiveLiveChatMessage) GetDisplayMessage() string {
 	if x != nil {
-		return x.Video
+		return x.DisplayMessage
 	}
-	return nil
+	return ""
 }
 
-type PollGroupingActiveLiveChatRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	GroupingId string `protobuf:"bytes,1,opt,name=groupingId,proto3" json:"groupingId,omitempty"`
+func (x *ActiveLiveChatMessage) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
 }
 
-func (x *PollGroupingActiveLiveChatRequest) Reset() {
-	*x = PollGroupingActiveLiveChatRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ActiveLiveChatMessage) GetIsSuperChat() bool {
+	if x != nil {
+		return x.IsSuperChat
 	}
+	return false
 }
 
-func (x *PollGroupingActiveLiveChatRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActiveLiveChatMessage) GetIsSuperSticker() bool {
+	if x != nil {
+		return x.IsSuperSticker
+	}
+	return false
 }
 
-func (*PollGroupingActiveLiveChatRequest) ProtoMessage() {}
+func (x *ActiveLiveChatMessage) GetIsFanFundingEvent() bool {
+	if x != nil {
+		return x.IsFanFundingEvent
+	}
+	return false
+}
 
-func (x *PollGroupingActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ActiveLiveChatMessage) GetAmountMicros() string {
+	if x != nil {
+		return x.AmountMicros
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use PollGroupingActiveLiveChatRequest.ProtoReflect.Descriptor instead.
-func (*PollGroupingActiveLiveChatRequest) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{35}
+func (x *ActiveLiveChatMessage) GetAmountDisplayString() string {
+	if x != nil {
+		return x.AmountDisplayString
+	}
+	return ""
 }
 
-func (x *PollGroupingActiveLiveChatRequest) GetGroupingId() string {
+func (x *ActiveLiveChatMessage) GetCurrency() string {
 	if x != nil {
-		return x.GroupingId
+		return x.Currency
 	}
 	return ""
 }
 
-type PollGroupingActiveLiveChatResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *ActiveLiveChatMessage) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
 
-	Status                        *Status                        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	GroupingActiveLiveChatMessage *GroupingActiveLiveChatMessage `protobuf:"bytes,2,opt,name=groupingActiveLiveChatMessage,proto3" json:"groupingActiveLiveChatMessage,omitempty"`
+func (x *ActiveLiveChatMessage) GetEventType() ActiveLiveChatEventType {
+	if x != nil {
+		return x.EventType
+	}
+	return ActiveLiveChatEventType_TEXT_MESSAGE_EVENT
 }
 
-func (x *PollGroupingActiveLiveChatResponse) Reset() {
-	*x = PollGroupingActiveLiveChatResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_protocol_proto_msgTypes[36]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ActiveLiveChatMessage) GetMemberLevelName() string {
+	if x != nil {
+		return x.MemberLevelName
 	}
+	return ""
 }
 
-func (x *PollGroupingActiveLiveChatResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActiveLiveChatMessage) GetMemberMonth() int64 {
+	if x != nil {
+		return x.MemberMonth
+	}
+	return 0
 }
 
-func (*PollGroupingActiveLiveChatResponse) ProtoMessage() {}
+func (x *ActiveLiveChatMessage) GetIsUpgrade() bool {
+	if x != nil {
+		return x.IsUpgrade
+	}
+	return false
+}
 
-func (x *PollGroupingActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_protocol_proto_msgTypes[36]
+func (x *ActiveLiveChatMessage) GetGiftMembershipsCount() int64 {
+	if x != nil {
+		return x.GiftMembershipsCount
+	}
+	return 0
+}
+
+func (x *ActiveLiveChatMessage) GetGifterChannelId() string {
+	if x != nil {
+		return x.GifterChannelId
+	}
+	return ""
+}
+
+func (x *ActiveLiveChatMessage) GetAssociatedMembershipGiftingMessageId() string {
+	if x != nil {
+		return x.AssociatedMembershipGiftingMessageId
+	}
+	return ""
+}
+
+func (x *ActiveLiveChatMessage) GetTargetMessageId() string {
+	if x != nil {
+		return x.TargetMessageId
+	}
+	return ""
+}
+
+func (x *ActiveLiveChatMessage) GetBannedUserChannelId() string {
+	if x != nil {
+		return x.BannedUserChannelId
+	}
+	return ""
+}
+
+func (x *ActiveLiveChatMessage) GetBannedUserDisplayName() string {
+	if x != nil {
+		return x.BannedUserDisplayName
+	}
+	return ""
+}
+
+func (x *ActiveLiveChatMessage) GetBanType() string {
+	if x != nil {
+		return x.BanType
+	}
+	return ""
+}
+
+func (x *ActiveLiveChatMessage) GetBanDurationSeconds() int64 {
+	if x != nil {
+		return x.BanDurationSeconds
+	}
+	return 0
+}
+
+func (x *ActiveLiveChatMessage) GetIsDeleted() bool {
+	if x != nil {
+		return x.IsDeleted
+	}
+	return false
+}
+
+func (x *ActiveLiveChatMessage) GetIsAuthorBanned() bool {
+	if x != nil {
+		return x.IsAuthorBanned
+	}
+	return false
+}
+
+type ArchiveLiveChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MessageId               string                     `protobuf:"bytes,1,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	ChannelId               string                     `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	VideoId                 string                     `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	ClientId                string                     `protobuf:"bytes,4,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	AuthorName              string                     `protobuf:"bytes,5,opt,name=authorName,proto3" json:"authorName,omitempty"`
+	AuthorExternalChannelId string                     `protobuf:"bytes,6,opt,name=authorExternalChannelId,proto3" json:"authorExternalChannelId,omitempty"`
+	MessageText             string                     `protobuf:"bytes,7,opt,name=messageText,proto3" json:"messageText,omitempty"`
+	PurchaseAmountText      string                     `protobuf:"bytes,8,opt,name=purchaseAmountText,proto3" json:"purchaseAmountText,omitempty"`
+	IsPaid                  bool                       `protobuf:"varint,9,opt,name=isPaid,proto3" json:"isPaid,omitempty"`
+	TimestampUsec           string                     `protobuf:"bytes,10,opt,name=timestampUsec,proto3" json:"timestampUsec,omitempty"`
+	TimestampText           string                     `protobuf:"bytes,11,opt,name=timestampText,proto3" json:"timestampText,omitempty"`
+	VideoOffsetTimeMsec     string                     `protobuf:"bytes,12,opt,name=videoOffsetTimeMsec,proto3" json:"videoOffsetTimeMsec,omitempty"`
+	Continuation            string                     `protobuf:"bytes,13,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	MessageType             ArchiveLiveChatMessageType `protobuf:"varint,14,opt,name=messageType,proto3,enum=ArchiveLiveChatMessageType" json:"messageType,omitempty"`
+	AuthorIsChatModerator   bool                       `protobuf:"varint,15,opt,name=authorIsChatModerator,proto3" json:"authorIsChatModerator,omitempty"`
+	AuthorIsChatOwner       bool                       `protobuf:"varint,16,opt,name=authorIsChatOwner,proto3" json:"authorIsChatOwner,omitempty"`
+	AuthorIsChatSponsor     bool                       `protobuf:"varint,17,opt,name=authorIsChatSponsor,proto3" json:"authorIsChatSponsor,omitempty"`
+	AuthorIsVerified        bool                       `protobuf:"varint,18,opt,name=authorIsVerified,proto3" json:"authorIsVerified,omitempty"`
+	AuthorBadges            []*AuthorBadge             `protobuf:"bytes,19,rep,name=authorBadges,proto3" json:"authorBadges,omitempty"`
+	// MEMBERSHIP_ITEM, SPONSORSHIPS_GIFT_PURCHASEのヘッダー
+	HeaderText string `protobuf:"bytes,20,opt,name=headerText,proto3" json:"headerText,omitempty"`
+	// SPONSORSHIPS_GIFT_PURCHASE
+	GiftMembershipsCount int64 `protobuf:"varint,21,opt,name=giftMembershipsCount,proto3" json:"giftMembershipsCount,omitempty"`
+}
+
+func (x *ArchiveLiveChatMessage) Reset() {
+	*x = ArchiveLiveChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArchiveLiveChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveLiveChatMessage) ProtoMessage() {}
+
+func (x *ArchiveLiveChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveLiveChatMessage.ProtoReflect.Descriptor instead.
+func (*ArchiveLiveChatMessage) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ArchiveLiveChatMessage) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetClientId() string {
+	if x != nil {
+		return x.ClientId
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorName() string {
+	if x != nil {
+		return x.AuthorName
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorExternalChannelId() string {
+	if x != nil {
+		return x.AuthorExternalChannelId
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetMessageText() string {
+	if x != nil {
+		return x.MessageText
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetPurchaseAmountText() string {
+	if x != nil {
+		return x.PurchaseAmountText
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetIsPaid() bool {
+	if x != nil {
+		return x.IsPaid
+	}
+	return false
+}
+
+func (x *ArchiveLiveChatMessage) GetTimestampUsec() string {
+	if x != nil {
+		return x.TimestampUsec
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetTimestampText() string {
+	if x != nil {
+		return x.TimestampText
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetVideoOffsetTimeMsec() string {
+	if x != nil {
+		return x.VideoOffsetTimeMsec
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetContinuation() string {
+	if x != nil {
+		return x.Continuation
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetMessageType() ArchiveLiveChatMessageType {
+	if x != nil {
+		return x.MessageType
+	}
+	return ArchiveLiveChatMessageType_TEXT_MESSAGE
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorIsChatModerator() bool {
+	if x != nil {
+		return x.AuthorIsChatModerator
+	}
+	return false
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorIsChatOwner() bool {
+	if x != nil {
+		return x.AuthorIsChatOwner
+	}
+	return false
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorIsChatSponsor() bool {
+	if x != nil {
+		return x.AuthorIsChatSponsor
+	}
+	return false
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorIsVerified() bool {
+	if x != nil {
+		return x.AuthorIsVerified
+	}
+	return false
+}
+
+func (x *ArchiveLiveChatMessage) GetAuthorBadges() []*AuthorBadge {
+	if x != nil {
+		return x.AuthorBadges
+	}
+	return nil
+}
+
+func (x *ArchiveLiveChatMessage) GetHeaderText() string {
+	if x != nil {
+		return x.HeaderText
+	}
+	return ""
+}
+
+func (x *ArchiveLiveChatMessage) GetGiftMembershipsCount() int64 {
+	if x != nil {
+		return x.GiftMembershipsCount
+	}
+	return 0
+}
+
+type AuthorBadge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OWNER, MODERATOR, VERIFIED, メンバーの場合は空
+	IconType     string `protobuf:"bytes,1,opt,name=iconType,proto3" json:"iconType,omitempty"`
+	Tooltip      string `protobuf:"bytes,2,opt,name=tooltip,proto3" json:"tooltip,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,3,opt,name=thumbnailUrl,proto3" json:"thumbnailUrl,omitempty"`
+}
+
+func (x *AuthorBadge) Reset() {
+	*x = AuthorBadge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorBadge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorBadge) ProtoMessage() {}
+
+func (x *AuthorBadge) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use PollGroupingActiveLiveChatResponse.ProtoReflect.Descriptor instead.
-func (*PollGroupingActiveLiveChatResponse) Descriptor() ([]byte, []int) {
-	return file_protocol_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *PollGroupingActiveLiveChatResponse) GetStatus() *Status {
-	if x != nil {
-		return x.Status
-	}
-	return nil
-}
-
-func (x *PollGroupingActiveLiveChatResponse) GetGroupingActiveLiveChatMessage() *GroupingActiveLiveChatMessage {
-	if x != nil {
-		return x.GroupingActiveLiveChatMessage
-	}
-	return nil
-}
-
-var File_protocol_proto protoreflect.FileDescriptor
-
-var file_protocol_proto_rawDesc = []byte{
-	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x3d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x05, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x10,
-	0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22,
-	0x40, 0x0a, 0x24, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x64, 0x22, 0x66, 0x0a, 0x25, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
-	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76,
-	0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64,
-	0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x35, 0x0a, 0x19, 0x50, 0x6f, 0x6c,
-	0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
-	0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
-	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x4e, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x22, 0x68, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x1f, 0x47,
-	0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
-	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f,
-	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x4e, 0x0a, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x16, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
-	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22,
-	0x5b, 0x0a, 0x25, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65,
-	0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x26,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x63, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66,
-	0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x17, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x97, 0x04,
-	0x0a, 0x05, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
-	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49,
-	0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x75,
-	0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61,
-	0x63, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73,
-	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
-	0x65, 0x12, 0x2a, 0x0a, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x6e,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x63, 0x68,
-	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a,
-	0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x62, 0x65, 0x64,
-	0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x62,
-	0x65, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xbf, 0x06, 0x0a, 0x15, 0x41, 0x63, 0x74, 0x69,
-	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x45, 0x74,
-	0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x45, 0x74, 0x61,
-	0x67, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
-	0x65, 0x6c, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x72, 0x6c, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61,
-	0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49,
-	0x73, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68,
-	0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73,
-	0x43, 0x68, 0x61, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73,
-	0x43, 0x68, 0x61, 0x74, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x49, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x76,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c,
-	0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x43,
-	0x68, 0x61, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x53, 0x74,
-	0x69, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x53,
-	0x75, 0x70, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x69,
-	0x73, 0x46, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
-	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x73, 0x46, 0x61, 0x6e, 0x46, 0x75, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x30, 0x0a,
-	0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12,
-	0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xf0, 0x03, 0x0a, 0x16, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c,
-	0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
-	0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x45,
-	0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x78, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65,
-	0x78, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x41, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
-	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x65,
-	0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x73, 0x65, 0x63,
-	0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x65, 0x78,
-	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x54, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x27,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
-	0x64, 0x22, 0x69, 0x0a, 0x28, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
-	0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
-	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x3f, 0x0a, 0x05,
-	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x01, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01,
-	0x67, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x62, 0x12,
-	0x0c, 0x0a, 0x01, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x61, 0x22, 0xb8, 0x02,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x07, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c,
-	0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a,
-	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x61, 0x78,
-	0x53, 0x69, 0x7a, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x53,
-	0x69, 0x7a, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d,
-	0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x06,
-	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72,
-	0x6f, 0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x06, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
-	0x75, 0x6e, 0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57,
-	0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x8f, 0x01,
-	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69,
-	0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x6f, 0x74, 0x65,
-	0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22,
-	0x69, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05,
-	0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69,
-	0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x4f, 0x0a, 0x19, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x1a, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4f, 0x0a, 0x09, 0x56, 0x6f,
-	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a,
-	0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63,
-	0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x06, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x6f,
-	0x74, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22,
-	0x2a, 0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x11, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xb7, 0x01, 0x0a, 0x1d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x78, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x78, 0x12,
-	0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a,
-	0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
-	0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x22,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06,
-	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x54,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a,
-	0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
-	0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52,
-	0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x23, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
-	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x49,
-	0x64, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22,
-	0x43, 0x0a, 0x21, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67,
-	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69,
-	0x6e, 0x67, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x22, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
-	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x64, 0x0a, 0x1d,
-	0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
-	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x1d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x2a, 0x5a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x55,
-	0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46,
-	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x50, 0x45,
-	0x52, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49, 0x4e, 0x5f,
-	0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e, 0x49, 0x4e,
-	0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04, 0x2a, 0x48,
-	0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f,
-	0x55, 0x53, 0x45, 0x52, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f,
-	0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x4f,
-	0x52, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44,
-	0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x32, 0xb1, 0x09, 0x0a, 0x04, 0x79, 0x6c, 0x63,
-	0x63, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x11, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x25, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x53,
-	0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x50,
-	0x6f, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x17, 0x47, 0x65, 0x74,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64,
-	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
-	0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x1e, 0x53, 0x74, 0x61,
-	0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x26, 0x2e, 0x53, 0x74,
-	0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f,
-	0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x12, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
-	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x79, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57,
-	0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65,
-	0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x12, 0x14, 0x2e, 0x47, 0x65, 0x74,
-	0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x08, 0x4f, 0x70, 0x65,
-	0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f,
-	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a,
-	0x0d, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x15,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x34, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x11, 0x2e, 0x43,
-	0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x12, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1b, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
-	0x43, 0x68, 0x61, 0x74, 0x12, 0x23, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75,
-	0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
-	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x53, 0x74, 0x61, 0x72,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
-	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x69, 0x0a, 0x1a, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e,
-	0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12,
-	0x22, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63,
-	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69,
-	0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x2f, 0x5a, 0x1e,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x74, 0x69, 0x78,
-	0x2f, 0x79, 0x6c, 0x63, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0xaa, 0x02,
-	0x0c, 0x79, 0x6c, 0x63, 0x63, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_protocol_proto_rawDescOnce sync.Once
-	file_protocol_proto_rawDescData = file_protocol_proto_rawDesc
-)
-
-func file_protocol_proto_rawDescGZIP() []byte {
-	file_protocol_proto_rawDescOnce.Do(func() {
-		file_protocol_proto_rawDescData = protoimpl.X.CompressGZIP(file_protocol_proto_rawDescData)
-	})
-	return file_protocol_proto_rawDescData
-}
-
-var file_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
-var file_protocol_proto_goTypes = []interface{}{
-	(Code)(0),                // 0: Code
-	(Target)(0),              // 1: Target
-	(*Status)(nil),           // 2: Status
-	(*GetVideoRequest)(nil),  // 3: GetVideoRequest
-	(*GetVideoResponse)(nil), // 4: GetVideoResponse
-	(*StartCollectionActiveLiveChatRequest)(nil),     // 5: StartCollectionActiveLiveChatRequest
-	(*StartCollectionActiveLiveChatResponse)(nil),    // 6: StartCollectionActiveLiveChatResponse
-	(*PollActiveLiveChatRequest)(nil),                // 7: PollActiveLiveChatRequest
-	(*PollActiveLiveChatResponse)(nil),               // 8: PollActiveLiveChatResponse
-	(*GetCachedActiveLiveChatRequest)(nil),           // 9: GetCachedActiveLiveChatRequest
-	(*GetCachedActiveLiveChatResponse)(nil),          // 10: GetCachedActiveLiveChatResponse
-	(*StartCollectionArchiveLiveChatRequest)(nil),    // 11: StartCollectionArchiveLiveChatRequest
-	(*StartCollectionArchiveLiveChatResponse)(nil),   // 12: StartCollectionArchiveLiveChatResponse
-	(*GetArchiveLiveChatRequest)(nil),                // 13: GetArchiveLiveChatRequest
-	(*GetArchiveLiveChatResponse)(nil),               // 14: GetArchiveLiveChatResponse
-	(*Video)(nil),                                    // 15: Video
-	(*ActiveLiveChatMessage)(nil),                    // 16: ActiveLiveChatMessage
-	(*ArchiveLiveChatMessage)(nil),                   // 17: ArchiveLiveChatMessage
-	(*StartCollectionWordCloudMessagesRequest)(nil),  // 18: StartCollectionWordCloudMessagesRequest
-	(*StartCollectionWordCloudMessagesResponse)(nil), // 19: StartCollectionWordCloudMessagesResponse
-	(*Color)(nil),                               // 20: Color
-	(*GetWordCloudRequest)(nil),                 // 21: GetWordCloudRequest
-	(*GetWordCloudResponse)(nil),                // 22: GetWordCloudResponse
-	(*VoteChoice)(nil),                          // 23: VoteChoice
-	(*OpenVoteRequest)(nil),                     // 24: OpenVoteRequest
-	(*OpenVoteResponse)(nil),                    // 25: OpenVoteResponse
-	(*UpdateVoteDurationRequest)(nil),           // 26: UpdateVoteDurationRequest
-	(*UpdateVoteDurationResponse)(nil),          // 27: UpdateVoteDurationResponse
-	(*VoteCount)(nil),                           // 28: VoteCount
-	(*GetVoteResultRequest)(nil),                // 29: GetVoteResultRequest
-	(*GetVoteResultResponse)(nil),               // 30: GetVoteResultResponse
-	(*CloseVoteRequest)(nil),                    // 31: CloseVoteRequest
-	(*CloseVoteResponse)(nil),                   // 32: CloseVoteResponse
-	(*GroupingActiveLiveChatMessage)(nil),       // 33: GroupingActiveLiveChatMessage
-	(*GroupingChoice)(nil),                      // 34: GroupingChoice
-	(*StartGroupingActiveLiveChatRequest)(nil),  // 35: StartGroupingActiveLiveChatRequest
-	(*StartGroupingActiveLiveChatResponse)(nil), // 36: StartGroupingActiveLiveChatResponse
-	(*PollGroupingActiveLiveChatRequest)(nil),   // 37: PollGroupingActiveLiveChatRequest
-	(*PollGroupingActiveLiveChatResponse)(nil),  // 38: PollGroupingActiveLiveChatResponse
-}
-var file_protocol_proto_depIdxs = []int32{
-	0,  // 0: Status.code:type_name -> Code
-	2,  // 1: GetVideoResponse.status:type_name -> Status
-	15, // 2: GetVideoResponse.video:type_name -> Video
-	2,  // 3: StartCollectionActiveLiveChatResponse.status:type_name -> Status
-	15, // 4: StartCollectionActiveLiveChatResponse.video:type_name -> Video
-	2,  // 5: PollActiveLiveChatResponse.status:type_name -> Status
-	16, // 6: PollActiveLiveChatResponse.activeLiveChatMessages:type_name -> ActiveLiveChatMessage
-	2,  // 7: GetCachedActiveLiveChatResponse.status:type_name -> Status
-	16, // 8: GetCachedActiveLiveChatResponse.activeLiveChatMessages:type_name -> ActiveLiveChatMessage
-	2,  // 9: StartCollectionArchiveLiveChatResponse.status:type_name -> Status
-	15, // 10: StartCollectionArchiveLiveChatResponse.video:type_name -> Video
-	2,  // 11: GetArchiveLiveChatResponse.status:type_name -> Status
-	17, // 12: GetArchiveLiveChatResponse.ArchiveLiveChatMessages:type_name -> ArchiveLiveChatMessage
-	2,  // 13: StartCollectionWordCloudMessagesResponse.status:type_name -> Status
-	15, // 14: StartCollectionWordCloudMessagesResponse.video:type_name -> Video
-	1,  // 15: GetWordCloudRequest.target:type_name -> Target
-	20, // 16: GetWordCloudRequest.colors:type_name -> Color
-	20, // 17: GetWordCloudRequest.backgroundColor:type_name -> Color
-	2,  // 18: GetWordCloudResponse.status:type_name -> Status
-	1,  // 19: OpenVoteRequest.target:type_name -> Target
-	23, // 20: OpenVoteRequest.choices:type_name -> VoteChoice
-	2,  // 21: OpenVoteResponse.status:type_name -> Status
-	15, // 22: OpenVoteResponse.video:type_name -> Video
-	2,  // 23: UpdateVoteDurationResponse.status:type_name -> Status
-	2,  // 24: GetVoteResultResponse.status:type_name -> Status
-	28, // 25: GetVoteResultResponse.counts:type_name -> VoteCount
-	2,  // 26: CloseVoteResponse.status:type_name -> Status
-	16, // 27: GroupingActiveLiveChatMessage.activeLiveChatMessage:type_name -> ActiveLiveChatMessage
-	1,  // 28: StartGroupingActiveLiveChatRequest.target:type_name -> Target
-	34, // 29: StartGroupingActiveLiveChatRequest.choices:type_name -> GroupingChoice
-	2,  // 30: StartGroupingActiveLiveChatResponse.status:type_name -> Status
-	15, // 31: StartGroupingActiveLiveChatResponse.video:type_name -> Video
-	2,  // 32: PollGroupingActiveLiveChatResponse.status:type_name -> Status
-	33, // 33: PollGroupingActiveLiveChatResponse.groupingActiveLiveChatMessage:type_name -> GroupingActiveLiveChatMessage
-	3,  // 34: ylcc.GetVideo:input_type -> GetVideoRequest
-	5,  // 35: ylcc.StartCollectionActiveLiveChat:input_type -> StartCollectionActiveLiveChatRequest
-	7,  // 36: ylcc.PollActiveLiveChat:input_type -> PollActiveLiveChatRequest
-	9,  // 37: ylcc.GetCachedActiveLiveChat:input_type -> GetCachedActiveLiveChatRequest
-	11, // 38: ylcc.StartCollectionArchiveLiveChat:input_type -> StartCollectionArchiveLiveChatRequest
-	13, // 39: ylcc.GetArchiveLiveChat:input_type -> GetArchiveLiveChatRequest
-	18, // 40: ylcc.StartCollectionWordCloudMessages:input_type -> StartCollectionWordCloudMessagesRequest
-	21, // 41: ylcc.GetWordCloud:input_type -> GetWordCloudRequest
-	24, // 42: ylcc.OpenVote:input_type -> OpenVoteRequest
-	26, // 43: ylcc.UpdateVoteDuration:input_type -> UpdateVoteDurationRequest
-	29, // 44: ylcc.GetVoteResult:input_type -> GetVoteResultRequest
-	31, // 45: ylcc.CloseVote:input_type -> CloseVoteRequest
-	35, // 46: ylcc.StartGroupingActiveLiveChat:input_type -> StartGroupingActiveLiveChatRequest
-	37, // 47: ylcc.PollGroupingActiveLiveChat:input_type -> PollGroupingActiveLiveChatRequest
-	4,  // 48: ylcc.GetVideo:output_type -> GetVideoResponse
-	6,  // 49: ylcc.StartCollectionActiveLiveChat:output_type -> StartCollectionActiveLiveChatResponse
-	8,  // 50: ylcc.PollActiveLiveChat:output_type -> PollActiveLiveChatResponse
-	10, // 51: ylcc.GetCachedActiveLiveChat:output_type -> GetCachedActiveLiveChatResponse
-	12, // 52: ylcc.StartCollectionArchiveLiveChat:output_type -> StartCollectionArchiveLiveChatResponse
-	14, // 53: ylcc.GetArchiveLiveChat:output_type -> GetArchiveLiveChatResponse
-	19, // 54: ylcc.StartCollectionWordCloudMessages:output_type -> StartCollectionWordCloudMessagesResponse
-	22, // 55: ylcc.GetWordCloud:output_type -> GetWordCloudResponse
-	25, // 56: ylcc.OpenVote:output_type -> OpenVoteResponse
-	27, // 57: ylcc.UpdateVoteDuration:output_type -> UpdateVoteDurationResponse
-	30, // 58: ylcc.GetVoteResult:output_type -> GetVoteResultResponse
-	32, // 59: ylcc.CloseVote:output_type -> CloseVoteResponse
-	36, // 60: ylcc.StartGroupingActiveLiveChat:output_type -> StartGroupingActiveLiveChatResponse
-	38, // 61: ylcc.PollGroupingActiveLiveChat:output_type -> PollGroupingActiveLiveChatResponse
-	48, // [48:62] is the sub-list for method output_type
-	34, // [34:48] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
-}
-
-func init() { file_protocol_proto_init() }
-func file_protocol_proto_init() {
-	if File_protocol_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_protocol_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorBadge.ProtoReflect.Descriptor instead.
+func (*AuthorBadge) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AuthorBadge) GetIconType() string {
+	if x != nil {
+		return x.IconType
+	}
+	return ""
+}
+
+func (x *AuthorBadge) GetTooltip() string {
+	if x != nil {
+		return x.Tooltip
+	}
+	return ""
+}
+
+func (x *AuthorBadge) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+type StartCollectionWordCloudMessagesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId string            `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	Source  ChatMessageSource `protobuf:"varint,2,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+}
+
+func (x *StartCollectionWordCloudMessagesRequest) Reset() {
+	*x = StartCollectionWordCloudMessagesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartCollectionWordCloudMessagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCollectionWordCloudMessagesRequest) ProtoMessage() {}
+
+func (x *StartCollectionWordCloudMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCollectionWordCloudMessagesRequest.ProtoReflect.Descriptor instead.
+func (*StartCollectionWordCloudMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *StartCollectionWordCloudMessagesRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *StartCollectionWordCloudMessagesRequest) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+type StartCollectionWordCloudMessagesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+}
+
+func (x *StartCollectionWordCloudMessagesResponse) Reset() {
+	*x = StartCollectionWordCloudMessagesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartCollectionWordCloudMessagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartCollectionWordCloudMessagesResponse) ProtoMessage() {}
+
+func (x *StartCollectionWordCloudMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartCollectionWordCloudMessagesResponse.ProtoReflect.Descriptor instead.
+func (*StartCollectionWordCloudMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StartCollectionWordCloudMessagesResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StartCollectionWordCloudMessagesResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type Color struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	R uint32 `protobuf:"varint,1,opt,name=r,proto3" json:"r,omitempty"`
+	G uint32 `protobuf:"varint,2,opt,name=g,proto3" json:"g,omitempty"`
+	B uint32 `protobuf:"varint,3,opt,name=b,proto3" json:"b,omitempty"`
+	A uint32 `protobuf:"varint,4,opt,name=a,proto3" json:"a,omitempty"`
+}
+
+func (x *Color) Reset() {
+	*x = Color{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Color) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Color) ProtoMessage() {}
+
+func (x *Color) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Color.ProtoReflect.Descriptor instead.
+func (*Color) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Color) GetR() uint32 {
+	if x != nil {
+		return x.R
+	}
+	return 0
+}
+
+func (x *Color) GetG() uint32 {
+	if x != nil {
+		return x.G
+	}
+	return 0
+}
+
+func (x *Color) GetB() uint32 {
+	if x != nil {
+		return x.B
+	}
+	return 0
+}
+
+func (x *Color) GetA() uint32 {
+	if x != nil {
+		return x.A
+	}
+	return 0
+}
+
+type GetWordCloudRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId         string            `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	Target          Target            `protobuf:"varint,2,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
+	MessageLimit    int32             `protobuf:"varint,3,opt,name=messageLimit,proto3" json:"messageLimit,omitempty"`
+	Width           int32             `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	Height          int32             `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	FontMaxSize     int32             `protobuf:"varint,6,opt,name=fontMaxSize,proto3" json:"fontMaxSize,omitempty"`
+	FontMinSize     int32             `protobuf:"varint,7,opt,name=fontMinSize,proto3" json:"fontMinSize,omitempty"`
+	Colors          []*Color          `protobuf:"bytes,8,rep,name=colors,proto3" json:"colors,omitempty"`
+	BackgroundColor *Color            `protobuf:"bytes,9,opt,name=backgroundColor,proto3" json:"backgroundColor,omitempty"`
+	Source          ChatMessageSource `protobuf:"varint,10,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+}
+
+func (x *GetWordCloudRequest) Reset() {
+	*x = GetWordCloudRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWordCloudRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWordCloudRequest) ProtoMessage() {}
+
+func (x *GetWordCloudRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWordCloudRequest.ProtoReflect.Descriptor instead.
+func (*GetWordCloudRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetWordCloudRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetWordCloudRequest) GetTarget() Target {
+	if x != nil {
+		return x.Target
+	}
+	return Target_ALL_USER
+}
+
+func (x *GetWordCloudRequest) GetMessageLimit() int32 {
+	if x != nil {
+		return x.MessageLimit
+	}
+	return 0
+}
+
+func (x *GetWordCloudRequest) GetWidth() int32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *GetWordCloudRequest) GetHeight() int32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *GetWordCloudRequest) GetFontMaxSize() int32 {
+	if x != nil {
+		return x.FontMaxSize
+	}
+	return 0
+}
+
+func (x *GetWordCloudRequest) GetFontMinSize() int32 {
+	if x != nil {
+		return x.FontMinSize
+	}
+	return 0
+}
+
+func (x *GetWordCloudRequest) GetColors() []*Color {
+	if x != nil {
+		return x.Colors
+	}
+	return nil
+}
+
+func (x *GetWordCloudRequest) GetBackgroundColor() *Color {
+	if x != nil {
+		return x.BackgroundColor
+	}
+	return nil
+}
+
+func (x *GetWordCloudRequest) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+type GetWordCloudResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status   *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	MimeType string  `protobuf:"bytes,2,opt,name=mimeType,proto3" json:"mimeType,omitempty"`
+	Data     []byte  `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetWordCloudResponse) Reset() {
+	*x = GetWordCloudResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetWordCloudResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWordCloudResponse) ProtoMessage() {}
+
+func (x *GetWordCloudResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWordCloudResponse.ProtoReflect.Descriptor instead.
+func (*GetWordCloudResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetWordCloudResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetWordCloudResponse) GetMimeType() string {
+	if x != nil {
+		return x.MimeType
+	}
+	return ""
+}
+
+func (x *GetWordCloudResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type VoteChoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Choice string `protobuf:"bytes,2,opt,name=choice,proto3" json:"choice,omitempty"`
+}
+
+func (x *VoteChoice) Reset() {
+	*x = VoteChoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteChoice) ProtoMessage() {}
+
+func (x *VoteChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteChoice.ProtoReflect.Descriptor instead.
+func (*VoteChoice) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VoteChoice) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *VoteChoice) GetChoice() string {
+	if x != nil {
+		return x.Choice
+	}
+	return ""
+}
+
+type OpenVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId  string            `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	Target   Target            `protobuf:"varint,2,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
+	Duration int32             `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Choices  []*VoteChoice     `protobuf:"bytes,4,rep,name=choices,proto3" json:"choices,omitempty"`
+	Source   ChatMessageSource `protobuf:"varint,5,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+	// アーカイブの場合の集計開始位置、集計範囲はdurationの秒数(0の場合は最後まで)
+	StartOffsetMsec int64 `protobuf:"varint,6,opt,name=startOffsetMsec,proto3" json:"startOffsetMsec,omitempty"`
+}
+
+func (x *OpenVoteRequest) Reset() {
+	*x = OpenVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenVoteRequest) ProtoMessage() {}
+
+func (x *OpenVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenVoteRequest.ProtoReflect.Descriptor instead.
+func (*OpenVoteRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *OpenVoteRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *OpenVoteRequest) GetTarget() Target {
+	if x != nil {
+		return x.Target
+	}
+	return Target_ALL_USER
+}
+
+func (x *OpenVoteRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *OpenVoteRequest) GetChoices() []*VoteChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+func (x *OpenVoteRequest) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+func (x *OpenVoteRequest) GetStartOffsetMsec() int64 {
+	if x != nil {
+		return x.StartOffsetMsec
+	}
+	return 0
+}
+
+type OpenVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	VoteId string  `protobuf:"bytes,2,opt,name=VoteId,proto3" json:"VoteId,omitempty"`
+	Video  *Video  `protobuf:"bytes,3,opt,name=video,proto3" json:"video,omitempty"`
+}
+
+func (x *OpenVoteResponse) Reset() {
+	*x = OpenVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OpenVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OpenVoteResponse) ProtoMessage() {}
+
+func (x *OpenVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OpenVoteResponse.ProtoReflect.Descriptor instead.
+func (*OpenVoteResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *OpenVoteResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *OpenVoteResponse) GetVoteId() string {
+	if x != nil {
+		return x.VoteId
+	}
+	return ""
+}
+
+func (x *OpenVoteResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type UpdateVoteDurationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoteId   string `protobuf:"bytes,1,opt,name=voteId,proto3" json:"voteId,omitempty"`
+	Duration int32  `protobuf:"varint,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *UpdateVoteDurationRequest) Reset() {
+	*x = UpdateVoteDurationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVoteDurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVoteDurationRequest) ProtoMessage() {}
+
+func (x *UpdateVoteDurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVoteDurationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVoteDurationRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *UpdateVoteDurationRequest) GetVoteId() string {
+	if x != nil {
+		return x.VoteId
+	}
+	return ""
+}
+
+func (x *UpdateVoteDurationRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type UpdateVoteDurationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *UpdateVoteDurationResponse) Reset() {
+	*x = UpdateVoteDurationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateVoteDurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVoteDurationResponse) ProtoMessage() {}
+
+func (x *UpdateVoteDurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVoteDurationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateVoteDurationResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateVoteDurationResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type VoteCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Choice string `protobuf:"bytes,2,opt,name=choice,proto3" json:"choice,omitempty"`
+	Count  int32  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *VoteCount) Reset() {
+	*x = VoteCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VoteCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VoteCount) ProtoMessage() {}
+
+func (x *VoteCount) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VoteCount.ProtoReflect.Descriptor instead.
+func (*VoteCount) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *VoteCount) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *VoteCount) GetChoice() string {
+	if x != nil {
+		return x.Choice
+	}
+	return ""
+}
+
+func (x *VoteCount) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type GetVoteResultRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoteId string `protobuf:"bytes,1,opt,name=voteId,proto3" json:"voteId,omitempty"`
+}
+
+func (x *GetVoteResultRequest) Reset() {
+	*x = GetVoteResultRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteResultRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteResultRequest) ProtoMessage() {}
+
+func (x *GetVoteResultRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteResultRequest.ProtoReflect.Descriptor instead.
+func (*GetVoteResultRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetVoteResultRequest) GetVoteId() string {
+	if x != nil {
+		return x.VoteId
+	}
+	return ""
+}
+
+type GetVoteResultResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Total  int32        `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Counts []*VoteCount `protobuf:"bytes,3,rep,name=counts,proto3" json:"counts,omitempty"`
+}
+
+func (x *GetVoteResultResponse) Reset() {
+	*x = GetVoteResultResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVoteResultResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVoteResultResponse) ProtoMessage() {}
+
+func (x *GetVoteResultResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVoteResultResponse.ProtoReflect.Descriptor instead.
+func (*GetVoteResultResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetVoteResultResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetVoteResultResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetVoteResultResponse) GetCounts() []*VoteCount {
+	if x != nil {
+		return x.Counts
+	}
+	return nil
+}
+
+type CloseVoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VoteId string `protobuf:"bytes,1,opt,name=voteId,proto3" json:"voteId,omitempty"`
+}
+
+func (x *CloseVoteRequest) Reset() {
+	*x = CloseVoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseVoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseVoteRequest) ProtoMessage() {}
+
+func (x *CloseVoteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseVoteRequest.ProtoReflect.Descriptor instead.
+func (*CloseVoteRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CloseVoteRequest) GetVoteId() string {
+	if x != nil {
+		return x.VoteId
+	}
+	return ""
+}
+
+type CloseVoteResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *CloseVoteResponse) Reset() {
+	*x = CloseVoteResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloseVoteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseVoteResponse) ProtoMessage() {}
+
+func (x *CloseVoteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseVoteResponse.ProtoReflect.Descriptor instead.
+func (*CloseVoteResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CloseVoteResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+type GroupingActiveLiveChatMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupIdx              int32                  `protobuf:"varint,1,opt,name=groupIdx,proto3" json:"groupIdx,omitempty"`
+	Label                 string                 `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Choice                string                 `protobuf:"bytes,3,opt,name=choice,proto3" json:"choice,omitempty"`
+	ActiveLiveChatMessage *ActiveLiveChatMessage `protobuf:"bytes,4,opt,name=activeLiveChatMessage,proto3" json:"activeLiveChatMessage,omitempty"`
+}
+
+func (x *GroupingActiveLiveChatMessage) Reset() {
+	*x = GroupingActiveLiveChatMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupingActiveLiveChatMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupingActiveLiveChatMessage) ProtoMessage() {}
+
+func (x *GroupingActiveLiveChatMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupingActiveLiveChatMessage.ProtoReflect.Descriptor instead.
+func (*GroupingActiveLiveChatMessage) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GroupingActiveLiveChatMessage) GetGroupIdx() int32 {
+	if x != nil {
+		return x.GroupIdx
+	}
+	return 0
+}
+
+func (x *GroupingActiveLiveChatMessage) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GroupingActiveLiveChatMessage) GetChoice() string {
+	if x != nil {
+		return x.Choice
+	}
+	return ""
+}
+
+func (x *GroupingActiveLiveChatMessage) GetActiveLiveChatMessage() *ActiveLiveChatMessage {
+	if x != nil {
+		return x.ActiveLiveChatMessage
+	}
+	return nil
+}
+
+type GroupingChoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Choice string `protobuf:"bytes,2,opt,name=choice,proto3" json:"choice,omitempty"`
+}
+
+func (x *GroupingChoice) Reset() {
+	*x = GroupingChoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GroupingChoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GroupingChoice) ProtoMessage() {}
+
+func (x *GroupingChoice) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GroupingChoice.ProtoReflect.Descriptor instead.
+func (*GroupingChoice) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GroupingChoice) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *GroupingChoice) GetChoice() string {
+	if x != nil {
+		return x.Choice
+	}
+	return ""
+}
+
+type StartGroupingActiveLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId string            `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	Target  Target            `protobuf:"varint,2,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
+	Choices []*GroupingChoice `protobuf:"bytes,3,rep,name=choices,proto3" json:"choices,omitempty"`
+}
+
+func (x *StartGroupingActiveLiveChatRequest) Reset() {
+	*x = StartGroupingActiveLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGroupingActiveLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGroupingActiveLiveChatRequest) ProtoMessage() {}
+
+func (x *StartGroupingActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGroupingActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*StartGroupingActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StartGroupingActiveLiveChatRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *StartGroupingActiveLiveChatRequest) GetTarget() Target {
+	if x != nil {
+		return x.Target
+	}
+	return Target_ALL_USER
+}
+
+func (x *StartGroupingActiveLiveChatRequest) GetChoices() []*GroupingChoice {
+	if x != nil {
+		return x.Choices
+	}
+	return nil
+}
+
+type StartGroupingActiveLiveChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	GroupingId string  `protobuf:"bytes,2,opt,name=groupingId,proto3" json:"groupingId,omitempty"`
+	Video      *Video  `protobuf:"bytes,3,opt,name=video,proto3" json:"video,omitempty"`
+}
+
+func (x *StartGroupingActiveLiveChatResponse) Reset() {
+	*x = StartGroupingActiveLiveChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartGroupingActiveLiveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartGroupingActiveLiveChatResponse) ProtoMessage() {}
+
+func (x *StartGroupingActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartGroupingActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*StartGroupingActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StartGroupingActiveLiveChatResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *StartGroupingActiveLiveChatResponse) GetGroupingId() string {
+	if x != nil {
+		return x.GroupingId
+	}
+	return ""
+}
+
+func (x *StartGroupingActiveLiveChatResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+type PollGroupingActiveLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupingId string `protobuf:"bytes,1,opt,name=groupingId,proto3" json:"groupingId,omitempty"`
+}
+
+func (x *PollGroupingActiveLiveChatRequest) Reset() {
+	*x = PollGroupingActiveLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollGroupingActiveLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollGroupingActiveLiveChatRequest) ProtoMessage() {}
+
+func (x *PollGroupingActiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollGroupingActiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*PollGroupingActiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PollGroupingActiveLiveChatRequest) GetGroupingId() string {
+	if x != nil {
+		return x.GroupingId
+	}
+	return ""
+}
+
+type PollGroupingActiveLiveChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status                        *Status                        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	GroupingActiveLiveChatMessage *GroupingActiveLiveChatMessage `protobuf:"bytes,2,opt,name=groupingActiveLiveChatMessage,proto3" json:"groupingActiveLiveChatMessage,omitempty"`
+}
+
+func (x *PollGroupingActiveLiveChatResponse) Reset() {
+	*x = PollGroupingActiveLiveChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PollGroupingActiveLiveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PollGroupingActiveLiveChatResponse) ProtoMessage() {}
+
+func (x *PollGroupingActiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PollGroupingActiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*PollGroupingActiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *PollGroupingActiveLiveChatResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PollGroupingActiveLiveChatResponse) GetGroupingActiveLiveChatMessage() *GroupingActiveLiveChatMessage {
+	if x != nil {
+		return x.GroupingActiveLiveChatMessage
+	}
+	return nil
+}
+
+type Collection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kind CollectionKind `protobuf:"varint,1,opt,name=kind,proto3,enum=CollectionKind" json:"kind,omitempty"`
+	// videoId, voteId or groupingId
+	Id              string          `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId         string          `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	State           CollectionState `protobuf:"varint,4,opt,name=state,proto3,enum=CollectionState" json:"state,omitempty"`
+	StartedAt       string          `protobuf:"bytes,5,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	MessageCount    int64           `protobuf:"varint,6,opt,name=messageCount,proto3" json:"messageCount,omitempty"`
+	LastPollAt      string          `protobuf:"bytes,7,opt,name=lastPollAt,proto3" json:"lastPollAt,omitempty"`
+	LastError       string          `protobuf:"bytes,8,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	SubscriberCount int32           `protobuf:"varint,9,opt,name=subscriberCount,proto3" json:"subscriberCount,omitempty"`
+	PageToken       string          `protobuf:"bytes,10,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	Continuation    string          `protobuf:"bytes,11,opt,name=continuation,proto3" json:"continuation,omitempty"`
+	// ACTIVE_LIVE_CHATのみ
+	Backend ActiveLiveChatBackend `protobuf:"varint,12,opt,name=backend,proto3,enum=ActiveLiveChatBackend" json:"backend,omitempty"`
+}
+
+func (x *Collection) Reset() {
+	*x = Collection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Collection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Collection) ProtoMessage() {}
+
+func (x *Collection) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Collection.ProtoReflect.Descriptor instead.
+func (*Collection) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *Collection) GetKind() CollectionKind {
+	if x != nil {
+		return x.Kind
+	}
+	return CollectionKind_ACTIVE_LIVE_CHAT
+}
+
+func (x *Collection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Collection) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *Collection) GetState() CollectionState {
+	if x != nil {
+		return x.State
+	}
+	return CollectionState_RUNNING
+}
+
+func (x *Collection) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *Collection) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *Collection) GetLastPollAt() string {
+	if x != nil {
+		return x.LastPollAt
+	}
+	return ""
+}
+
+func (x *Collection) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *Collection) GetSubscriberCount() int32 {
+	if x != nil {
+		return x.SubscriberCount
+	}
+	return 0
+}
+
+func (x *Collection) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *Collection) GetContinuation() string {
+	if x != nil {
+		return x.Continuation
+	}
+	return ""
+}
+
+func (x *Collection) GetBackend() ActiveLiveChatBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return ActiveLiveChatBackend_DEFAULT_BACKEND
+}
+
+type ListCollectionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCollectionsRequest) Reset() {
+	*x = ListCollectionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsRequest) ProtoMessage() {}
+
+func (x *ListCollectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsRequest.ProtoReflect.Descriptor instead.
+func (*ListCollectionsRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{53}
+}
+
+type ListCollectionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status      *Status       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Collections []*Collection `protobuf:"bytes,2,rep,name=collections,proto3" json:"collections,omitempty"`
+}
+
+func (x *ListCollectionsResponse) Reset() {
+	*x = ListCollectionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCollectionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCollectionsResponse) ProtoMessage() {}
+
+func (x *ListCollectionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCollectionsResponse.ProtoReflect.Descriptor instead.
+func (*ListCollectionsResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListCollectionsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ListCollectionsResponse) GetCollections() []*Collection {
+	if x != nil {
+		return x.Collections
+	}
+	return nil
+}
+
+type ApiKeyUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index              int32  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	MaskedApiKey       string `protobuf:"bytes,2,opt,name=maskedApiKey,proto3" json:"maskedApiKey,omitempty"`
+	UsedUnits          int64  `protobuf:"varint,3,opt,name=usedUnits,proto3" json:"usedUnits,omitempty"`
+	QuotaUnits         int64  `protobuf:"varint,4,opt,name=quotaUnits,proto3" json:"quotaUnits,omitempty"`
+	Requests           int64  `protobuf:"varint,5,opt,name=requests,proto3" json:"requests,omitempty"`
+	QuotaExceededCount int64  `protobuf:"varint,6,opt,name=quotaExceededCount,proto3" json:"quotaExceededCount,omitempty"`
+	Exhausted          bool   `protobuf:"varint,7,opt,name=exhausted,proto3" json:"exhausted,omitempty"`
+	Current            bool   `protobuf:"varint,8,opt,name=current,proto3" json:"current,omitempty"`
+	LastError          string `protobuf:"bytes,9,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	ResetAt            string `protobuf:"bytes,10,opt,name=resetAt,proto3" json:"resetAt,omitempty"`
+}
+
+func (x *ApiKeyUsage) Reset() {
+	*x = ApiKeyUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiKeyUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiKeyUsage) ProtoMessage() {}
+
+func (x *ApiKeyUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiKeyUsage.ProtoReflect.Descriptor instead.
+func (*ApiKeyUsage) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ApiKeyUsage) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetMaskedApiKey() string {
+	if x != nil {
+		return x.MaskedApiKey
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetUsedUnits() int64 {
+	if x != nil {
+		return x.UsedUnits
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetQuotaUnits() int64 {
+	if x != nil {
+		return x.QuotaUnits
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetRequests() int64 {
+	if x != nil {
+		return x.Requests
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetQuotaExceededCount() int64 {
+	if x != nil {
+		return x.QuotaExceededCount
+	}
+	return 0
+}
+
+func (x *ApiKeyUsage) GetExhausted() bool {
+	if x != nil {
+		return x.Exhausted
+	}
+	return false
+}
+
+func (x *ApiKeyUsage) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+func (x *ApiKeyUsage) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *ApiKeyUsage) GetResetAt() string {
+	if x != nil {
+		return x.ResetAt
+	}
+	return ""
+}
+
+type GetApiKeyUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetApiKeyUsageRequest) Reset() {
+	*x = GetApiKeyUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApiKeyUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiKeyUsageRequest) ProtoMessage() {}
+
+func (x *GetApiKeyUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiKeyUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetApiKeyUsageRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{56}
+}
+
+type GetApiKeyUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status       *Status        `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	ApiKeyUsages []*ApiKeyUsage `protobuf:"bytes,2,rep,name=apiKeyUsages,proto3" json:"apiKeyUsages,omitempty"`
+}
+
+func (x *GetApiKeyUsageResponse) Reset() {
+	*x = GetApiKeyUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetApiKeyUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetApiKeyUsageResponse) ProtoMessage() {}
+
+func (x *GetApiKeyUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetApiKeyUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetApiKeyUsageResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetApiKeyUsageResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetApiKeyUsageResponse) GetApiKeyUsages() []*ApiKeyUsage {
+	if x != nil {
+		return x.ApiKeyUsages
+	}
+	return nil
+}
+
+type WatchChannelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId string `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// trueの場合は監視を停止する
+	Unwatch bool `protobuf:"varint,2,opt,name=unwatch,proto3" json:"unwatch,omitempty"`
+	// 配信中のライブチャットの収集に使うbackend
+	Backend ActiveLiveChatBackend `protobuf:"varint,3,opt,name=backend,proto3,enum=ActiveLiveChatBackend" json:"backend,omitempty"`
+}
+
+func (x *WatchChannelRequest) Reset() {
+	*x = WatchChannelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchChannelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChannelRequest) ProtoMessage() {}
+
+func (x *WatchChannelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChannelRequest.ProtoReflect.Descriptor instead.
+func (*WatchChannelRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *WatchChannelRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *WatchChannelRequest) GetUnwatch() bool {
+	if x != nil {
+		return x.Unwatch
+	}
+	return false
+}
+
+func (x *WatchChannelRequest) GetBackend() ActiveLiveChatBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return ActiveLiveChatBackend_DEFAULT_BACKEND
+}
+
+type WatchedVideo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId            string            `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	Title              string            `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	ScheduledStartTime string            `protobuf:"bytes,3,opt,name=scheduledStartTime,proto3" json:"scheduledStartTime,omitempty"`
+	State              WatchedVideoState `protobuf:"varint,4,opt,name=state,proto3,enum=WatchedVideoState" json:"state,omitempty"`
+}
+
+func (x *WatchedVideo) Reset() {
+	*x = WatchedVideo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedVideo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedVideo) ProtoMessage() {}
+
+func (x *WatchedVideo) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedVideo.ProtoReflect.Descriptor instead.
+func (*WatchedVideo) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *WatchedVideo) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *WatchedVideo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *WatchedVideo) GetScheduledStartTime() string {
+	if x != nil {
+		return x.ScheduledStartTime
+	}
+	return ""
+}
+
+func (x *WatchedVideo) GetState() WatchedVideoState {
+	if x != nil {
+		return x.State
+	}
+	return WatchedVideoState_UPCOMING_VIDEO
+}
+
+type WatchedChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChannelId        string                `protobuf:"bytes,1,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Backend          ActiveLiveChatBackend `protobuf:"varint,2,opt,name=backend,proto3,enum=ActiveLiveChatBackend" json:"backend,omitempty"`
+	LastDiscoveredAt string                `protobuf:"bytes,3,opt,name=lastDiscoveredAt,proto3" json:"lastDiscoveredAt,omitempty"`
+	LastError        string                `protobuf:"bytes,4,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	WatchedVideos    []*WatchedVideo       `protobuf:"bytes,5,rep,name=watchedVideos,proto3" json:"watchedVideos,omitempty"`
+}
+
+func (x *WatchedChannel) Reset() {
+	*x = WatchedChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchedChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchedChannel) ProtoMessage() {}
+
+func (x *WatchedChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchedChannel.ProtoReflect.Descriptor instead.
+func (*WatchedChannel) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *WatchedChannel) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *WatchedChannel) GetBackend() ActiveLiveChatBackend {
+	if x != nil {
+		return x.Backend
+	}
+	return ActiveLiveChatBackend_DEFAULT_BACKEND
+}
+
+func (x *WatchedChannel) GetLastDiscoveredAt() string {
+	if x != nil {
+		return x.LastDiscoveredAt
+	}
+	return ""
+}
+
+func (x *WatchedChannel) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+func (x *WatchedChannel) GetWatchedVideos() []*WatchedVideo {
+	if x != nil {
+		return x.WatchedVideos
+	}
+	return nil
+}
+
+type WatchChannelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         *Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	WatchedChannel *WatchedChannel `protobuf:"bytes,2,opt,name=watchedChannel,proto3" json:"watchedChannel,omitempty"`
+}
+
+func (x *WatchChannelResponse) Reset() {
+	*x = WatchChannelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchChannelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchChannelResponse) ProtoMessage() {}
+
+func (x *WatchChannelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchChannelResponse.ProtoReflect.Descriptor instead.
+func (*WatchChannelResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *WatchChannelResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *WatchChannelResponse) GetWatchedChannel() *WatchedChannel {
+	if x != nil {
+		return x.WatchedChannel
+	}
+	return nil
+}
+
+type PinVideoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// trueの場合は削除の対象外を解除する
+	Unpin bool `protobuf:"varint,2,opt,name=unpin,proto3" json:"unpin,omitempty"`
+}
+
+func (x *PinVideoRequest) Reset() {
+	*x = PinVideoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinVideoRequest) ProtoMessage() {}
+
+func (x *PinVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinVideoRequest.ProtoReflect.Descriptor instead.
+func (*PinVideoRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *PinVideoRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *PinVideoRequest) GetUnpin() bool {
+	if x != nil {
+		return x.Unpin
+	}
+	return false
+}
+
+type PinVideoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         *Status  `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PinnedVideoIds []string `protobuf:"bytes,2,rep,name=pinnedVideoIds,proto3" json:"pinnedVideoIds,omitempty"`
+}
+
+func (x *PinVideoResponse) Reset() {
+	*x = PinVideoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PinVideoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PinVideoResponse) ProtoMessage() {}
+
+func (x *PinVideoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PinVideoResponse.ProtoReflect.Descriptor instead.
+func (*PinVideoResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *PinVideoResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *PinVideoResponse) GetPinnedVideoIds() []string {
+	if x != nil {
+		return x.PinnedVideoIds
+	}
+	return nil
+}
+
+type CleanerDeletion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Table     string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	VideoId   string `protobuf:"bytes,2,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	ChannelId string `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	// 秒数
+	Retention    int64 `protobuf:"varint,4,opt,name=retention,proto3" json:"retention,omitempty"`
+	RowsAffected int64 `protobuf:"varint,5,opt,name=rowsAffected,proto3" json:"rowsAffected,omitempty"`
+}
+
+func (x *CleanerDeletion) Reset() {
+	*x = CleanerDeletion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanerDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanerDeletion) ProtoMessage() {}
+
+func (x *CleanerDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanerDeletion.ProtoReflect.Descriptor instead.
+func (*CleanerDeletion) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CleanerDeletion) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *CleanerDeletion) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *CleanerDeletion) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *CleanerDeletion) GetRetention() int64 {
+	if x != nil {
+		return x.Retention
+	}
+	return 0
+}
+
+func (x *CleanerDeletion) GetRowsAffected() int64 {
+	if x != nil {
+		return x.RowsAffected
+	}
+	return 0
+}
+
+type CleanerReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartedAt         string             `protobuf:"bytes,1,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	FinishedAt        string             `protobuf:"bytes,2,opt,name=finishedAt,proto3" json:"finishedAt,omitempty"`
+	TotalRowsAffected int64              `protobuf:"varint,3,opt,name=totalRowsAffected,proto3" json:"totalRowsAffected,omitempty"`
+	CleanerDeletions  []*CleanerDeletion `protobuf:"bytes,4,rep,name=cleanerDeletions,proto3" json:"cleanerDeletions,omitempty"`
+	LastError         string             `protobuf:"bytes,5,opt,name=lastError,proto3" json:"lastError,omitempty"`
+}
+
+func (x *CleanerReport) Reset() {
+	*x = CleanerReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CleanerReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CleanerReport) ProtoMessage() {}
+
+func (x *CleanerReport) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CleanerReport.ProtoReflect.Descriptor instead.
+func (*CleanerReport) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CleanerReport) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *CleanerReport) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+func (x *CleanerReport) GetTotalRowsAffected() int64 {
+	if x != nil {
+		return x.TotalRowsAffected
+	}
+	return 0
+}
+
+func (x *CleanerReport) GetCleanerDeletions() []*CleanerDeletion {
+	if x != nil {
+		return x.CleanerDeletions
+	}
+	return nil
+}
+
+func (x *CleanerReport) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+type GetCleanerReportsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetCleanerReportsRequest) Reset() {
+	*x = GetCleanerReportsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCleanerReportsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCleanerReportsRequest) ProtoMessage() {}
+
+func (x *GetCleanerReportsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCleanerReportsRequest.ProtoReflect.Descriptor instead.
+func (*GetCleanerReportsRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{66}
+}
+
+type GetCleanerReportsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         *Status          `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CleanerReports []*CleanerReport `protobuf:"bytes,2,rep,name=cleanerReports,proto3" json:"cleanerReports,omitempty"`
+}
+
+func (x *GetCleanerReportsResponse) Reset() {
+	*x = GetCleanerReportsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetCleanerReportsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCleanerReportsResponse) ProtoMessage() {}
+
+func (x *GetCleanerReportsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCleanerReportsResponse.ProtoReflect.Descriptor instead.
+func (*GetCleanerReportsResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GetCleanerReportsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetCleanerReportsResponse) GetCleanerReports() []*CleanerReport {
+	if x != nil {
+		return x.CleanerReports
+	}
+	return nil
+}
+
+type SearchLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 空白区切りの検索語 (すべてを含むメッセージを返す)
+	// 空の場合は条件に一致するメッセージを新しい順に返す
+	Query     string            `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Source    ChatMessageSource `protobuf:"varint,2,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+	ChannelId string            `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	VideoId   string            `protobuf:"bytes,4,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// 投稿者のチャンネルIDか表示名
+	Author string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	// 投稿時刻の範囲 (unix時間の秒数, 0の場合は制限しない)
+	Since int64 `protobuf:"varint,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,7,opt,name=until,proto3" json:"until,omitempty"`
+	// trueの場合はスーパーチャットなどの有料メッセージだけを返す
+	PaidOnly bool   `protobuf:"varint,8,opt,name=paidOnly,proto3" json:"paidOnly,omitempty"`
+	Target   Target `protobuf:"varint,9,opt,name=target,proto3,enum=Target" json:"target,omitempty"`
+	Offset   int64  `protobuf:"varint,10,opt,name=offset,proto3" json:"offset,omitempty"`
+	Count    int64  `protobuf:"varint,11,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SearchLiveChatRequest) Reset() {
+	*x = SearchLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLiveChatRequest) ProtoMessage() {}
+
+func (x *SearchLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*SearchLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SearchLiveChatRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchLiveChatRequest) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+func (x *SearchLiveChatRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SearchLiveChatRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *SearchLiveChatRequest) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *SearchLiveChatRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *SearchLiveChatRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *SearchLiveChatRequest) GetPaidOnly() bool {
+	if x != nil {
+		return x.PaidOnly
+	}
+	return false
+}
+
+func (x *SearchLiveChatRequest) GetTarget() Target {
+	if x != nil {
+		return x.Target
+	}
+	return Target_ALL_USER
+}
+
+func (x *SearchLiveChatRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchLiveChatRequest) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type SearchLiveChatResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source                ChatMessageSource `protobuf:"varint,1,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+	MessageId             string            `protobuf:"bytes,2,opt,name=messageId,proto3" json:"messageId,omitempty"`
+	VideoId               string            `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	ChannelId             string            `protobuf:"bytes,4,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	AuthorChannelId       string            `protobuf:"bytes,5,opt,name=authorChannelId,proto3" json:"authorChannelId,omitempty"`
+	AuthorDisplayName     string            `protobuf:"bytes,6,opt,name=authorDisplayName,proto3" json:"authorDisplayName,omitempty"`
+	AuthorIsChatModerator bool              `protobuf:"varint,7,opt,name=authorIsChatModerator,proto3" json:"authorIsChatModerator,omitempty"`
+	AuthorIsChatOwner     bool              `protobuf:"varint,8,opt,name=authorIsChatOwner,proto3" json:"authorIsChatOwner,omitempty"`
+	AuthorIsChatSponsor   bool              `protobuf:"varint,9,opt,name=authorIsChatSponsor,proto3" json:"authorIsChatSponsor,omitempty"`
+	Message               string            `protobuf:"bytes,10,opt,name=message,proto3" json:"message,omitempty"`
+	// 検索語を<b></b>で囲んだメッセージの抜粋 (メッセージはHTMLエスケープされる)
+	Snippet string `protobuf:"bytes,11,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// 大きいほど関連度が高い
+	Rank        float64 `protobuf:"fixed64,12,opt,name=rank,proto3" json:"rank,omitempty"`
+	PublishedAt string  `protobuf:"bytes,13,opt,name=publishedAt,proto3" json:"publishedAt,omitempty"`
+	// アーカイブのライブチャットの場合の動画の再生位置 (ミリ秒)
+	VideoOffsetTimeMsec int64  `protobuf:"varint,14,opt,name=videoOffsetTimeMsec,proto3" json:"videoOffsetTimeMsec,omitempty"`
+	IsPaid              bool   `protobuf:"varint,15,opt,name=isPaid,proto3" json:"isPaid,omitempty"`
+	AmountDisplayString string `protobuf:"bytes,16,opt,name=amountDisplayString,proto3" json:"amountDisplayString,omitempty"`
+}
+
+func (x *SearchLiveChatResult) Reset() {
+	*x = SearchLiveChatResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchLiveChatResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLiveChatResult) ProtoMessage() {}
+
+func (x *SearchLiveChatResult) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLiveChatResult.ProtoReflect.Descriptor instead.
+func (*SearchLiveChatResult) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SearchLiveChatResult) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+func (x *SearchLiveChatResult) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetAuthorChannelId() string {
+	if x != nil {
+		return x.AuthorChannelId
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetAuthorDisplayName() string {
+	if x != nil {
+		return x.AuthorDisplayName
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetAuthorIsChatModerator() bool {
+	if x != nil {
+		return x.AuthorIsChatModerator
+	}
+	return false
+}
+
+func (x *SearchLiveChatResult) GetAuthorIsChatOwner() bool {
+	if x != nil {
+		return x.AuthorIsChatOwner
+	}
+	return false
+}
+
+func (x *SearchLiveChatResult) GetAuthorIsChatSponsor() bool {
+	if x != nil {
+		return x.AuthorIsChatSponsor
+	}
+	return false
+}
+
+func (x *SearchLiveChatResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetRank() float64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *SearchLiveChatResult) GetPublishedAt() string {
+	if x != nil {
+		return x.PublishedAt
+	}
+	return ""
+}
+
+func (x *SearchLiveChatResult) GetVideoOffsetTimeMsec() int64 {
+	if x != nil {
+		return x.VideoOffsetTimeMsec
+	}
+	return 0
+}
+
+func (x *SearchLiveChatResult) GetIsPaid() bool {
+	if x != nil {
+		return x.IsPaid
+	}
+	return false
+}
+
+func (x *SearchLiveChatResult) GetAmountDisplayString() string {
+	if x != nil {
+		return x.AmountDisplayString
+	}
+	return ""
+}
+
+type SearchLiveChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status  *Status                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results []*SearchLiveChatResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (x *SearchLiveChatResponse) Reset() {
+	*x = SearchLiveChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchLiveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchLiveChatResponse) ProtoMessage() {}
+
+func (x *SearchLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*SearchLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SearchLiveChatResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *SearchLiveChatResponse) GetResults() []*SearchLiveChatResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source ChatMessageSource `protobuf:"varint,1,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+	// videoIdかchannelIdのどちらかを指定する
+	VideoId   string       `protobuf:"bytes,2,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	ChannelId string       `protobuf:"bytes,3,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Format    ExportFormat `protobuf:"varint,4,opt,name=format,proto3,enum=ExportFormat" json:"format,omitempty"`
+	// 出力するフィールド (ActiveLiveChatMessageかArchiveLiveChatMessageのフィールド名)
+	// 空の場合はすべてのフィールドを出力する
+	Fields []string `protobuf:"bytes,5,rep,name=fields,proto3" json:"fields,omitempty"`
+	// 投稿時刻の範囲 (unix時間の秒数, 0の場合は制限しない)
+	Since int64 `protobuf:"varint,6,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,7,opt,name=until,proto3" json:"until,omitempty"`
+	// chunkごとのメッセージ数
+	ChunkSize int64 `protobuf:"varint,8,opt,name=chunkSize,proto3" json:"chunkSize,omitempty"`
+}
+
+func (x *ExportLiveChatRequest) Reset() {
+	*x = ExportLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLiveChatRequest) ProtoMessage() {}
+
+func (x *ExportLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*ExportLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ExportLiveChatRequest) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+func (x *ExportLiveChatRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ExportLiveChatRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ExportLiveChatRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_JSONL_EXPORT_FORMAT
+}
+
+func (x *ExportLiveChatRequest) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ExportLiveChatRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ExportLiveChatRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ExportLiveChatRequest) GetChunkSize() int64 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+type ExportLiveChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// 最初のレスポンスだけに含まれる出力対象の動画
+	Videos []*Video     `protobuf:"bytes,2,rep,name=videos,proto3" json:"videos,omitempty"`
+	Format ExportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=ExportFormat" json:"format,omitempty"`
+	// 出力されるフィールドの順序
+	Fields []string `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// 0から始まるchunkの番号
+	Sequence int64  `protobuf:"varint,5,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	Chunk    []byte `protobuf:"bytes,6,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	// chunkに含まれるメッセージ数
+	Count int64 `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	// trueの場合は最後のchunk
+	Eof bool `protobuf:"varint,8,opt,name=eof,proto3" json:"eof,omitempty"`
+}
+
+func (x *ExportLiveChatResponse) Reset() {
+	*x = ExportLiveChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportLiveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportLiveChatResponse) ProtoMessage() {}
+
+func (x *ExportLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*ExportLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ExportLiveChatResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ExportLiveChatResponse) GetVideos() []*Video {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ExportLiveChatResponse) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_JSONL_EXPORT_FORMAT
+}
+
+func (x *ExportLiveChatResponse) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ExportLiveChatResponse) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *ExportLiveChatResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *ExportLiveChatResponse) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ExportLiveChatResponse) GetEof() bool {
+	if x != nil {
+		return x.Eof
+	}
+	return false
+}
+
+type ImportArchiveLiveChatRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// videoId, channelId, formatは最初のリクエストだけ使われる
+	VideoId string `protobuf:"bytes,1,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// 動画が保存されておらずyoutubeからも取得できない場合に使われる
+	ChannelId string       `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	Format    ImportFormat `protobuf:"varint,3,opt,name=format,proto3,enum=ImportFormat" json:"format,omitempty"`
+	Chunk     []byte       `protobuf:"bytes,4,opt,name=chunk,proto3" json:"chunk,omitempty"`
+}
+
+func (x *ImportArchiveLiveChatRequest) Reset() {
+	*x = ImportArchiveLiveChatRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportArchiveLiveChatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArchiveLiveChatRequest) ProtoMessage() {}
+
+func (x *ImportArchiveLiveChatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArchiveLiveChatRequest.ProtoReflect.Descriptor instead.
+func (*ImportArchiveLiveChatRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ImportArchiveLiveChatRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *ImportArchiveLiveChatRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *ImportArchiveLiveChatRequest) GetFormat() ImportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ImportFormat_YT_DLP_IMPORT_FORMAT
+}
+
+func (x *ImportArchiveLiveChatRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type ImportArchiveLiveChatResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Video  *Video  `protobuf:"bytes,2,opt,name=video,proto3" json:"video,omitempty"`
+	// 取り込んだメッセージ数
+	Imported int64 `protobuf:"varint,3,opt,name=imported,proto3" json:"imported,omitempty"`
+	// チャット以外などで取り込まなかったメッセージ数
+	Skipped int64 `protobuf:"varint,4,opt,name=skipped,proto3" json:"skipped,omitempty"`
+}
+
+func (x *ImportArchiveLiveChatResponse) Reset() {
+	*x = ImportArchiveLiveChatResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ImportArchiveLiveChatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportArchiveLiveChatResponse) ProtoMessage() {}
+
+func (x *ImportArchiveLiveChatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportArchiveLiveChatResponse.ProtoReflect.Descriptor instead.
+func (*ImportArchiveLiveChatResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ImportArchiveLiveChatResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ImportArchiveLiveChatResponse) GetVideo() *Video {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *ImportArchiveLiveChatResponse) GetImported() int64 {
+	if x != nil {
+		return x.Imported
+	}
+	return 0
+}
+
+func (x *ImportArchiveLiveChatResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+type GetChatStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source ChatMessageSource `protobuf:"varint,1,opt,name=source,proto3,enum=ChatMessageSource" json:"source,omitempty"`
+	// videoIdかchannelIdのどちらかが必要 (両方の場合は両方に一致するメッセージ)
+	ChannelId string `protobuf:"bytes,2,opt,name=channelId,proto3" json:"channelId,omitempty"`
+	VideoId   string `protobuf:"bytes,3,opt,name=videoId,proto3" json:"videoId,omitempty"`
+	// 投稿時刻の範囲 (unix時間の秒数, 0の場合は制限しない)
+	Since int64 `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until int64 `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+	// topAuthorsの最大件数 (0の場合は10)
+	TopAuthorsCount int64 `protobuf:"varint,6,opt,name=topAuthorsCount,proto3" json:"topAuthorsCount,omitempty"`
+}
+
+func (x *GetChatStatisticsRequest) Reset() {
+	*x = GetChatStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatStatisticsRequest) ProtoMessage() {}
+
+func (x *GetChatStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetChatStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetChatStatisticsRequest) GetSource() ChatMessageSource {
+	if x != nil {
+		return x.Source
+	}
+	return ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+func (x *GetChatStatisticsRequest) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *GetChatStatisticsRequest) GetVideoId() string {
+	if x != nil {
+		return x.VideoId
+	}
+	return ""
+}
+
+func (x *GetChatStatisticsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetChatStatisticsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *GetChatStatisticsRequest) GetTopAuthorsCount() int64 {
+	if x != nil {
+		return x.TopAuthorsCount
+	}
+	return 0
+}
+
+type MessagesPerMinute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 1分間の開始時刻 (unix時間の秒数)
+	Minute int64 `protobuf:"varint,1,opt,name=minute,proto3" json:"minute,omitempty"`
+	Count  int64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *MessagesPerMinute) Reset() {
+	*x = MessagesPerMinute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MessagesPerMinute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessagesPerMinute) ProtoMessage() {}
+
+func (x *MessagesPerMinute) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessagesPerMinute.ProtoReflect.Descriptor instead.
+func (*MessagesPerMinute) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *MessagesPerMinute) GetMinute() int64 {
+	if x != nil {
+		return x.Minute
+	}
+	return 0
+}
+
+func (x *MessagesPerMinute) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AuthorStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthorChannelId   string `protobuf:"bytes,1,opt,name=authorChannelId,proto3" json:"authorChannelId,omitempty"`
+	AuthorDisplayName string `protobuf:"bytes,2,opt,name=authorDisplayName,proto3" json:"authorDisplayName,omitempty"`
+	MessageCount      int64  `protobuf:"varint,3,opt,name=messageCount,proto3" json:"messageCount,omitempty"`
+	// スーパーチャットなどの有料メッセージ数
+	PaidMessageCount int64 `protobuf:"varint,4,opt,name=paidMessageCount,proto3" json:"paidMessageCount,omitempty"`
+}
+
+func (x *AuthorStatistics) Reset() {
+	*x = AuthorStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorStatistics) ProtoMessage() {}
+
+func (x *AuthorStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorStatistics.ProtoReflect.Descriptor instead.
+func (*AuthorStatistics) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *AuthorStatistics) GetAuthorChannelId() string {
+	if x != nil {
+		return x.AuthorChannelId
+	}
+	return ""
+}
+
+func (x *AuthorStatistics) GetAuthorDisplayName() string {
+	if x != nil {
+		return x.AuthorDisplayName
+	}
+	return ""
+}
+
+func (x *AuthorStatistics) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *AuthorStatistics) GetPaidMessageCount() int64 {
+	if x != nil {
+		return x.PaidMessageCount
+	}
+	return 0
+}
+
+type SuperChatTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 通貨コード (アーカイブで通貨コードが分からない場合は通貨記号)
+	Currency     string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	AmountMicros int64  `protobuf:"varint,2,opt,name=amountMicros,proto3" json:"amountMicros,omitempty"`
+	Count        int64  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *SuperChatTotal) Reset() {
+	*x = SuperChatTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SuperChatTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuperChatTotal) ProtoMessage() {}
+
+func (x *SuperChatTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuperChatTotal.ProtoReflect.Descriptor instead.
+func (*SuperChatTotal) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *SuperChatTotal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SuperChatTotal) GetAmountMicros() int64 {
+	if x != nil {
+		return x.AmountMicros
+	}
+	return 0
+}
+
+func (x *SuperChatTotal) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ChatStatistics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 削除やBANなどのイベントを除いたメッセージ数
+	MessageCount   int64 `protobuf:"varint,1,opt,name=messageCount,proto3" json:"messageCount,omitempty"`
+	UniqueChatters int64 `protobuf:"varint,2,opt,name=uniqueChatters,proto3" json:"uniqueChatters,omitempty"`
+	// 範囲内の最初のメッセージより前にチャンネルで投稿したことがない投稿者数
+	NewChatters int64 `protobuf:"varint,3,opt,name=newChatters,proto3" json:"newChatters,omitempty"`
+	// 範囲内の最初のメッセージより前にチャンネルで投稿したことがある投稿者数
+	ReturningChatters int64 `protobuf:"varint,4,opt,name=returningChatters,proto3" json:"returningChatters,omitempty"`
+	// メンバーとモデレーターのメッセージ数とmessageCountに対する割合
+	MemberMessageCount    int64   `protobuf:"varint,5,opt,name=memberMessageCount,proto3" json:"memberMessageCount,omitempty"`
+	MemberMessageShare    float64 `protobuf:"fixed64,6,opt,name=memberMessageShare,proto3" json:"memberMessageShare,omitempty"`
+	ModeratorMessageCount int64   `protobuf:"varint,7,opt,name=moderatorMessageCount,proto3" json:"moderatorMessageCount,omitempty"`
+	ModeratorMessageShare float64 `protobuf:"fixed64,8,opt,name=moderatorMessageShare,proto3" json:"moderatorMessageShare,omitempty"`
+	// メッセージがある分だけ時刻順
+	MessagesPerMinute []*MessagesPerMinute `protobuf:"bytes,9,rep,name=messagesPerMinute,proto3" json:"messagesPerMinute,omitempty"`
+	// メッセージ数の多い順
+	TopAuthors []*AuthorStatistics `protobuf:"bytes,10,rep,name=topAuthors,proto3" json:"topAuthors,omitempty"`
+	// 通貨ごとの合計
+	SuperChatTotals []*SuperChatTotal `protobuf:"bytes,11,rep,name=superChatTotals,proto3" json:"superChatTotals,omitempty"`
+}
+
+func (x *ChatStatistics) Reset() {
+	*x = ChatStatistics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChatStatistics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatStatistics) ProtoMessage() {}
+
+func (x *ChatStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatStatistics.ProtoReflect.Descriptor instead.
+func (*ChatStatistics) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ChatStatistics) GetMessageCount() int64 {
+	if x != nil {
+		return x.MessageCount
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetUniqueChatters() int64 {
+	if x != nil {
+		return x.UniqueChatters
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetNewChatters() int64 {
+	if x != nil {
+		return x.NewChatters
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetReturningChatters() int64 {
+	if x != nil {
+		return x.ReturningChatters
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetMemberMessageCount() int64 {
+	if x != nil {
+		return x.MemberMessageCount
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetMemberMessageShare() float64 {
+	if x != nil {
+		return x.MemberMessageShare
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetModeratorMessageCount() int64 {
+	if x != nil {
+		return x.ModeratorMessageCount
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetModeratorMessageShare() float64 {
+	if x != nil {
+		return x.ModeratorMessageShare
+	}
+	return 0
+}
+
+func (x *ChatStatistics) GetMessagesPerMinute() []*MessagesPerMinute {
+	if x != nil {
+		return x.MessagesPerMinute
+	}
+	return nil
+}
+
+func (x *ChatStatistics) GetTopAuthors() []*AuthorStatistics {
+	if x != nil {
+		return x.TopAuthors
+	}
+	return nil
+}
+
+func (x *ChatStatistics) GetSuperChatTotals() []*SuperChatTotal {
+	if x != nil {
+		return x.SuperChatTotals
+	}
+	return nil
+}
+
+type GetChatStatisticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     *Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Statistics *ChatStatistics `protobuf:"bytes,2,opt,name=statistics,proto3" json:"statistics,omitempty"`
+}
+
+func (x *GetChatStatisticsResponse) Reset() {
+	*x = GetChatStatisticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protocol_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChatStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChatStatisticsResponse) ProtoMessage() {}
+
+func (x *GetChatStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protocol_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChatStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetChatStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_protocol_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetChatStatisticsResponse) GetStatus() *Status {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *GetChatStatisticsResponse) GetStatistics() *ChatStatistics {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+var File_protocol_proto protoreflect.FileDescriptor
+
+var file_protocol_proto_rawDesc = []byte{
+	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x3d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x19, 0x0a, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x05, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x2b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x51, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22,
+	0xc6, 0x01, 0x0a, 0x24, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x47, 0x72,
+	0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x13, 0x61, 0x75, 0x74, 0x6f, 0x53, 0x74, 0x6f, 0x70, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x62,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x61, 0x69, 0x74, 0x46, 0x6f,
+	0x72, 0x4c, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x61, 0x69,
+	0x74, 0x46, 0x6f, 0x72, 0x4c, 0x69, 0x76, 0x65, 0x22, 0x66, 0x0a, 0x25, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x22, 0x35, 0x0a, 0x19, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0xf3, 0x01, 0x0a, 0x1e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c,
+	0x61, 0x73, 0x73, 0x52, 0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0a, 0x72, 0x65, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x65, 0x74, 0x72, 0x79, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xda, 0x01,
+	0x0a, 0x1a, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a,
+	0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x4b, 0x0a,
+	0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xca, 0x01, 0x0a, 0x1e, 0x47,
+	0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74,
+	0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69,
+	0x74, 0x68, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc8, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x16,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x22, 0x65, 0x0a, 0x1b, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x90, 0x02, 0x0a, 0x1c, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x4e, 0x0a, 0x16, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x16, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x62,
+	0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12,
+	0x4b, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3f, 0x0a, 0x23,
+	0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0x47, 0x0a,
+	0x24, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5b, 0x0a, 0x25, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x22, 0x67, 0x0a, 0x26, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e,
+	0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0xc5, 0x01, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x69, 0x74, 0x68, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x69, 0x74, 0x68, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x22, 0xc6, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x51, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x17,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x40, 0x0a,
+	0x24, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22,
+	0x48, 0x0a, 0x25, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x3e, 0x0a, 0x22, 0x50, 0x6f, 0x6c,
+	0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x22, 0xd9, 0x01, 0x0a, 0x17, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x50, 0x72, 0x6f,
+	0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x4d, 0x73, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x12,
+	0x22, 0x0a, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x65, 0x63, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x7c, 0x0a, 0x23, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x18, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x78, 0x0a, 0x1c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x28, 0x0a,
+	0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x65, 0x63,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0x9b, 0x02,
+	0x0a, 0x1d, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x30, 0x0a, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x17, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x17, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x23,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x64, 0x12,
+	0x28, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f,
+	0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22,
+	0x47, 0x0a, 0x24, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x97, 0x04, 0x0a, 0x05, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2a, 0x0a, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x49, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f,
+	0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x61, 0x63, 0x74, 0x75, 0x61, 0x6c,
+	0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x63, 0x74, 0x75, 0x61, 0x6c, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x12,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10,
+	0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x69, 0x76,
+	0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22,
+	0x0a, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0xb5, 0x0b, 0x0a, 0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x69, 0x45, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x70, 0x69, 0x45, 0x74, 0x61, 0x67, 0x12, 0x28, 0x0a, 0x0f,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x55,
+	0x72, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x12, 0x34, 0x0a, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x15, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73,
+	0x43, 0x68, 0x61, 0x74, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x53,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x49, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69,
+	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x49, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x70,
+	0x6c, 0x61, 0x79, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x53, 0x74, 0x69, 0x63, 0x6b, 0x65, 0x72,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x53, 0x75, 0x70, 0x65, 0x72, 0x53,
+	0x74, 0x69, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x73, 0x46, 0x61, 0x6e, 0x46,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x69, 0x73, 0x46, 0x61, 0x6e, 0x46, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x12, 0x32, 0x0a, 0x14, 0x67, 0x69, 0x66, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x1a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x67, 0x69, 0x66, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x67, 0x69,
+	0x66, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x1b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x67, 0x69, 0x66, 0x74, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x52, 0x0a, 0x24, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74,
+	0x65, 0x64, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x47, 0x69, 0x66, 0x74,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x24, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x47, 0x69, 0x66, 0x74, 0x69, 0x6e, 0x67, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1f, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x15, 0x62, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x61,
+	0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x20, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x61, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x62, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x21, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x12, 0x62, 0x61, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x73, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x61,
+	0x6e, 0x6e, 0x65, 0x64, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x42, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x22, 0xf7, 0x06, 0x0a, 0x16, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
+	0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x45, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x65, 0x78, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x65, 0x78, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x65, 0x78, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x73, 0x65, 0x63, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x55, 0x73, 0x65,
+	0x63, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x54, 0x65,
+	0x78, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x54, 0x65, 0x78, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x34, 0x0a, 0x15,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68,
+	0x61, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74,
+	0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x53, 0x70, 0x6f, 0x6e, 0x73,
+	0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x73, 0x18, 0x13,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x61, 0x64,
+	0x67, 0x65, 0x52, 0x0c, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x61, 0x64, 0x67, 0x65, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x54, 0x65, 0x78, 0x74,
+	0x12, 0x32, 0x0a, 0x14, 0x67, 0x69, 0x66, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x67, 0x69, 0x66, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x67, 0x0a, 0x0b, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x42, 0x61,
+	0x64, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x74, 0x6f, 0x6f, 0x6c, 0x74, 0x69, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x6f, 0x0a,
+	0x27, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x69,
+	0x0a, 0x28, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x3f, 0x0a, 0x05, 0x43, 0x6f, 0x6c,
+	0x6f, 0x72, 0x12, 0x0c, 0x0a, 0x01, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x72,
+	0x12, 0x0c, 0x0a, 0x01, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x67, 0x12, 0x0c,
+	0x0a, 0x01, 0x62, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x62, 0x12, 0x0c, 0x0a, 0x01,
+	0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x61, 0x22, 0xe4, 0x02, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x77, 0x69, 0x64, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x66, 0x6f, 0x6e, 0x74, 0x4d, 0x69, 0x6e, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x06, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x06, 0x63, 0x6f, 0x6c,
+	0x6f, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e,
+	0x64, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43,
+	0x6f, 0x6c, 0x6f, 0x72, 0x52, 0x0f, 0x62, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x22, 0x67, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69,
+	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x69,
+	0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3a, 0x0a, 0x0a, 0x56, 0x6f,
+	0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xe5, 0x01, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x25, 0x0a, 0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x07, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x4d, 0x73, 0x65, 0x63, 0x22, 0x69,
+	0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x56, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x05, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64,
+	0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x4f, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3d, 0x0a, 0x1a, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x4f, 0x0a, 0x09, 0x56, 0x6f, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68,
+	0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x72, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x06, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x56, 0x6f, 0x74,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x2a,
+	0x0a, 0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x74, 0x65, 0x49, 0x64, 0x22, 0x34, 0x0a, 0x11, 0x43, 0x6c,
+	0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xb7, 0x01, 0x0a, 0x1d, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x78, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x64, 0x78, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x15,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x15, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x0e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x22, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x29, 0x0a, 0x07,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x22, 0x84, 0x01, 0x0a, 0x23, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x22, 0x43,
+	0x0a, 0x21, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e,
+	0x67, 0x49, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x22, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x64, 0x0a, 0x1d, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x1d, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0xa1, 0x03, 0x0a, 0x0a, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x23, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12,
+	0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10,
+	0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73,
+	0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c,
+	0x61, 0x73, 0x74, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x22, 0x18, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x69, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x0b, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x63,
+	0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xc1, 0x02, 0x0a, 0x0b, 0x41,
+	0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x61, 0x73, 0x6b, 0x65, 0x64, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x6e, 0x69, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x2e,
+	0x0a, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x45, 0x78, 0x63, 0x65, 0x65, 0x64, 0x65, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x65, 0x78, 0x68, 0x61, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x73, 0x65, 0x74, 0x41, 0x74, 0x22, 0x17,
+	0x0a, 0x15, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x6b, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x70,
+	0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x30, 0x0a, 0x0c, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x41, 0x70, 0x69, 0x4b, 0x65,
+	0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x0c, 0x61, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x73, 0x22, 0x7f, 0x0a, 0x13, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x6e, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x75, 0x6e, 0x77, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x62, 0x61,
+	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x22, 0x98, 0x01, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x12, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56,
+	0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x22, 0xdf, 0x01, 0x0a, 0x0e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x30, 0x0a, 0x07, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x52, 0x07, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6c,
+	0x61, 0x73, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x33, 0x0a,
+	0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x0d, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x73, 0x22, 0x70, 0x0a, 0x14, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x37, 0x0a, 0x0e, 0x77,
+	0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x0e, 0x77, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x41, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x05, 0x75, 0x6e, 0x70, 0x69, 0x6e, 0x22, 0x5b, 0x0a, 0x10, 0x50, 0x69, 0x6e, 0x56, 0x69,
+	0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x56, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x0f, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x62, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x77, 0x73,
+	0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0xd7, 0x01, 0x0a, 0x0d, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x52, 0x6f, 0x77, 0x73, 0x41, 0x66, 0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x6f, 0x77, 0x73, 0x41, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x10, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x74,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x0e,
+	0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x0e, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x22, 0xc0, 0x02, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x69, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x70, 0x61, 0x69, 0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xec, 0x04, 0x0a, 0x14, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x12, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64,
+	0x65, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x30,
+	0x0a, 0x13, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x53, 0x70,
+	0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x49, 0x73, 0x43, 0x68, 0x61, 0x74, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6e,
+	0x69, 0x70, 0x70, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6e, 0x69,
+	0x70, 0x70, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x76, 0x69,
+	0x64, 0x65, 0x6f, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65,
+	0x63, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x73, 0x50, 0x61, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x50, 0x61, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x10, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x13, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x6a, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x73, 0x22, 0x84, 0x02, 0x0a, 0x15, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x43,
+	0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65,
+	0x6f, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64,
+	0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x63, 0x68, 0x75, 0x6e, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x22, 0xf2, 0x01, 0x0a, 0x16, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x06, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x06, 0x76,
+	0x69, 0x64, 0x65, 0x6f, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x65, 0x6f, 0x66, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x65, 0x6f, 0x66, 0x22, 0x93,
+	0x01, 0x0a, 0x1c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63,
+	0x68, 0x75, 0x6e, 0x6b, 0x22, 0x94, 0x01, 0x0a, 0x1d, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x05, 0x76, 0x69, 0x64, 0x65, 0x6f,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x05,
+	0x76, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x52, 0x06, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x69, 0x64, 0x65, 0x6f, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x69, 0x6e,
+	0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x70, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x41, 0x0a, 0x11, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x10, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69,
+	0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x44, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x69, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x70, 0x61, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x22, 0x66, 0x0a, 0x0e, 0x53, 0x75, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69, 0x63, 0x72, 0x6f, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x69,
+	0x63, 0x72, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa8, 0x04, 0x0a, 0x0e, 0x43,
+	0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75, 0x65, 0x43, 0x68, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x75, 0x6e, 0x69, 0x71, 0x75,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x77,
+	0x43, 0x68, 0x61, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x72,
+	0x65, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x74, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x43, 0x68, 0x61, 0x74, 0x74, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x15, 0x6d, 0x6f, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x15, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x34, 0x0a, 0x15, 0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x15,
+	0x6d, 0x6f, 0x64, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x40, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x50, 0x65, 0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x65, 0x72, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x52, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x50, 0x65,
+	0x72, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x6f, 0x70, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0a,
+	0x74, 0x6f, 0x70, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x73, 0x12, 0x39, 0x0a, 0x0f, 0x73, 0x75,
+	0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x0b, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x53, 0x75, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x52, 0x0f, 0x73, 0x75, 0x70, 0x65, 0x72, 0x43, 0x68, 0x61, 0x74, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0x6d, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2f, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x69, 0x73,
+	0x74, 0x69, 0x63, 0x73, 0x2a, 0x5a, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x0b, 0x0a, 0x07,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x54,
+	0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f,
+	0x50, 0x45, 0x52, 0x4d, 0x49, 0x54, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x12, 0x0a, 0x0e,
+	0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x04,
+	0x2a, 0x60, 0x0a, 0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x54, 0x52, 0x59, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06,
+	0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x53, 0x54, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47,
+	0x10, 0x05, 0x2a, 0x65, 0x0a, 0x0e, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x10, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4c,
+	0x49, 0x56, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x10,
+	0x02, 0x12, 0x08, 0x0a, 0x04, 0x56, 0x4f, 0x54, 0x45, 0x10, 0x03, 0x12, 0x0c, 0x0a, 0x08, 0x47,
+	0x52, 0x4f, 0x55, 0x50, 0x49, 0x4e, 0x47, 0x10, 0x04, 0x2a, 0x31, 0x0a, 0x0b, 0x52, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4c, 0x41, 0x59,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x42, 0x0a, 0x0d,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x09, 0x0a,
+	0x05, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x53, 0x55,
+	0x4d, 0x45, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x53, 0x45, 0x45, 0x4b, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x43, 0x48, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x03,
+	0x2a, 0x9e, 0x03, 0x0a, 0x17, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12,
+	0x54, 0x45, 0x58, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x53, 0x55, 0x50, 0x45, 0x52, 0x5f, 0x43, 0x48,
+	0x41, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x17, 0x0a, 0x13, 0x53, 0x55,
+	0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x49, 0x43, 0x4b, 0x45, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x46, 0x41, 0x4e, 0x5f, 0x46, 0x55, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x4e, 0x45,
+	0x57, 0x5f, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
+	0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x4d, 0x49, 0x4c, 0x45,
+	0x53, 0x54, 0x4f, 0x4e, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x10, 0x05, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53, 0x48, 0x49, 0x50,
+	0x5f, 0x47, 0x49, 0x46, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x06,
+	0x12, 0x22, 0x0a, 0x1e, 0x47, 0x49, 0x46, 0x54, 0x5f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52, 0x53,
+	0x48, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45,
+	0x4e, 0x54, 0x10, 0x07, 0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x08, 0x12,
+	0x1b, 0x0a, 0x17, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x41,
+	0x43, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x09, 0x12, 0x15, 0x0a, 0x11,
+	0x55, 0x53, 0x45, 0x52, 0x5f, 0x42, 0x41, 0x4e, 0x4e, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x10, 0x0a, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x48, 0x41, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45,
+	0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x0b, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x50, 0x4f,
+	0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10, 0x0c, 0x12, 0x21,
+	0x0a, 0x1d, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x5f, 0x4d,
+	0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x10,
+	0x0d, 0x2a, 0xa9, 0x01, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x10, 0x0a, 0x0c, 0x54, 0x45, 0x58, 0x54, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x41, 0x49, 0x44, 0x5f, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x41, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x49,
+	0x43, 0x4b, 0x45, 0x52, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x4d, 0x42, 0x45, 0x52,
+	0x53, 0x48, 0x49, 0x50, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x4f, 0x52, 0x53, 0x48, 0x49, 0x50, 0x53, 0x5f, 0x47, 0x49, 0x46, 0x54,
+	0x5f, 0x50, 0x55, 0x52, 0x43, 0x48, 0x41, 0x53, 0x45, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x53,
+	0x50, 0x4f, 0x4e, 0x53, 0x4f, 0x52, 0x53, 0x48, 0x49, 0x50, 0x53, 0x5f, 0x47, 0x49, 0x46, 0x54,
+	0x5f, 0x52, 0x45, 0x44, 0x45, 0x4d, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x05, 0x2a, 0x59, 0x0a,
+	0x15, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x45, 0x46, 0x41, 0x55, 0x4c,
+	0x54, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x44,
+	0x41, 0x54, 0x41, 0x5f, 0x41, 0x50, 0x49, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10,
+	0x01, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x54, 0x55, 0x42, 0x45, 0x5f, 0x42,
+	0x41, 0x43, 0x4b, 0x45, 0x4e, 0x44, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x17, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x48, 0x41,
+	0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x52,
+	0x43, 0x48, 0x49, 0x56, 0x45, 0x5f, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x48, 0x41, 0x54, 0x5f,
+	0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x01, 0x2a, 0x81, 0x01, 0x0a, 0x0a, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x4f, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x49, 0x45,
+	0x4e, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c, 0x49, 0x4d,
+	0x49, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x51, 0x55, 0x4f, 0x54, 0x41, 0x5f,
+	0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x48,
+	0x41, 0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x46, 0x4f,
+	0x52, 0x42, 0x49, 0x44, 0x44, 0x45, 0x4e, 0x10, 0x05, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x06, 0x2a, 0x48, 0x0a, 0x06,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x4c, 0x4c, 0x5f, 0x55, 0x53,
+	0x45, 0x52, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x4f, 0x52, 0x10,
+	0x01, 0x12, 0x13, 0x0a, 0x0f, 0x4f, 0x57, 0x4e, 0x45, 0x52, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52,
+	0x41, 0x54, 0x4f, 0x52, 0x10, 0x02, 0x2a, 0x5b, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x65,
+	0x64, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x55,
+	0x50, 0x43, 0x4f, 0x4d, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x4c, 0x49, 0x56, 0x45, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x01, 0x12,
+	0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x5f, 0x56, 0x49, 0x44, 0x45, 0x4f, 0x10, 0x02,
+	0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x5f, 0x56, 0x49, 0x44, 0x45,
+	0x4f, 0x10, 0x03, 0x2a, 0x59, 0x0a, 0x0c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x6d, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x13, 0x4a, 0x53, 0x4f, 0x4e, 0x4c, 0x5f, 0x45, 0x58, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11,
+	0x43, 0x53, 0x56, 0x5f, 0x45, 0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x41, 0x52, 0x51, 0x55, 0x45, 0x54, 0x5f, 0x45,
+	0x58, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x02, 0x2a, 0x4b,
+	0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x18,
+	0x0a, 0x14, 0x59, 0x54, 0x5f, 0x44, 0x4c, 0x50, 0x5f, 0x49, 0x4d, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x00, 0x12, 0x21, 0x0a, 0x1d, 0x43, 0x48, 0x41, 0x54,
+	0x5f, 0x44, 0x4f, 0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x45, 0x52, 0x5f, 0x49, 0x4d, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x10, 0x01, 0x32, 0xa7, 0x13, 0x0a, 0x04,
+	0x79, 0x6c, 0x63, 0x63, 0x12, 0x31, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f,
+	0x12, 0x10, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1d, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x25, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x12, 0x50, 0x6f, 0x6c,
+	0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12,
+	0x1a, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x50, 0x6f,
+	0x6c, 0x6c, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5e, 0x0a, 0x17,
+	0x47, 0x65, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1f, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x14,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x12, 0x1c, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x1c, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x24, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x1e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x26, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12,
+	0x1a, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x47, 0x65,
+	0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1d, 0x53, 0x74,
+	0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x25, 0x2e, 0x53, 0x74,
+	0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6c, 0x0a, 0x1b,
+	0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x2e, 0x50, 0x6f,
+	0x6c, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x24, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a, 0x0a, 0x15, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43,
+	0x68, 0x61, 0x74, 0x12, 0x1d, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x6d, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x24, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x20, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75,
+	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x28, 0x2e, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x3d, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x12, 0x14, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x6f, 0x72, 0x64,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x10, 0x2e, 0x4f, 0x70,
+	0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e,
+	0x4f, 0x70, 0x65, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x4f, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74, 0x65,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x6f, 0x74, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x47, 0x65,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f,
+	0x74, 0x65, 0x12, 0x11, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x56, 0x6f, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x1b, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x23, 0x2e, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x24, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x1a, 0x50, 0x6f, 0x6c, 0x6c, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x22, 0x2e, 0x50, 0x6f, 0x6c, 0x6c, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x50, 0x6f, 0x6c, 0x6c,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x69, 0x6e, 0x67, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4c, 0x69,
+	0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x30, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x12, 0x14, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x31, 0x0a, 0x08, 0x50, 0x69, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x12, 0x10, 0x2e,
+	0x50, 0x69, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x11, 0x2e, 0x50, 0x69, 0x6e, 0x56, 0x69, 0x64, 0x65, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e,
+	0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x47, 0x65, 0x74, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x76, 0x65,
+	0x43, 0x68, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x76,
+	0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x0e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x16, 0x2e, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x17, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68,
+	0x61, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x5a,
+	0x0a, 0x15, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
+	0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x12, 0x1d, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x43, 0x68, 0x61, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x12, 0x4c, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12,
+	0x19, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
+	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x47, 0x65, 0x74,
+	0x43, 0x68, 0x61, 0x74, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x17, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x16, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x47, 0x65, 0x74,
+	0x41, 0x70, 0x69, 0x4b, 0x65, 0x79, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x2f, 0x5a, 0x1e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x6f, 0x74, 0x69, 0x78, 0x2f, 0x79, 0x6c, 0x63, 0x63, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0xaa, 0x02, 0x0c, 0x79, 0x6c, 0x63, 0x63, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protocol_proto_rawDescOnce sync.Once
+	file_protocol_proto_rawDescData = file_protocol_proto_rawDesc
+)
+
+func file_protocol_proto_rawDescGZIP() []byte {
+	file_protocol_proto_rawDescOnce.Do(func() {
+		file_protocol_proto_rawDescData = protoimpl.X.CompressGZIP(file_protocol_proto_rawDescData)
+	})
+	return file_protocol_proto_rawDescData
+}
+
+var file_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 14)
+var file_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 81)
+var file_protocol_proto_goTypes = []interface{}{
+	(Code)(0),                                        // 0: Code
+	(CollectionState)(0),                             // 1: CollectionState
+	(CollectionKind)(0),                              // 2: CollectionKind
+	(ReplayState)(0),                                 // 3: ReplayState
+	(ReplayControl)(0),                               // 4: ReplayControl
+	(ActiveLiveChatEventType)(0),                     // 5: ActiveLiveChatEventType
+	(ArchiveLiveChatMessageType)(0),                  // 6: ArchiveLiveChatMessageType
+	(ActiveLiveChatBackend)(0),                       // 7: ActiveLiveChatBackend
+	(ChatMessageSource)(0),                           // 8: ChatMessageSource
+	(ErrorClass)(0),                                  // 9: ErrorClass
+	(Target)(0),                                      // 10: Target
+	(WatchedVideoState)(0),                           // 11: WatchedVideoState
+	(ExportFormat)(0),                                // 12: ExportFormat
+	(ImportFormat)(0),                                // 13: ImportFormat
+	(*Status)(nil),                                   // 14: Status
+	(*GetVideoRequest)(nil),                          // 15: GetVideoRequest
+	(*GetVideoResponse)(nil),                         // 16: GetVideoResponse
+	(*StartCollectionActiveLiveChatRequest)(nil),     // 17: StartCollectionActiveLiveChatRequest
+	(*StartCollectionActiveLiveChatResponse)(nil),    // 18: StartCollectionActiveLiveChatResponse
+	(*PollActiveLiveChatRequest)(nil),                // 19: PollActiveLiveChatRequest
+	(*ActiveLiveChatCollectionStatus)(nil),           // 20: ActiveLiveChatCollectionStatus
+	(*PollActiveLiveChatResponse)(nil),               // 21: PollActiveLiveChatResponse
+	(*GetCachedActiveLiveChatRequest)(nil),           // 22: GetCachedActiveLiveChatRequest
+	(*GetCachedActiveLiveChatResponse)(nil),          // 23: GetCachedActiveLiveChatResponse
+	(*FollowActiveLiveChatRequest)(nil),              // 24: FollowActiveLiveChatRequest
+	(*FollowActiveLiveChatResponse)(nil),             // 25: FollowActiveLiveChatResponse
+	(*StopCollectionActiveLiveChatRequest)(nil),      // 26: StopCollectionActiveLiveChatRequest
+	(*StopCollectionActiveLiveChatResponse)(nil),     // 27: StopCollectionActiveLiveChatResponse
+	(*StartCollectionArchiveLiveChatRequest)(nil),    // 28: StartCollectionArchiveLiveChatRequest
+	(*StartCollectionArchiveLiveChatResponse)(nil),   // 29: StartCollectionArchiveLiveChatResponse
+	(*GetArchiveLiveChatRequest)(nil),                // 30: GetArchiveLiveChatRequest
+	(*GetArchiveLiveChatResponse)(nil),               // 31: GetArchiveLiveChatResponse
+	(*StopCollectionArchiveLiveChatRequest)(nil),     // 32: StopCollectionArchiveLiveChatRequest
+	(*StopCollectionArchiveLiveChatResponse)(nil),    // 33: StopCollectionArchiveLiveChatResponse
+	(*PollArchiveLiveChatProgressRequest)(nil),       // 34: PollArchiveLiveChatProgressRequest
+	(*ArchiveLiveChatProgress)(nil),                  // 35: ArchiveLiveChatProgress
+	(*PollArchiveLiveChatProgressResponse)(nil),      // 36: PollArchiveLiveChatProgressResponse
+	(*ReplayArchiveLiveChatRequest)(nil),             // 37: ReplayArchiveLiveChatRequest
+	(*ReplayArchiveLiveChatResponse)(nil),            // 38: ReplayArchiveLiveChatResponse
+	(*ControlReplayArchiveLiveChatRequest)(nil),      // 39: ControlReplayArchiveLiveChatRequest
+	(*ControlReplayArchiveLiveChatResponse)(nil),     // 40: ControlReplayArchiveLiveChatResponse
+	(*Video)(nil),                                    // 41: Video
+	(*ActiveLiveChatMessage)(nil),                    // 42: ActiveLiveChatMessage
+	(*ArchiveLiveChatMessage)(nil),                   // 43: ArchiveLiveChatMessage
+	(*AuthorBadge)(nil),                              // 44: AuthorBadge
+	(*StartCollectionWordCloudMessagesRequest)(nil),  // 45: StartCollectionWordCloudMessagesRequest
+	(*StartCollectionWordCloudMessagesResponse)(nil), // 46: StartCollectionWordCloudMessagesResponse
+	(*Color)(nil),                                    // 47: Color
+	(*GetWordCloudRequest)(nil),                      // 48: GetWordCloudRequest
+	(*GetWordCloudResponse)(nil),                     // 49: GetWordCloudResponse
+	(*VoteChoice)(nil),                               // 50: VoteChoice
+	(*OpenVoteRequest)(nil),                          // 51: OpenVoteRequest
+	(*OpenVoteResponse)(nil),                         // 52: OpenVoteResponse
+	(*UpdateVoteDurationRequest)(nil),                // 53: UpdateVoteDurationRequest
+	(*UpdateVoteDurationResponse)(nil),               // 54: UpdateVoteDurationResponse
+	(*VoteCount)(nil),                                // 55: VoteCount
+	(*GetVoteResultRequest)(nil),                     // 56: GetVoteResultRequest
+	(*GetVoteResultResponse)(nil),                    // 57: GetVoteResultResponse
+	(*CloseVoteRequest)(nil),                         // 58: CloseVoteRequest
+	(*CloseVoteResponse)(nil),                        // 59: CloseVoteResponse
+	(*GroupingActiveLiveChatMessage)(nil),            // 60: GroupingActiveLiveChatMessage
+	(*GroupingChoice)(nil),                           // 61: GroupingChoice
+	(*StartGroupingActiveLiveChatRequest)(nil),       // 62: StartGroupingActiveLiveChatRequest
+	(*StartGroupingActiveLiveChatResponse)(nil),      // 63: StartGroupingActiveLiveChatResponse
+	(*PollGroupingActiveLiveChatRequest)(nil),        // 64: PollGroupingActiveLiveChatRequest
+	(*PollGroupingActiveLiveChatResponse)(nil),       // 65: PollGroupingActiveLiveChatResponse
+	(*Collection)(nil),                               // 66: Collection
+	(*ListCollectionsRequest)(nil),                   // 67: ListCollectionsRequest
+	(*ListCollectionsResponse)(nil),                  // 68: ListCollectionsResponse
+	(*ApiKeyUsage)(nil),                              // 69: ApiKeyUsage
+	(*GetApiKeyUsageRequest)(nil),                    // 70: GetApiKeyUsageRequest
+	(*GetApiKeyUsageResponse)(nil),                   // 71: GetApiKeyUsageResponse
+	(*WatchChannelRequest)(nil),                      // 72: WatchChannelRequest
+	(*WatchedVideo)(nil),                             // 73: WatchedVideo
+	(*WatchedChannel)(nil),                           // 74: WatchedChannel
+	(*WatchChannelResponse)(nil),                     // 75: WatchChannelResponse
+	(*PinVideoRequest)(nil),                          // 76: PinVideoRequest
+	(*PinVideoResponse)(nil),                         // 77: PinVideoResponse
+	(*CleanerDeletion)(nil),                          // 78: CleanerDeletion
+	(*CleanerReport)(nil),                            // 79: CleanerReport
+	(*GetCleanerReportsRequest)(nil),                 // 80: GetCleanerReportsRequest
+	(*GetCleanerReportsResponse)(nil),                // 81: GetCleanerReportsResponse
+	(*SearchLiveChatRequest)(nil),                    // 82: SearchLiveChatRequest
+	(*SearchLiveChatResult)(nil),                     // 83: SearchLiveChatResult
+	(*SearchLiveChatResponse)(nil),                   // 84: SearchLiveChatResponse
+	(*ExportLiveChatRequest)(nil),                    // 85: ExportLiveChatRequest
+	(*ExportLiveChatResponse)(nil),                   // 86: ExportLiveChatResponse
+	(*ImportArchiveLiveChatRequest)(nil),             // 87: ImportArchiveLiveChatRequest
+	(*ImportArchiveLiveChatResponse)(nil),            // 88: ImportArchiveLiveChatResponse
+	(*GetChatStatisticsRequest)(nil),                 // 89: GetChatStatisticsRequest
+	(*MessagesPerMinute)(nil),                        // 90: MessagesPerMinute
+	(*AuthorStatistics)(nil),                         // 91: AuthorStatistics
+	(*SuperChatTotal)(nil),                           // 92: SuperChatTotal
+	(*ChatStatistics)(nil),                           // 93: ChatStatistics
+	(*GetChatStatisticsResponse)(nil),                // 94: GetChatStatisticsResponse
+}
+var file_protocol_proto_depIdxs = []int32{
+	0,   // 0: Status.code:type_name -> Code
+	14,  // 1: GetVideoResponse.status:type_name -> Status
+	41,  // 2: GetVideoResponse.video:type_name -> Video
+	7,   // 3: StartCollectionActiveLiveChatRequest.backend:type_name -> ActiveLiveChatBackend
+	14,  // 4: StartCollectionActiveLiveChatResponse.status:type_name -> Status
+	41,  // 5: StartCollectionActiveLiveChatResponse.video:type_name -> Video
+	1,   // 6: ActiveLiveChatCollectionStatus.state:type_name -> CollectionState
+	9,   // 7: ActiveLiveChatCollectionStatus.errorClass:type_name -> ErrorClass
+	14,  // 8: PollActiveLiveChatResponse.status:type_name -> Status
+	42,  // 9: PollActiveLiveChatResponse.activeLiveChatMessages:type_name -> ActiveLiveChatMessage
+	20,  // 10: PollActiveLiveChatResponse.collectionStatus:type_name -> ActiveLiveChatCollectionStatus
+	14,  // 11: GetCachedActiveLiveChatResponse.status:type_name -> Status
+	42,  // 12: GetCachedActiveLiveChatResponse.activeLiveChatMessages:type_name -> ActiveLiveChatMessage
+	14,  // 13: FollowActiveLiveChatResponse.status:type_name -> Status
+	42,  // 14: FollowActiveLiveChatResponse.activeLiveChatMessages:type_name -> ActiveLiveChatMessage
+	20,  // 15: FollowActiveLiveChatResponse.collectionStatus:type_name -> ActiveLiveChatCollectionStatus
+	14,  // 16: StopCollectionActiveLiveChatResponse.status:type_name -> Status
+	14,  // 17: StartCollectionArchiveLiveChatResponse.status:type_name -> Status
+	41,  // 18: StartCollectionArchiveLiveChatResponse.video:type_name -> Video
+	14,  // 19: GetArchiveLiveChatResponse.status:type_name -> Status
+	43,  // 20: GetArchiveLiveChatResponse.ArchiveLiveChatMessages:type_name -> ArchiveLiveChatMessage
+	14,  // 21: StopCollectionArchiveLiveChatResponse.status:type_name -> Status
+	1,   // 22: ArchiveLiveChatProgress.state:type_name -> CollectionState
+	14,  // 23: PollArchiveLiveChatProgressResponse.status:type_name -> Status
+	35,  // 24: PollArchiveLiveChatProgressResponse.progress:type_name -> ArchiveLiveChatProgress
+	14,  // 25: ReplayArchiveLiveChatResponse.status:type_name -> Status
+	3,   // 26: ReplayArchiveLiveChatResponse.state:type_name -> ReplayState
+	43,  // 27: ReplayArchiveLiveChatResponse.archiveLiveChatMessages:type_name -> ArchiveLiveChatMessage
+	4,   // 28: ControlReplayArchiveLiveChatRequest.control:type_name -> ReplayControl
+	14,  // 29: ControlReplayArchiveLiveChatResponse.status:type_name -> Status
+	5,   // 30: ActiveLiveChatMessage.eventType:type_name -> ActiveLiveChatEventType
+	6,   // 31: ArchiveLiveChatMessage.messageType:type_name -> ArchiveLiveChatMessageType
+	44,  // 32: ArchiveLiveChatMessage.authorBadges:type_name -> AuthorBadge
+	8,   // 33: StartCollectionWordCloudMessagesRequest.source:type_name -> ChatMessageSource
+	14,  // 34: StartCollectionWordCloudMessagesResponse.status:type_name -> Status
+	41,  // 35: StartCollectionWordCloudMessagesResponse.video:type_name -> Video
+	10,  // 36: GetWordCloudRequest.target:type_name -> Target
+	47,  // 37: GetWordCloudRequest.colors:type_name -> Color
+	47,  // 38: GetWordCloudRequest.backgroundColor:type_name -> Color
+	8,   // 39: GetWordCloudRequest.source:type_name -> ChatMessageSource
+	14,  // 40: GetWordCloudResponse.status:type_name -> Status
+	10,  // 41: OpenVoteRequest.target:type_name -> Target
+	50,  // 42: OpenVoteRequest.choices:type_name -> VoteChoice
+	8,   // 43: OpenVoteRequest.source:type_name -> ChatMessageSource
+	14,  // 44: OpenVoteResponse.status:type_name -> Status
+	41,  // 45: OpenVoteResponse.video:type_name -> Video
+	14,  // 46: UpdateVoteDurationResponse.status:type_name -> Status
+	14,  // 47: GetVoteResultResponse.status:type_name -> Status
+	55,  // 48: GetVoteResultResponse.counts:type_name -> VoteCount
+	14,  // 49: CloseVoteResponse.status:type_name -> Status
+	42,  // 50: GroupingActiveLiveChatMessage.activeLiveChatMessage:type_name -> ActiveLiveChatMessage
+	10,  // 51: StartGroupingActiveLiveChatRequest.target:type_name -> Target
+	61,  // 52: StartGroupingActiveLiveChatRequest.choices:type_name -> GroupingChoice
+	14,  // 53: StartGroupingActiveLiveChatResponse.status:type_name -> Status
+	41,  // 54: StartGroupingActiveLiveChatResponse.video:type_name -> Video
+	14,  // 55: PollGroupingActiveLiveChatResponse.status:type_name -> Status
+	60,  // 56: PollGroupingActiveLiveChatResponse.groupingActiveLiveChatMessage:type_name -> GroupingActiveLiveChatMessage
+	2,   // 57: Collection.kind:type_name -> CollectionKind
+	1,   // 58: Collection.state:type_name -> CollectionState
+	7,   // 59: Collection.backend:type_name -> ActiveLiveChatBackend
+	14,  // 60: ListCollectionsResponse.status:type_name -> Status
+	66,  // 61: ListCollectionsResponse.collections:type_name -> Collection
+	14,  // 62: GetApiKeyUsageResponse.status:type_name -> Status
+	69,  // 63: GetApiKeyUsageResponse.apiKeyUsages:type_name -> ApiKeyUsage
+	7,   // 64: WatchChannelRequest.backend:type_name -> ActiveLiveChatBackend
+	11,  // 65: WatchedVideo.state:type_name -> WatchedVideoState
+	7,   // 66: WatchedChannel.backend:type_name -> ActiveLiveChatBackend
+	73,  // 67: WatchedChannel.watchedVideos:type_name -> WatchedVideo
+	14,  // 68: WatchChannelResponse.status:type_name -> Status
+	74,  // 69: WatchChannelResponse.watchedChannel:type_name -> WatchedChannel
+	14,  // 70: PinVideoResponse.status:type_name -> Status
+	78,  // 71: CleanerReport.cleanerDeletions:type_name -> CleanerDeletion
+	14,  // 72: GetCleanerReportsResponse.status:type_name -> Status
+	79,  // 73: GetCleanerReportsResponse.cleanerReports:type_name -> CleanerReport
+	8,   // 74: SearchLiveChatRequest.source:type_name -> ChatMessageSource
+	10,  // 75: SearchLiveChatRequest.target:type_name -> Target
+	8,   // 76: SearchLiveChatResult.source:type_name -> ChatMessageSource
+	14,  // 77: SearchLiveChatResponse.status:type_name -> Status
+	83,  // 78: SearchLiveChatResponse.results:type_name -> SearchLiveChatResult
+	8,   // 79: ExportLiveChatRequest.source:type_name -> ChatMessageSource
+	12,  // 80: ExportLiveChatRequest.format:type_name -> ExportFormat
+	14,  // 81: ExportLiveChatResponse.status:type_name -> Status
+	41,  // 82: ExportLiveChatResponse.videos:type_name -> Video
+	12,  // 83: ExportLiveChatResponse.format:type_name -> ExportFormat
+	13,  // 84: ImportArchiveLiveChatRequest.format:type_name -> ImportFormat
+	14,  // 85: ImportArchiveLiveChatResponse.status:type_name -> Status
+	41,  // 86: ImportArchiveLiveChatResponse.video:type_name -> Video
+	8,   // 87: GetChatStatisticsRequest.source:type_name -> ChatMessageSource
+	90,  // 88: ChatStatistics.messagesPerMinute:type_name -> MessagesPerMinute
+	91,  // 89: ChatStatistics.topAuthors:type_name -> AuthorStatistics
+	92,  // 90: ChatStatistics.superChatTotals:type_name -> SuperChatTotal
+	14,  // 91: GetChatStatisticsResponse.status:type_name -> Status
+	93,  // 92: GetChatStatisticsResponse.statistics:type_name -> ChatStatistics
+	15,  // 93: ylcc.GetVideo:input_type -> GetVideoRequest
+	17,  // 94: ylcc.StartCollectionActiveLiveChat:input_type -> StartCollectionActiveLiveChatRequest
+	19,  // 95: ylcc.PollActiveLiveChat:input_type -> PollActiveLiveChatRequest
+	22,  // 96: ylcc.GetCachedActiveLiveChat:input_type -> GetCachedActiveLiveChatRequest
+	24,  // 97: ylcc.FollowActiveLiveChat:input_type -> FollowActiveLiveChatRequest
+	26,  // 98: ylcc.StopCollectionActiveLiveChat:input_type -> StopCollectionActiveLiveChatRequest
+	28,  // 99: ylcc.StartCollectionArchiveLiveChat:input_type -> StartCollectionArchiveLiveChatRequest
+	30,  // 100: ylcc.GetArchiveLiveChat:input_type -> GetArchiveLiveChatRequest
+	32,  // 101: ylcc.StopCollectionArchiveLiveChat:input_type -> StopCollectionArchiveLiveChatRequest
+	34,  // 102: ylcc.PollArchiveLiveChatProgress:input_type -> PollArchiveLiveChatProgressRequest
+	37,  // 103: ylcc.ReplayArchiveLiveChat:input_type -> ReplayArchiveLiveChatRequest
+	39,  // 104: ylcc.ControlReplayArchiveLiveChat:input_type -> ControlReplayArchiveLiveChatRequest
+	45,  // 105: ylcc.StartCollectionWordCloudMessages:input_type -> StartCollectionWordCloudMessagesRequest
+	48,  // 106: ylcc.GetWordCloud:input_type -> GetWordCloudRequest
+	51,  // 107: ylcc.OpenVote:input_type -> OpenVoteRequest
+	53,  // 108: ylcc.UpdateVoteDuration:input_type -> UpdateVoteDurationRequest
+	56,  // 109: ylcc.GetVoteResult:input_type -> GetVoteResultRequest
+	58,  // 110: ylcc.CloseVote:input_type -> CloseVoteRequest
+	62,  // 111: ylcc.StartGroupingActiveLiveChat:input_type -> StartGroupingActiveLiveChatRequest
+	64,  // 112: ylcc.PollGroupingActiveLiveChat:input_type -> PollGroupingActiveLiveChatRequest
+	72,  // 113: ylcc.WatchChannel:input_type -> WatchChannelRequest
+	76,  // 114: ylcc.PinVideo:input_type -> PinVideoRequest
+	80,  // 115: ylcc.GetCleanerReports:input_type -> GetCleanerReportsRequest
+	82,  // 116: ylcc.SearchLiveChat:input_type -> SearchLiveChatRequest
+	85,  // 117: ylcc.ExportLiveChat:input_type -> ExportLiveChatRequest
+	87,  // 118: ylcc.ImportArchiveLiveChat:input_type -> ImportArchiveLiveChatRequest
+	89,  // 119: ylcc.GetChatStatistics:input_type -> GetChatStatisticsRequest
+	67,  // 120: ylcc.ListCollections:input_type -> ListCollectionsRequest
+	70,  // 121: ylcc.GetApiKeyUsage:input_type -> GetApiKeyUsageRequest
+	16,  // 122: ylcc.GetVideo:output_type -> GetVideoResponse
+	18,  // 123: ylcc.StartCollectionActiveLiveChat:output_type -> StartCollectionActiveLiveChatResponse
+	21,  // 124: ylcc.PollActiveLiveChat:output_type -> PollActiveLiveChatResponse
+	23,  // 125: ylcc.GetCachedActiveLiveChat:output_type -> GetCachedActiveLiveChatResponse
+	25,  // 126: ylcc.FollowActiveLiveChat:output_type -> FollowActiveLiveChatResponse
+	27,  // 127: ylcc.StopCollectionActiveLiveChat:output_type -> StopCollectionActiveLiveChatResponse
+	29,  // 128: ylcc.StartCollectionArchiveLiveChat:output_type -> StartCollectionArchiveLiveChatResponse
+	31,  // 129: ylcc.GetArchiveLiveChat:output_type -> GetArchiveLiveChatResponse
+	33,  // 130: ylcc.StopCollectionArchiveLiveChat:output_type -> StopCollectionArchiveLiveChatResponse
+	36,  // 131: ylcc.PollArchiveLiveChatProgress:output_type -> PollArchiveLiveChatProgressResponse
+	38,  // 132: ylcc.ReplayArchiveLiveChat:output_type -> ReplayArchiveLiveChatResponse
+	40,  // 133: ylcc.ControlReplayArchiveLiveChat:output_type -> ControlReplayArchiveLiveChatResponse
+	46,  // 134: ylcc.StartCollectionWordCloudMessages:output_type -> StartCollectionWordCloudMessagesResponse
+	49,  // 135: ylcc.GetWordCloud:output_type -> GetWordCloudResponse
+	52,  // 136: ylcc.OpenVote:output_type -> OpenVoteResponse
+	54,  // 137: ylcc.UpdateVoteDuration:output_type -> UpdateVoteDurationResponse
+	57,  // 138: ylcc.GetVoteResult:output_type -> GetVoteResultResponse
+	59,  // 139: ylcc.CloseVote:output_type -> CloseVoteResponse
+	63,  // 140: ylcc.StartGroupingActiveLiveChat:output_type -> StartGroupingActiveLiveChatResponse
+	65,  // 141: ylcc.PollGroupingActiveLiveChat:output_type -> PollGroupingActiveLiveChatResponse
+	75,  // 142: ylcc.WatchChannel:output_type -> WatchChannelResponse
+	77,  // 143: ylcc.PinVideo:output_type -> PinVideoResponse
+	81,  // 144: ylcc.GetCleanerReports:output_type -> GetCleanerReportsResponse
+	84,  // 145: ylcc.SearchLiveChat:output_type -> SearchLiveChatResponse
+	86,  // 146: ylcc.ExportLiveChat:output_type -> ExportLiveChatResponse
+	88,  // 147: ylcc.ImportArchiveLiveChat:output_type -> ImportArchiveLiveChatResponse
+	94,  // 148: ylcc.GetChatStatistics:output_type -> GetChatStatisticsResponse
+	68,  // 149: ylcc.ListCollections:output_type -> ListCollectionsResponse
+	71,  // 150: ylcc.GetApiKeyUsage:output_type -> GetApiKeyUsageResponse
+	122, // [122:151] is the sub-list for method output_type
+	93,  // [93:122] is the sub-list for method input_type
+	93,  // [93:93] is the sub-list for extension type_name
+	93,  // [93:93] is the sub-list for extension extendee
+	0,   // [0:93] is the sub-list for field type_name
+}
+
+func init() { file_protocol_proto_init() }
+func file_protocol_proto_init() {
+	if File_protocol_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protocol_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Status); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVideoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVideoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCollectionActiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCollectionActiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollActiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveLiveChatCollectionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollActiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCachedActiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCachedActiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowActiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FollowActiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopCollectionActiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopCollectionActiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCollectionArchiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCollectionArchiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetArchiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopCollectionArchiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopCollectionArchiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollArchiveLiveChatProgressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveLiveChatProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollArchiveLiveChatProgressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayArchiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReplayArchiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlReplayArchiveLiveChatRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControlReplayArchiveLiveChatResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Video); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ActiveLiveChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveLiveChatMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorBadge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCollectionWordCloudMessagesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartCollectionWordCloudMessagesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Color); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWordCloudRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetWordCloudResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteChoice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenVoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenVoteResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVoteDurationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateVoteDurationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VoteCount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3178,8 +8793,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoRequest); i {
+		file_protocol_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteResultRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3190,8 +8805,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVideoResponse); i {
+		file_protocol_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVoteResultResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3202,8 +8817,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCollectionActiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseVoteRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3214,8 +8829,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCollectionActiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseVoteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3226,8 +8841,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PollActiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupingActiveLiveChatMessage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3238,8 +8853,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PollActiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupingChoice); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3250,8 +8865,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCachedActiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartGroupingActiveLiveChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3262,8 +8877,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetCachedActiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StartGroupingActiveLiveChatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3274,8 +8889,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCollectionArchiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollGroupingActiveLiveChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3286,8 +8901,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCollectionArchiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PollGroupingActiveLiveChatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3298,8 +8913,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArchiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Collection); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3310,8 +8925,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetArchiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3322,8 +8937,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Video); i {
+		file_protocol_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCollectionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3334,8 +8949,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ActiveLiveChatMessage); i {
+		file_protocol_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiKeyUsage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3346,8 +8961,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveLiveChatMessage); i {
+		file_protocol_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiKeyUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3358,8 +8973,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCollectionWordCloudMessagesRequest); i {
+		file_protocol_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetApiKeyUsageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3370,8 +8985,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartCollectionWordCloudMessagesResponse); i {
+		file_protocol_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchChannelRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3382,8 +8997,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Color); i {
+		file_protocol_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedVideo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3394,8 +9009,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWordCloudRequest); i {
+		file_protocol_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchedChannel); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3406,8 +9021,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetWordCloudResponse); i {
+		file_protocol_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchChannelResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3418,8 +9033,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteChoice); i {
+		file_protocol_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinVideoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3430,8 +9045,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenVoteRequest); i {
+		file_protocol_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PinVideoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3442,8 +9057,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenVoteResponse); i {
+		file_protocol_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanerDeletion); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3454,8 +9069,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVoteDurationRequest); i {
+		file_protocol_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CleanerReport); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3466,8 +9081,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateVoteDurationResponse); i {
+		file_protocol_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCleanerReportsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3478,8 +9093,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VoteCount); i {
+		file_protocol_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetCleanerReportsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3490,8 +9105,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVoteResultRequest); i {
+		file_protocol_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchLiveChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3502,8 +9117,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVoteResultResponse); i {
+		file_protocol_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchLiveChatResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3514,8 +9129,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseVoteRequest); i {
+		file_protocol_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchLiveChatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3526,8 +9141,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CloseVoteResponse); i {
+		file_protocol_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLiveChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3538,8 +9153,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupingActiveLiveChatMessage); i {
+		file_protocol_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportLiveChatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3550,8 +9165,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GroupingChoice); i {
+		file_protocol_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportArchiveLiveChatRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3562,8 +9177,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartGroupingActiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ImportArchiveLiveChatResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3574,8 +9189,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StartGroupingActiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChatStatisticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3586,8 +9201,8 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PollGroupingActiveLiveChatRequest); i {
+		file_protocol_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessagesPerMinute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3598,8 +9213,44 @@ func file_protocol_proto_init() {
 				return nil
 			}
 		}
-		file_protocol_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PollGroupingActiveLiveChatResponse); i {
+		file_protocol_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SuperChatTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChatStatistics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protocol_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetChatStatisticsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3616,8 +9267,8 @@ func file_protocol_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protocol_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   37,
+			NumEnums:      14,
+			NumMessages:   81,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
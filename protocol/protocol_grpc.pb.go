@@ -22,26 +22,47 @@ type YlccClient interface {
 	GetVideo(ctx context.Context, in *GetVideoRequest, opts ...grpc.CallOption) (*GetVideoResponse, error)
 	// 配信中のライブチャットの収集を開始する
 	// 収集したメッセージはキャッシュされる
+	// waitForLiveがtrueの場合は配信開始まで待機し、待機中もPollActiveLiveChatで購読できる
 	StartCollectionActiveLiveChat(ctx context.Context, in *StartCollectionActiveLiveChatRequest, opts ...grpc.CallOption) (*StartCollectionActiveLiveChatResponse, error)
 	// 収集中のライブチャットのメッセージをリアルタイムに返す
 	PollActiveLiveChat(ctx context.Context, in *PollActiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_PollActiveLiveChatClient, error)
 	// 収集後キャッシュしたライブチャットのメッセージを返す
-	// すでに収集中はエラーを返す
+	// 収集中でもキャッシュ済みのメッセージを返す
 	GetCachedActiveLiveChat(ctx context.Context, in *GetCachedActiveLiveChatRequest, opts ...grpc.CallOption) (*GetCachedActiveLiveChatResponse, error)
+	// cursorの後のキャッシュしたメッセージを返した後、収集中のメッセージをリアルタイムに返す
+	// メッセージの欠落や重複なしにキャッシュからリアルタイムに切り替わる
+	// 収集中でない場合はキャッシュしたメッセージだけを返して終了する
+	FollowActiveLiveChat(ctx context.Context, in *FollowActiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_FollowActiveLiveChatClient, error)
+	// 配信中のライブチャットの収集を停止する
+	StopCollectionActiveLiveChat(ctx context.Context, in *StopCollectionActiveLiveChatRequest, opts ...grpc.CallOption) (*StopCollectionActiveLiveChatResponse, error)
 	// アーカイブのライブチャットの収集を開始する
 	// すでに収集中はエラーを返す
 	StartCollectionArchiveLiveChat(ctx context.Context, in *StartCollectionArchiveLiveChatRequest, opts ...grpc.CallOption) (*StartCollectionArchiveLiveChatResponse, error)
 	// アーカイブのライブチャットのメッセージを返す
 	// 収集完了していない間はエラーを返す
 	GetArchiveLiveChat(ctx context.Context, in *GetArchiveLiveChatRequest, opts ...grpc.CallOption) (*GetArchiveLiveChatResponse, error)
+	// アーカイブのライブチャットの収集を停止する
+	StopCollectionArchiveLiveChat(ctx context.Context, in *StopCollectionArchiveLiveChatRequest, opts ...grpc.CallOption) (*StopCollectionArchiveLiveChatResponse, error)
+	// アーカイブのライブチャットの収集の進捗を受け取る
+	// 収集が終了すると終了状態とエラー内容を送ってストリームを閉じる
+	PollArchiveLiveChatProgress(ctx context.Context, in *PollArchiveLiveChatProgressRequest, opts ...grpc.CallOption) (Ylcc_PollArchiveLiveChatProgressClient, error)
+	// 保存済みのアーカイブのライブチャットを動画の時間に合わせて再生する
+	// 最初のレスポンスでreplayIdを返し、最後まで再生するとENDEDを返して終了する
+	ReplayArchiveLiveChat(ctx context.Context, in *ReplayArchiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_ReplayArchiveLiveChatClient, error)
+	// 再生中のアーカイブのライブチャットの一時停止、再開、シーク、速度変更を行う
+	ControlReplayArchiveLiveChat(ctx context.Context, in *ControlReplayArchiveLiveChatRequest, opts ...grpc.CallOption) (*ControlReplayArchiveLiveChatResponse, error)
 	// 配信中のライブチャットのワードクラウドメッセージの収集を開始する
 	// すでに収集中はエラーを返す
+	// sourceがARCHIVE_LIVE_CHAT_SOURCEの場合はアーカイブのライブチャットを収集する
 	StartCollectionWordCloudMessages(ctx context.Context, in *StartCollectionWordCloudMessagesRequest, opts ...grpc.CallOption) (*StartCollectionWordCloudMessagesResponse, error)
 	// 収集中のライブチャットメッセージからword cloudを生成して返す
+	// sourceがARCHIVE_LIVE_CHAT_SOURCEの場合は保存済みのアーカイブのライブチャットから生成する
 	GetWordCloud(ctx context.Context, in *GetWordCloudRequest, opts ...grpc.CallOption) (*GetWordCloudResponse, error)
 	// 配信中のライブチャットの収集を始めて投票を開始する
+	// sourceがARCHIVE_LIVE_CHAT_SOURCEの場合は保存済みのアーカイブのライブチャットで集計する
 	OpenVote(ctx context.Context, in *OpenVoteRequest, opts ...grpc.CallOption) (*OpenVoteResponse, error)
 	// 配信中のライブチャットの投票の時間を変更する
+	// アーカイブのライブチャットの投票は変更できない
 	UpdateVoteDuration(ctx context.Context, in *UpdateVoteDurationRequest, opts ...grpc.CallOption) (*UpdateVoteDurationResponse, error)
 	// 配信中のライブチャットの投票の現在の結果を取得する
 	GetVoteResult(ctx context.Context, in *GetVoteResultRequest, opts ...grpc.CallOption) (*GetVoteResultResponse, error)
@@ -51,6 +72,29 @@ type YlccClient interface {
 	StartGroupingActiveLiveChat(ctx context.Context, in *StartGroupingActiveLiveChatRequest, opts ...grpc.CallOption) (*StartGroupingActiveLiveChatResponse, error)
 	// 収集中のライブチャットのグルーピングメッセージをリアルタイムに返す
 	PollGroupingActiveLiveChat(ctx context.Context, in *PollGroupingActiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_PollGroupingActiveLiveChatClient, error)
+	// チャンネルを監視して配信が始まると配信中のライブチャットの収集を開始する
+	// 配信が終わるとアーカイブのライブチャットの収集を開始する
+	// unwatchがtrueの場合は監視を停止する
+	WatchChannel(ctx context.Context, in *WatchChannelRequest, opts ...grpc.CallOption) (*WatchChannelResponse, error)
+	// 動画を保持期間による削除の対象外にする
+	// unpinがtrueの場合は対象外を解除する
+	PinVideo(ctx context.Context, in *PinVideoRequest, opts ...grpc.CallOption) (*PinVideoResponse, error)
+	// クリーナーの実行ごとに削除した内容を新しい順に返す
+	GetCleanerReports(ctx context.Context, in *GetCleanerReportsRequest, opts ...grpc.CallOption) (*GetCleanerReportsResponse, error)
+	// 保存済みのライブチャットを全文検索して関連度の高い順に返す
+	SearchLiveChat(ctx context.Context, in *SearchLiveChatRequest, opts ...grpc.CallOption) (*SearchLiveChatResponse, error)
+	// 保存済みの動画かチャンネルのライブチャットをJSONL, CSV, Parquetに変換して分割して返す
+	// 返されたchunkを順に連結すると1つのファイルになる
+	ExportLiveChat(ctx context.Context, in *ExportLiveChatRequest, opts ...grpc.CallOption) (Ylcc_ExportLiveChatClient, error)
+	// yt-dlpやchat-downloaderで保存したライブチャットのファイルをアーカイブのライブチャットとして取り込む
+	// ファイルの内容を分割して順に送る
+	ImportArchiveLiveChat(ctx context.Context, opts ...grpc.CallOption) (Ylcc_ImportArchiveLiveChatClient, error)
+	// 保存済みの動画かチャンネルのライブチャットの統計を返す
+	GetChatStatistics(ctx context.Context, in *GetChatStatisticsRequest, opts ...grpc.CallOption) (*GetChatStatisticsResponse, error)
+	// 実行中の収集と処理の一覧を返す
+	ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error)
+	// APIキーごとの推定クォータ使用量を返す
+	GetApiKeyUsage(ctx context.Context, in *GetApiKeyUsageRequest, opts ...grpc.CallOption) (*GetApiKeyUsageResponse, error)
 }
 
 type ylccClient struct {
@@ -120,6 +164,47 @@ func (c *ylccClient) GetCachedActiveLiveChat(ctx context.Context, in *GetCachedA
 	return out, nil
 }
 
+func (c *ylccClient) FollowActiveLiveChat(ctx context.Context, in *FollowActiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_FollowActiveLiveChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[1], "/ylcc/FollowActiveLiveChat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ylccFollowActiveLiveChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ylcc_FollowActiveLiveChatClient interface {
+	Recv() (*FollowActiveLiveChatResponse, error)
+	grpc.ClientStream
+}
+
+type ylccFollowActiveLiveChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *ylccFollowActiveLiveChatClient) Recv() (*FollowActiveLiveChatResponse, error) {
+	m := new(FollowActiveLiveChatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ylccClient) StopCollectionActiveLiveChat(ctx context.Context, in *StopCollectionActiveLiveChatRequest, opts ...grpc.CallOption) (*StopCollectionActiveLiveChatResponse, error) {
+	out := new(StopCollectionActiveLiveChatResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/StopCollectionActiveLiveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ylccClient) StartCollectionArchiveLiveChat(ctx context.Context, in *StartCollectionArchiveLiveChatRequest, opts ...grpc.CallOption) (*StartCollectionArchiveLiveChatResponse, error) {
 	out := new(StartCollectionArchiveLiveChatResponse)
 	err := c.cc.Invoke(ctx, "/ylcc/StartCollectionArchiveLiveChat", in, out, opts...)
@@ -138,6 +223,88 @@ func (c *ylccClient) GetArchiveLiveChat(ctx context.Context, in *GetArchiveLiveC
 	return out, nil
 }
 
+func (c *ylccClient) StopCollectionArchiveLiveChat(ctx context.Context, in *StopCollectionArchiveLiveChatRequest, opts ...grpc.CallOption) (*StopCollectionArchiveLiveChatResponse, error) {
+	out := new(StopCollectionArchiveLiveChatResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/StopCollectionArchiveLiveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) PollArchiveLiveChatProgress(ctx context.Context, in *PollArchiveLiveChatProgressRequest, opts ...grpc.CallOption) (Ylcc_PollArchiveLiveChatProgressClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[2], "/ylcc/PollArchiveLiveChatProgress", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ylccPollArchiveLiveChatProgressClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ylcc_PollArchiveLiveChatProgressClient interface {
+	Recv() (*PollArchiveLiveChatProgressResponse, error)
+	grpc.ClientStream
+}
+
+type ylccPollArchiveLiveChatProgressClient struct {
+	grpc.ClientStream
+}
+
+func (x *ylccPollArchiveLiveChatProgressClient) Recv() (*PollArchiveLiveChatProgressResponse, error) {
+	m := new(PollArchiveLiveChatProgressResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ylccClient) ReplayArchiveLiveChat(ctx context.Context, in *ReplayArchiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_ReplayArchiveLiveChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[3], "/ylcc/ReplayArchiveLiveChat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ylccReplayArchiveLiveChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ylcc_ReplayArchiveLiveChatClient interface {
+	Recv() (*ReplayArchiveLiveChatResponse, error)
+	grpc.ClientStream
+}
+
+type ylccReplayArchiveLiveChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *ylccReplayArchiveLiveChatClient) Recv() (*ReplayArchiveLiveChatResponse, error) {
+	m := new(ReplayArchiveLiveChatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ylccClient) ControlReplayArchiveLiveChat(ctx context.Context, in *ControlReplayArchiveLiveChatRequest, opts ...grpc.CallOption) (*ControlReplayArchiveLiveChatResponse, error) {
+	out := new(ControlReplayArchiveLiveChatResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/ControlReplayArchiveLiveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *ylccClient) StartCollectionWordCloudMessages(ctx context.Context, in *StartCollectionWordCloudMessagesRequest, opts ...grpc.CallOption) (*StartCollectionWordCloudMessagesResponse, error) {
 	out := new(StartCollectionWordCloudMessagesResponse)
 	err := c.cc.Invoke(ctx, "/ylcc/StartCollectionWordCloudMessages", in, out, opts...)
@@ -202,7 +369,7 @@ func (c *ylccClient) StartGroupingActiveLiveChat(ctx context.Context, in *StartG
 }
 
 func (c *ylccClient) PollGroupingActiveLiveChat(ctx context.Context, in *PollGroupingActiveLiveChatRequest, opts ...grpc.CallOption) (Ylcc_PollGroupingActiveLiveChatClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[1], "/ylcc/PollGroupingActiveLiveChat", opts...)
+	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[4], "/ylcc/PollGroupingActiveLiveChat", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -233,6 +400,135 @@ func (x *ylccPollGroupingActiveLiveChatClient) Recv() (*PollGroupingActiveLiveCh
 	return m, nil
 }
 
+func (c *ylccClient) WatchChannel(ctx context.Context, in *WatchChannelRequest, opts ...grpc.CallOption) (*WatchChannelResponse, error) {
+	out := new(WatchChannelResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/WatchChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) PinVideo(ctx context.Context, in *PinVideoRequest, opts ...grpc.CallOption) (*PinVideoResponse, error) {
+	out := new(PinVideoResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/PinVideo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) GetCleanerReports(ctx context.Context, in *GetCleanerReportsRequest, opts ...grpc.CallOption) (*GetCleanerReportsResponse, error) {
+	out := new(GetCleanerReportsResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/GetCleanerReports", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) SearchLiveChat(ctx context.Context, in *SearchLiveChatRequest, opts ...grpc.CallOption) (*SearchLiveChatResponse, error) {
+	out := new(SearchLiveChatResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/SearchLiveChat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) ExportLiveChat(ctx context.Context, in *ExportLiveChatRequest, opts ...grpc.CallOption) (Ylcc_ExportLiveChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[5], "/ylcc/ExportLiveChat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ylccExportLiveChatClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Ylcc_ExportLiveChatClient interface {
+	Recv() (*ExportLiveChatResponse, error)
+	grpc.ClientStream
+}
+
+type ylccExportLiveChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *ylccExportLiveChatClient) Recv() (*ExportLiveChatResponse, error) {
+	m := new(ExportLiveChatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ylccClient) ImportArchiveLiveChat(ctx context.Context, opts ...grpc.CallOption) (Ylcc_ImportArchiveLiveChatClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Ylcc_ServiceDesc.Streams[6], "/ylcc/ImportArchiveLiveChat", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &ylccImportArchiveLiveChatClient{stream}
+	return x, nil
+}
+
+type Ylcc_ImportArchiveLiveChatClient interface {
+	Send(*ImportArchiveLiveChatRequest) error
+	CloseAndRecv() (*ImportArchiveLiveChatResponse, error)
+	grpc.ClientStream
+}
+
+type ylccImportArchiveLiveChatClient struct {
+	grpc.ClientStream
+}
+
+func (x *ylccImportArchiveLiveChatClient) Send(m *ImportArchiveLiveChatRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *ylccImportArchiveLiveChatClient) CloseAndRecv() (*ImportArchiveLiveChatResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ImportArchiveLiveChatResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *ylccClient) GetChatStatistics(ctx context.Context, in *GetChatStatisticsRequest, opts ...grpc.CallOption) (*GetChatStatisticsResponse, error) {
+	out := new(GetChatStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/GetChatStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) ListCollections(ctx context.Context, in *ListCollectionsRequest, opts ...grpc.CallOption) (*ListCollectionsResponse, error) {
+	out := new(ListCollectionsResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/ListCollections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ylccClient) GetApiKeyUsage(ctx context.Context, in *GetApiKeyUsageRequest, opts ...grpc.CallOption) (*GetApiKeyUsageResponse, error) {
+	out := new(GetApiKeyUsageResponse)
+	err := c.cc.Invoke(ctx, "/ylcc/GetApiKeyUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // YlccServer is the server API for Ylcc service.
 // All implementations must embed UnimplementedYlccServer
 // for forward compatibility
@@ -241,26 +537,47 @@ type YlccServer interface {
 	GetVideo(context.Context, *GetVideoRequest) (*GetVideoResponse, error)
 	// 配信中のライブチャットの収集を開始する
 	// 収集したメッセージはキャッシュされる
+	// waitForLiveがtrueの場合は配信開始まで待機し、待機中もPollActiveLiveChatで購読できる
 	StartCollectionActiveLiveChat(context.Context, *StartCollectionActiveLiveChatRequest) (*StartCollectionActiveLiveChatResponse, error)
 	// 収集中のライブチャットのメッセージをリアルタイムに返す
 	PollActiveLiveChat(*PollActiveLiveChatRequest, Ylcc_PollActiveLiveChatServer) error
 	// 収集後キャッシュしたライブチャットのメッセージを返す
-	// すでに収集中はエラーを返す
+	// 収集中でもキャッシュ済みのメッセージを返す
 	GetCachedActiveLiveChat(context.Context, *GetCachedActiveLiveChatRequest) (*GetCachedActiveLiveChatResponse, error)
+	// cursorの後のキャッシュしたメッセージを返した後、収集中のメッセージをリアルタイムに返す
+	// メッセージの欠落や重複なしにキャッシュからリアルタイムに切り替わる
+	// 収集中でない場合はキャッシュしたメッセージだけを返して終了する
+	FollowActiveLiveChat(*FollowActiveLiveChatRequest, Ylcc_FollowActiveLiveChatServer) error
+	// 配信中のライブチャットの収集を停止する
+	StopCollectionActiveLiveChat(context.Context, *StopCollectionActiveLiveChatRequest) (*StopCollectionActiveLiveChatResponse, error)
 	// アーカイブのライブチャットの収集を開始する
 	// すでに収集中はエラーを返す
 	StartCollectionArchiveLiveChat(context.Context, *StartCollectionArchiveLiveChatRequest) (*StartCollectionArchiveLiveChatResponse, error)
 	// アーカイブのライブチャットのメッセージを返す
 	// 収集完了していない間はエラーを返す
 	GetArchiveLiveChat(context.Context, *GetArchiveLiveChatRequest) (*GetArchiveLiveChatResponse, error)
+	// アーカイブのライブチャットの収集を停止する
+	StopCollectionArchiveLiveChat(context.Context, *StopCollectionArchiveLiveChatRequest) (*StopCollectionArchiveLiveChatResponse, error)
+	// アーカイブのライブチャットの収集の進捗を受け取る
+	// 収集が終了すると終了状態とエラー内容を送ってストリームを閉じる
+	PollArchiveLiveChatProgress(*PollArchiveLiveChatProgressRequest, Ylcc_PollArchiveLiveChatProgressServer) error
+	// 保存済みのアーカイブのライブチャットを動画の時間に合わせて再生する
+	// 最初のレスポンスでreplayIdを返し、最後まで再生するとENDEDを返して終了する
+	ReplayArchiveLiveChat(*ReplayArchiveLiveChatRequest, Ylcc_ReplayArchiveLiveChatServer) error
+	// 再生中のアーカイブのライブチャットの一時停止、再開、シーク、速度変更を行う
+	ControlReplayArchiveLiveChat(context.Context, *ControlReplayArchiveLiveChatRequest) (*ControlReplayArchiveLiveChatResponse, error)
 	// 配信中のライブチャットのワードクラウドメッセージの収集を開始する
 	// すでに収集中はエラーを返す
+	// sourceがARCHIVE_LIVE_CHAT_SOURCEの場合はアーカイブのライブチャットを収集する
 	StartCollectionWordCloudMessages(context.Context, *StartCollectionWordCloudMessagesRequest) (*StartCollectionWordCloudMessagesResponse, error)
 	// 収集中のライブチャットメッセージからword cloudを生成して返す
+	// sourceがARCHIVE_LIVE_CHAT_SOURCEの場合は保存済みのアーカイブのライブチャットから生成する
 	GetWordCloud(context.Context, *GetWordCloudRequest) (*GetWordCloudResponse, error)
 	// 配信中のライブチャットの収集を始めて投票を開始する
+	// sourceがARCHIVE_LIVE_CHAT_SOURCEの場合は保存済みのアーカイブのライブチャットで集計する
 	OpenVote(context.Context, *OpenVoteRequest) (*OpenVoteResponse, error)
 	// 配信中のライブチャットの投票の時間を変更する
+	// アーカイブのライブチャットの投票は変更できない
 	UpdateVoteDuration(context.Context, *UpdateVoteDurationRequest) (*UpdateVoteDurationResponse, error)
 	// 配信中のライブチャットの投票の現在の結果を取得する
 	GetVoteResult(context.Context, *GetVoteResultRequest) (*GetVoteResultResponse, error)
@@ -270,6 +587,29 @@ type YlccServer interface {
 	StartGroupingActiveLiveChat(context.Context, *StartGroupingActiveLiveChatRequest) (*StartGroupingActiveLiveChatResponse, error)
 	// 収集中のライブチャットのグルーピングメッセージをリアルタイムに返す
 	PollGroupingActiveLiveChat(*PollGroupingActiveLiveChatRequest, Ylcc_PollGroupingActiveLiveChatServer) error
+	// チャンネルを監視して配信が始まると配信中のライブチャットの収集を開始する
+	// 配信が終わるとアーカイブのライブチャットの収集を開始する
+	// unwatchがtrueの場合は監視を停止する
+	WatchChannel(context.Context, *WatchChannelRequest) (*WatchChannelResponse, error)
+	// 動画を保持期間による削除の対象外にする
+	// unpinがtrueの場合は対象外を解除する
+	PinVideo(context.Context, *PinVideoRequest) (*PinVideoResponse, error)
+	// クリーナーの実行ごとに削除した内容を新しい順に返す
+	GetCleanerReports(context.Context, *GetCleanerReportsRequest) (*GetCleanerReportsResponse, error)
+	// 保存済みのライブチャットを全文検索して関連度の高い順に返す
+	SearchLiveChat(context.Context, *SearchLiveChatRequest) (*SearchLiveChatResponse, error)
+	// 保存済みの動画かチャンネルのライブチャットをJSONL, CSV, Parquetに変換して分割して返す
+	// 返されたchunkを順に連結すると1つのファイルになる
+	ExportLiveChat(*ExportLiveChatRequest, Ylcc_ExportLiveChatServer) error
+	// yt-dlpやchat-downloaderで保存したライブチャットのファイルをアーカイブのライブチャットとして取り込む
+	// ファイルの内容を分割して順に送る
+	ImportArchiveLiveChat(Ylcc_ImportArchiveLiveChatServer) error
+	// 保存済みの動画かチャンネルのライブチャットの統計を返す
+	GetChatStatistics(context.Context, *GetChatStatisticsRequest) (*GetChatStatisticsResponse, error)
+	// 実行中の収集と処理の一覧を返す
+	ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error)
+	// APIキーごとの推定クォータ使用量を返す
+	GetApiKeyUsage(context.Context, *GetApiKeyUsageRequest) (*GetApiKeyUsageResponse, error)
 	mustEmbedUnimplementedYlccServer()
 }
 
@@ -289,12 +629,30 @@ func (UnimplementedYlccServer) PollActiveLiveChat(*PollActiveLiveChatRequest, Yl
 func (UnimplementedYlccServer) GetCachedActiveLiveChat(context.Context, *GetCachedActiveLiveChatRequest) (*GetCachedActiveLiveChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCachedActiveLiveChat not implemented")
 }
+func (UnimplementedYlccServer) FollowActiveLiveChat(*FollowActiveLiveChatRequest, Ylcc_FollowActiveLiveChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method FollowActiveLiveChat not implemented")
+}
+func (UnimplementedYlccServer) StopCollectionActiveLiveChat(context.Context, *StopCollectionActiveLiveChatRequest) (*StopCollectionActiveLiveChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCollectionActiveLiveChat not implemented")
+}
 func (UnimplementedYlccServer) StartCollectionArchiveLiveChat(context.Context, *StartCollectionArchiveLiveChatRequest) (*StartCollectionArchiveLiveChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartCollectionArchiveLiveChat not implemented")
 }
 func (UnimplementedYlccServer) GetArchiveLiveChat(context.Context, *GetArchiveLiveChatRequest) (*GetArchiveLiveChatResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetArchiveLiveChat not implemented")
 }
+func (UnimplementedYlccServer) StopCollectionArchiveLiveChat(context.Context, *StopCollectionArchiveLiveChatRequest) (*StopCollectionArchiveLiveChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopCollectionArchiveLiveChat not implemented")
+}
+func (UnimplementedYlccServer) PollArchiveLiveChatProgress(*PollArchiveLiveChatProgressRequest, Ylcc_PollArchiveLiveChatProgressServer) error {
+	return status.Errorf(codes.Unimplemented, "method PollArchiveLiveChatProgress not implemented")
+}
+func (UnimplementedYlccServer) ReplayArchiveLiveChat(*ReplayArchiveLiveChatRequest, Ylcc_ReplayArchiveLiveChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReplayArchiveLiveChat not implemented")
+}
+func (UnimplementedYlccServer) ControlReplayArchiveLiveChat(context.Context, *ControlReplayArchiveLiveChatRequest) (*ControlReplayArchiveLiveChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControlReplayArchiveLiveChat not implemented")
+}
 func (UnimplementedYlccServer) StartCollectionWordCloudMessages(context.Context, *StartCollectionWordCloudMessagesRequest) (*StartCollectionWordCloudMessagesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StartCollectionWordCloudMessages not implemented")
 }
@@ -319,6 +677,33 @@ func (UnimplementedYlccServer) StartGroupingActiveLiveChat(context.Context, *Sta
 func (UnimplementedYlccServer) PollGroupingActiveLiveChat(*PollGroupingActiveLiveChatRequest, Ylcc_PollGroupingActiveLiveChatServer) error {
 	return status.Errorf(codes.Unimplemented, "method PollGroupingActiveLiveChat not implemented")
 }
+func (UnimplementedYlccServer) WatchChannel(context.Context, *WatchChannelRequest) (*WatchChannelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WatchChannel not implemented")
+}
+func (UnimplementedYlccServer) PinVideo(context.Context, *PinVideoRequest) (*PinVideoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinVideo not implemented")
+}
+func (UnimplementedYlccServer) GetCleanerReports(context.Context, *GetCleanerReportsRequest) (*GetCleanerReportsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCleanerReports not implemented")
+}
+func (UnimplementedYlccServer) SearchLiveChat(context.Context, *SearchLiveChatRequest) (*SearchLiveChatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchLiveChat not implemented")
+}
+func (UnimplementedYlccServer) ExportLiveChat(*ExportLiveChatRequest, Ylcc_ExportLiveChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportLiveChat not implemented")
+}
+func (UnimplementedYlccServer) ImportArchiveLiveChat(Ylcc_ImportArchiveLiveChatServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImportArchiveLiveChat not implemented")
+}
+func (UnimplementedYlccServer) GetChatStatistics(context.Context, *GetChatStatisticsRequest) (*GetChatStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatStatistics not implemented")
+}
+func (UnimplementedYlccServer) ListCollections(context.Context, *ListCollectionsRequest) (*ListCollectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCollections not implemented")
+}
+func (UnimplementedYlccServer) GetApiKeyUsage(context.Context, *GetApiKeyUsageRequest) (*GetApiKeyUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeyUsage not implemented")
+}
 func (UnimplementedYlccServer) mustEmbedUnimplementedYlccServer() {}
 
 // UnsafeYlccServer may be embedded to opt out of forward compatibility for this service.
@@ -407,6 +792,45 @@ func _Ylcc_GetCachedActiveLiveChat_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ylcc_FollowActiveLiveChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(FollowActiveLiveChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(YlccServer).FollowActiveLiveChat(m, &ylccFollowActiveLiveChatServer{stream})
+}
+
+type Ylcc_FollowActiveLiveChatServer interface {
+	Send(*FollowActiveLiveChatResponse) error
+	grpc.ServerStream
+}
+
+type ylccFollowActiveLiveChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *ylccFollowActiveLiveChatServer) Send(m *FollowActiveLiveChatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ylcc_StopCollectionActiveLiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopCollectionActiveLiveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).StopCollectionActiveLiveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/StopCollectionActiveLiveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).StopCollectionActiveLiveChat(ctx, req.(*StopCollectionActiveLiveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Ylcc_StartCollectionArchiveLiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCollectionArchiveLiveChatRequest)
 	if err := dec(in); err != nil {
@@ -443,6 +867,84 @@ func _Ylcc_GetArchiveLiveChat_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ylcc_StopCollectionArchiveLiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopCollectionArchiveLiveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).StopCollectionArchiveLiveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/StopCollectionArchiveLiveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).StopCollectionArchiveLiveChat(ctx, req.(*StopCollectionArchiveLiveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_PollArchiveLiveChatProgress_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PollArchiveLiveChatProgressRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(YlccServer).PollArchiveLiveChatProgress(m, &ylccPollArchiveLiveChatProgressServer{stream})
+}
+
+type Ylcc_PollArchiveLiveChatProgressServer interface {
+	Send(*PollArchiveLiveChatProgressResponse) error
+	grpc.ServerStream
+}
+
+type ylccPollArchiveLiveChatProgressServer struct {
+	grpc.ServerStream
+}
+
+func (x *ylccPollArchiveLiveChatProgressServer) Send(m *PollArchiveLiveChatProgressResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ylcc_ReplayArchiveLiveChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReplayArchiveLiveChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(YlccServer).ReplayArchiveLiveChat(m, &ylccReplayArchiveLiveChatServer{stream})
+}
+
+type Ylcc_ReplayArchiveLiveChatServer interface {
+	Send(*ReplayArchiveLiveChatResponse) error
+	grpc.ServerStream
+}
+
+type ylccReplayArchiveLiveChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *ylccReplayArchiveLiveChatServer) Send(m *ReplayArchiveLiveChatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ylcc_ControlReplayArchiveLiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ControlReplayArchiveLiveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).ControlReplayArchiveLiveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/ControlReplayArchiveLiveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).ControlReplayArchiveLiveChat(ctx, req.(*ControlReplayArchiveLiveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Ylcc_StartCollectionWordCloudMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(StartCollectionWordCloudMessagesRequest)
 	if err := dec(in); err != nil {
@@ -590,6 +1092,179 @@ func (x *ylccPollGroupingActiveLiveChatServer) Send(m *PollGroupingActiveLiveCha
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Ylcc_WatchChannel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WatchChannelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).WatchChannel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/WatchChannel",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).WatchChannel(ctx, req.(*WatchChannelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_PinVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).PinVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/PinVideo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).PinVideo(ctx, req.(*PinVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_GetCleanerReports_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCleanerReportsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).GetCleanerReports(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/GetCleanerReports",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).GetCleanerReports(ctx, req.(*GetCleanerReportsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_SearchLiveChat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchLiveChatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).SearchLiveChat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/SearchLiveChat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).SearchLiveChat(ctx, req.(*SearchLiveChatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_ExportLiveChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportLiveChatRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(YlccServer).ExportLiveChat(m, &ylccExportLiveChatServer{stream})
+}
+
+type Ylcc_ExportLiveChatServer interface {
+	Send(*ExportLiveChatResponse) error
+	grpc.ServerStream
+}
+
+type ylccExportLiveChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *ylccExportLiveChatServer) Send(m *ExportLiveChatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Ylcc_ImportArchiveLiveChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(YlccServer).ImportArchiveLiveChat(&ylccImportArchiveLiveChatServer{stream})
+}
+
+type Ylcc_ImportArchiveLiveChatServer interface {
+	SendAndClose(*ImportArchiveLiveChatResponse) error
+	Recv() (*ImportArchiveLiveChatRequest, error)
+	grpc.ServerStream
+}
+
+type ylccImportArchiveLiveChatServer struct {
+	grpc.ServerStream
+}
+
+func (x *ylccImportArchiveLiveChatServer) SendAndClose(m *ImportArchiveLiveChatResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *ylccImportArchiveLiveChatServer) Recv() (*ImportArchiveLiveChatRequest, error) {
+	m := new(ImportArchiveLiveChatRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Ylcc_GetChatStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChatStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).GetChatStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/GetChatStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).GetChatStatistics(ctx, req.(*GetChatStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_ListCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCollectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).ListCollections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/ListCollections",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).ListCollections(ctx, req.(*ListCollectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Ylcc_GetApiKeyUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeyUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YlccServer).GetApiKeyUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ylcc/GetApiKeyUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YlccServer).GetApiKeyUsage(ctx, req.(*GetApiKeyUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Ylcc_ServiceDesc is the grpc.ServiceDesc for Ylcc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -609,6 +1284,10 @@ var Ylcc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCachedActiveLiveChat",
 			Handler:    _Ylcc_GetCachedActiveLiveChat_Handler,
 		},
+		{
+			MethodName: "StopCollectionActiveLiveChat",
+			Handler:    _Ylcc_StopCollectionActiveLiveChat_Handler,
+		},
 		{
 			MethodName: "StartCollectionArchiveLiveChat",
 			Handler:    _Ylcc_StartCollectionArchiveLiveChat_Handler,
@@ -617,6 +1296,14 @@ var Ylcc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetArchiveLiveChat",
 			Handler:    _Ylcc_GetArchiveLiveChat_Handler,
 		},
+		{
+			MethodName: "StopCollectionArchiveLiveChat",
+			Handler:    _Ylcc_StopCollectionArchiveLiveChat_Handler,
+		},
+		{
+			MethodName: "ControlReplayArchiveLiveChat",
+			Handler:    _Ylcc_ControlReplayArchiveLiveChat_Handler,
+		},
 		{
 			MethodName: "StartCollectionWordCloudMessages",
 			Handler:    _Ylcc_StartCollectionWordCloudMessages_Handler,
@@ -645,6 +1332,34 @@ var Ylcc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StartGroupingActiveLiveChat",
 			Handler:    _Ylcc_StartGroupingActiveLiveChat_Handler,
 		},
+		{
+			MethodName: "WatchChannel",
+			Handler:    _Ylcc_WatchChannel_Handler,
+		},
+		{
+			MethodName: "PinVideo",
+			Handler:    _Ylcc_PinVideo_Handler,
+		},
+		{
+			MethodName: "GetCleanerReports",
+			Handler:    _Ylcc_GetCleanerReports_Handler,
+		},
+		{
+			MethodName: "SearchLiveChat",
+			Handler:    _Ylcc_SearchLiveChat_Handler,
+		},
+		{
+			MethodName: "GetChatStatistics",
+			Handler:    _Ylcc_GetChatStatistics_Handler,
+		},
+		{
+			MethodName: "ListCollections",
+			Handler:    _Ylcc_ListCollections_Handler,
+		},
+		{
+			MethodName: "GetApiKeyUsage",
+			Handler:    _Ylcc_GetApiKeyUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -652,11 +1367,36 @@ var Ylcc_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Ylcc_PollActiveLiveChat_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "FollowActiveLiveChat",
+			Handler:       _Ylcc_FollowActiveLiveChat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "PollArchiveLiveChatProgress",
+			Handler:       _Ylcc_PollArchiveLiveChatProgress_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReplayArchiveLiveChat",
+			Handler:       _Ylcc_ReplayArchiveLiveChat_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "PollGroupingActiveLiveChat",
 			Handler:       _Ylcc_PollGroupingActiveLiveChat_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportLiveChat",
+			Handler:       _Ylcc_ExportLiveChat_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportArchiveLiveChat",
+			Handler:       _Ylcc_ImportArchiveLiveChat_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "protocol.proto",
 }
@@ -83,7 +83,7 @@ func NewServer(addrPort string, handler Handler, opts ...Option) (*Server, error
 	if baseOpts.tlsCertPath != "" && baseOpts.tlsKeyPath != "" {
 		serverCred, err := credentials.NewServerTLSFromFile(baseOpts.tlsCertPath, baseOpts.tlsKeyPath)
 		if err != nil {
-			return nil, fmt.Errorf("can not create server credential (tlsCertPath, tlsKeyPath = %v, $v): %v", baseOpts.tlsCertPath, baseOpts.tlsKeyPath, err)
+			return nil, fmt.Errorf("can not create server credential (tlsCertPath, tlsKeyPath = %v, %v): %v", baseOpts.tlsCertPath, baseOpts.tlsKeyPath, err)
 		}
 		grpcServer = grpc.NewServer(grpc.Creds(serverCred))
 	}
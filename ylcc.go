@@ -3,14 +3,19 @@ package main
 import (
 	"encoding/json"
 	"flag"
+	"fmt"
 	"github.com/potix/utils/signal"
 	"github.com/potix/utils/configurator"
 	"github.com/potix/ylcc/collector"
 	"github.com/potix/ylcc/handler"
 	"github.com/potix/ylcc/processor"
+	pb "github.com/potix/ylcc/protocol"
 	"github.com/potix/ylcc/server"
 	"log"
 	"log/syslog"
+	"os"
+	"strings"
+	"time"
 )
 
 type ylccProcessorConfig struct {
@@ -18,9 +23,43 @@ type ylccProcessorConfig struct {
 	Font                  string `toml:"font"`
 }
 
+type ylccRetentionRuleConfig struct {
+	ChannelIds []string `toml:"channelIds"`
+	VideoIds   []string `toml:"videoIds"`
+	Tables     []string `toml:"tables"`
+	Retention  int64    `toml:"retention"`
+}
+
+type ylccRetentionConfig struct {
+	CleanInterval          int64                      `toml:"cleanInterval"`
+	Video                  int64                      `toml:"video"`
+	ActiveLiveChatMessage  int64                      `toml:"activeLiveChatMessage"`
+	ArchiveLiveChatMessage int64                      `toml:"archiveLiveChatMessage"`
+	Rules                  []*ylccRetentionRuleConfig `toml:"rules"`
+}
+
 type ylccCollectorConfig struct {
-	ApiKeyFile   string `toml:"apiKeyFile"`
-	DatabasePath string `toml:"databasePath"`
+	ApiKeyFile            string               `toml:"apiKeyFile"`
+	DatabaseDriver        string               `toml:"databaseDriver"`
+	DatabasePath          string               `toml:"databasePath"`
+	DatabaseDsn           string               `toml:"databaseDsn"`
+	InstanceId            string               `toml:"instanceId"`
+	QuotaUnitsPerApiKey   int64                `toml:"quotaUnitsPerApiKey"`
+	AutoStopGracePeriod   int64                `toml:"autoStopGracePeriod"`
+	YoutubeBaseUrl        string               `toml:"youtubeBaseUrl"`
+	HttpRecordDir         string               `toml:"httpRecordDir"`
+	HttpReplayDir         string               `toml:"httpReplayDir"`
+	ApiEndpoint           string               `toml:"apiEndpoint"`
+	ActiveLiveChatBackend string               `toml:"activeLiveChatBackend"`
+	Retention             *ylccRetentionConfig `toml:"retention"`
+}
+
+type ylccWatchConfig struct {
+	ChannelIds        []string `toml:"channelIds"`
+	WatchInterval     int64    `toml:"watchInterval"`
+	DiscoveryInterval int64    `toml:"discoveryInterval"`
+	LeadTime          int64    `toml:"leadTime"`
+	ArchiveDelay      int64    `toml:"archiveDelay"`
 }
 
 type ylccServerConfig struct {
@@ -37,6 +76,7 @@ type ylccConfig struct {
 	Verbose   bool                 `toml:"verbose"`
 	Processor *ylccProcessorConfig `toml:"processor"`
 	Collector *ylccCollectorConfig `toml:"collector"`
+	Watch     *ylccWatchConfig     `toml:"watch"`
 	Server    *ylccServerConfig    `toml:"server"`
 	Log       *ylccLogConfig       `toml:"log"`
 }
@@ -57,6 +97,254 @@ func verboseLoadedConfig(config *ylccConfig) {
 	log.Printf("loaded config: %v", string(j))
 }
 
+func activeLiveChatBackend(backend string) pb.ActiveLiveChatBackend {
+	switch backend {
+	case "", "dataApi":
+		return pb.ActiveLiveChatBackend_DATA_API_BACKEND
+	case "innertube":
+		return pb.ActiveLiveChatBackend_INNERTUBE_BACKEND
+	}
+	log.Fatalf("invalid active live chat backend: %v", backend)
+	return pb.ActiveLiveChatBackend_DEFAULT_BACKEND
+}
+
+func retentionPolicy(retentionConfig *ylccRetentionConfig) *collector.RetentionPolicy {
+	if retentionConfig == nil {
+		return nil
+	}
+	rules := make([]*collector.RetentionRule, 0, len(retentionConfig.Rules))
+	for _, ruleConfig := range retentionConfig.Rules {
+		rules = append(rules, &collector.RetentionRule{
+			ChannelIds: ruleConfig.ChannelIds,
+			VideoIds:   ruleConfig.VideoIds,
+			Tables:     ruleConfig.Tables,
+			Retention:  time.Duration(ruleConfig.Retention) * time.Second,
+		})
+	}
+	return &collector.RetentionPolicy{
+		Video:                  time.Duration(retentionConfig.Video) * time.Second,
+		ActiveLiveChatMessage:  time.Duration(retentionConfig.ActiveLiveChatMessage) * time.Second,
+		ArchiveLiveChatMessage: time.Duration(retentionConfig.ArchiveLiveChatMessage) * time.Second,
+		Rules:                  rules,
+	}
+}
+
+func databaseDataSourceName(collectorConfig *ylccCollectorConfig) string {
+	if collectorConfig.DatabaseDriver == collector.DatabaseDriverPostgres {
+		return collectorConfig.DatabaseDsn
+	}
+	return collectorConfig.DatabasePath
+}
+
+// migrate reports pending migrations of database and applies them unless statusOnly
+func migrate(conf *ylccConfig, statusOnly bool) {
+	storage, err := collector.NewStorage(
+		conf.Collector.DatabaseDriver,
+		databaseDataSourceName(conf.Collector),
+		collector.Verbose(conf.Verbose),
+	)
+	if err != nil {
+		log.Fatalf("can not create storage: %v", err)
+	}
+	migrator, ok := storage.(collector.Migrator)
+	if !ok {
+		log.Fatalf("storage does not support migration")
+	}
+	if err := migrator.Connect(); err != nil {
+		log.Fatalf("can not connect database: %v", err)
+	}
+	defer migrator.Close()
+	version, err := migrator.SchemaVersion()
+	if err != nil {
+		log.Fatalf("can not get schema version: %v", err)
+	}
+	pendingMigrations, err := migrator.PendingMigrations()
+	if err != nil {
+		log.Fatalf("can not get pending migrations: %v", err)
+	}
+	fmt.Printf("schema version: %v\n", version)
+	for _, migration := range pendingMigrations {
+		fmt.Printf("pending migration: %v %v\n", migration.Version, migration.Description)
+	}
+	if statusOnly || len(pendingMigrations) == 0 {
+		return
+	}
+	appliedMigrations, err := migrator.Migrate()
+	for _, migration := range appliedMigrations {
+		fmt.Printf("applied migration: %v %v\n", migration.Version, migration.Description)
+	}
+	if err != nil {
+		log.Fatalf("can not migrate database: %v", err)
+	}
+	version, err = migrator.SchemaVersion()
+	if err != nil {
+		log.Fatalf("can not get schema version: %v", err)
+	}
+	fmt.Printf("schema version: %v\n", version)
+}
+
+func exportSource(source string) pb.ChatMessageSource {
+	switch source {
+	case "", "active":
+		return pb.ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+	case "archive":
+		return pb.ChatMessageSource_ARCHIVE_LIVE_CHAT_SOURCE
+	}
+	log.Fatalf("invalid source: %v", source)
+	return pb.ChatMessageSource_ACTIVE_LIVE_CHAT_SOURCE
+}
+
+func exportFormat(format string) pb.ExportFormat {
+	switch format {
+	case "", "jsonl":
+		return pb.ExportFormat_JSONL_EXPORT_FORMAT
+	case "csv":
+		return pb.ExportFormat_CSV_EXPORT_FORMAT
+	case "parquet":
+		return pb.ExportFormat_PARQUET_EXPORT_FORMAT
+	}
+	log.Fatalf("invalid format: %v", format)
+	return pb.ExportFormat_JSONL_EXPORT_FORMAT
+}
+
+// export writes live chat stored in database to output without starting server,
+// videos of output are written to videos file, <output>.videos.json or stderr when output is stdout
+func export(conf *ylccConfig, args []string) {
+	var videoId string
+	var channelId string
+	var source string
+	var format string
+	var fields string
+	var since int64
+	var until int64
+	var output string
+	var videosOutput string
+	flagSet := flag.NewFlagSet("export", flag.ExitOnError)
+	flagSet.StringVar(&videoId, "video", "", "video id")
+	flagSet.StringVar(&channelId, "channel", "", "channel id")
+	flagSet.StringVar(&source, "source", "active", "<active | archive>")
+	flagSet.StringVar(&format, "format", "jsonl", "<jsonl | csv | parquet>")
+	flagSet.StringVar(&fields, "fields", "", "comma separated fields, empty means all fields")
+	flagSet.Int64Var(&since, "since", 0, "unix time in seconds")
+	flagSet.Int64Var(&until, "until", 0, "unix time in seconds")
+	flagSet.StringVar(&output, "output", "", "output file, empty means stdout")
+	flagSet.StringVar(&videosOutput, "videos", "", "videos file, empty means <output>.videos.json or stderr when output is stdout")
+	flagSet.Parse(args)
+	if videosOutput == "" && output != "" {
+		videosOutput = output + ".videos.json"
+	}
+	request := &pb.ExportLiveChatRequest{
+		Source:    exportSource(source),
+		VideoId:   videoId,
+		ChannelId: channelId,
+		Format:    exportFormat(format),
+		Since:     since,
+		Until:     until,
+	}
+	if fields != "" {
+		request.Fields = strings.Split(fields, ",")
+	}
+	storage, err := collector.NewStorage(
+		conf.Collector.DatabaseDriver,
+		databaseDataSourceName(conf.Collector),
+		collector.Verbose(conf.Verbose),
+	)
+	if err != nil {
+		log.Fatalf("can not create storage: %v", err)
+	}
+	if err := storage.Open(); err != nil {
+		log.Fatalf("can not open storage: %v", err)
+	}
+	defer storage.Close()
+	file := os.Stdout
+	if output != "" {
+		file, err = os.Create(output)
+		if err != nil {
+			log.Fatalf("can not create output file: %v", err)
+		}
+		defer file.Close()
+	}
+	exporter := collector.NewExporter(storage, collector.Verbose(conf.Verbose))
+	err = exporter.Export(request, func(response *pb.ExportLiveChatResponse) error {
+		if response.Status.Code != pb.Code_SUCCESS {
+			return fmt.Errorf("%v", response.Status.Message)
+		}
+		if response.Sequence == 0 {
+			videos, err := json.MarshalIndent(response.Videos, "", "  ")
+			if err != nil {
+				return fmt.Errorf("can not encode videos: %w", err)
+			}
+			// videos are not mixed into stdout, because stdout is the file of chunks
+			if videosOutput == "" {
+				if _, err := os.Stderr.Write(append(videos, '\n')); err != nil {
+					return fmt.Errorf("can not write videos: %w", err)
+				}
+			} else if err := os.WriteFile(videosOutput, videos, 0644); err != nil {
+				return fmt.Errorf("can not write videos: %w", err)
+			}
+		}
+		if _, err := file.Write(response.Chunk); err != nil {
+			return fmt.Errorf("can not write chunk: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatalf("can not export live chat: %v", err)
+	}
+}
+
+func importFormat(format string) pb.ImportFormat {
+	switch format {
+	case "", "yt-dlp":
+		return pb.ImportFormat_YT_DLP_IMPORT_FORMAT
+	case "chat-downloader":
+		return pb.ImportFormat_CHAT_DOWNLOADER_IMPORT_FORMAT
+	}
+	log.Fatalf("invalid format: %v", format)
+	return pb.ImportFormat_YT_DLP_IMPORT_FORMAT
+}
+
+// importArchiveLiveChat writes file of yt-dlp or chat-downloader to database without starting server,
+// channelId is required when video is not stored
+func importArchiveLiveChat(conf *ylccConfig, args []string) {
+	var videoId string
+	var channelId string
+	var format string
+	var input string
+	flagSet := flag.NewFlagSet("import", flag.ExitOnError)
+	flagSet.StringVar(&videoId, "video", "", "video id")
+	flagSet.StringVar(&channelId, "channel", "", "channel id of video")
+	flagSet.StringVar(&format, "format", "yt-dlp", "<yt-dlp | chat-downloader>")
+	flagSet.StringVar(&input, "input", "", "input file, empty means stdin")
+	flagSet.Parse(args)
+	storage, err := collector.NewStorage(
+		conf.Collector.DatabaseDriver,
+		databaseDataSourceName(conf.Collector),
+		collector.Verbose(conf.Verbose),
+	)
+	if err != nil {
+		log.Fatalf("can not create storage: %v", err)
+	}
+	if err := storage.Open(); err != nil {
+		log.Fatalf("can not open storage: %v", err)
+	}
+	defer storage.Close()
+	file := os.Stdin
+	if input != "" {
+		file, err = os.Open(input)
+		if err != nil {
+			log.Fatalf("can not open input file: %v", err)
+		}
+		defer file.Close()
+	}
+	importer := collector.NewImporter(storage, collector.Verbose(conf.Verbose))
+	response := importer.Import(videoId, channelId, importFormat(format), file)
+	fmt.Printf("imported: %v, skipped: %v\n", response.Imported, response.Skipped)
+	if response.Status.Code != pb.Code_SUCCESS {
+		log.Fatalf("can not import archive live chat: %v", response.Status.Message)
+	}
+}
+
 func main() {
 	cmdArgs := new(commandArguments)
 	flag.StringVar(&cmdArgs.configFile, "config", "./ylcc.conf", "config file")
@@ -81,18 +369,72 @@ func main() {
 		log.SetOutput(logger)
 	}
 	verboseLoadedConfig(&conf)
+	// ylcc [-config <config file>] migrate [status]
+	if flag.Arg(0) == "migrate" {
+		migrate(&conf, flag.Arg(1) == "status")
+		return
+	}
+	// ylcc [-config <config file>] export [-video <video id>] [-channel <channel id>] [-source <source>] [-format <format>] ...
+	if flag.Arg(0) == "export" {
+		export(&conf, flag.Args()[1:])
+		return
+	}
+	// ylcc [-config <config file>] import -video <video id> [-channel <channel id>] [-format <format>] [-input <file>]
+	if flag.Arg(0) == "import" {
+		importArchiveLiveChat(&conf, flag.Args()[1:])
+		return
+	}
 	apiKeys, err := configurator.LoadSecretFile(conf.Collector.ApiKeyFile)
 	if err != nil {
 		log.Fatalf("can not load secret file %v: %v", conf.Collector.ApiKeyFile, err)
 	}
-	if len(apiKeys) != 1 {
+	if len(apiKeys) < 1 {
 		log.Fatalf("no api key")
 	}
 	cVerboseOpt := collector.Verbose(conf.Verbose)
+	cQuotaUnitsPerApiKeyOpt := collector.QuotaUnitsPerApiKey(conf.Collector.QuotaUnitsPerApiKey)
+	cAutoStopGracePeriodOpt := collector.AutoStopGracePeriod(time.Duration(conf.Collector.AutoStopGracePeriod) * time.Second)
+	cYoutubeBaseUrlOpt := collector.YoutubeBaseUrl(conf.Collector.YoutubeBaseUrl)
+	cHTTPRecordDirOpt := collector.HTTPRecordDir(conf.Collector.HttpRecordDir)
+	cHTTPReplayDirOpt := collector.HTTPReplayDir(conf.Collector.HttpReplayDir)
+	cApiEndpointOpt := collector.ApiEndpoint(conf.Collector.ApiEndpoint)
+	cActiveLiveChatBackendOpt := collector.ActiveLiveChatBackend(activeLiveChatBackend(conf.Collector.ActiveLiveChatBackend))
+	cDatabaseDriverOpt := collector.DatabaseDriver(conf.Collector.DatabaseDriver)
+	cInstanceIdOpt := collector.InstanceId(conf.Collector.InstanceId)
+	cRetentionOpt := collector.Retention(retentionPolicy(conf.Collector.Retention))
+	var cleanInterval int64
+	if conf.Collector.Retention != nil {
+		cleanInterval = conf.Collector.Retention.CleanInterval
+	}
+	cCleanIntervalOpt := collector.CleanInterval(time.Duration(cleanInterval) * time.Second)
+	if conf.Watch == nil {
+		conf.Watch = new(ylccWatchConfig)
+	}
+	cWatchChannelIdsOpt := collector.WatchChannelIds(conf.Watch.ChannelIds)
+	cWatchIntervalOpt := collector.WatchInterval(time.Duration(conf.Watch.WatchInterval) * time.Second)
+	cDiscoveryIntervalOpt := collector.DiscoveryInterval(time.Duration(conf.Watch.DiscoveryInterval) * time.Second)
+	cLeadTimeOpt := collector.LeadTime(time.Duration(conf.Watch.LeadTime) * time.Second)
+	cArchiveDelayOpt := collector.ArchiveDelay(time.Duration(conf.Watch.ArchiveDelay) * time.Second)
 	newCollector, err := collector.NewCollector(
 		apiKeys,
-		conf.Collector.DatabasePath,
+		databaseDataSourceName(conf.Collector),
 		cVerboseOpt,
+		cQuotaUnitsPerApiKeyOpt,
+		cAutoStopGracePeriodOpt,
+		cYoutubeBaseUrlOpt,
+		cHTTPRecordDirOpt,
+		cHTTPReplayDirOpt,
+		cApiEndpointOpt,
+		cActiveLiveChatBackendOpt,
+		cWatchChannelIdsOpt,
+		cWatchIntervalOpt,
+		cDiscoveryIntervalOpt,
+		cLeadTimeOpt,
+		cArchiveDelayOpt,
+		cRetentionOpt,
+		cCleanIntervalOpt,
+		cDatabaseDriverOpt,
+		cInstanceIdOpt,
 	)
 	if err != nil {
 		log.Fatalf("can not create controller: %v", err)
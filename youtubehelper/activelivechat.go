@@ -3,39 +3,67 @@ package youtubehelper
 import (
 	"context"
 	"fmt"
-	"google.golang.org/api/option"
 	"google.golang.org/api/youtube/v3"
 	"time"
 )
 
+const (
+	videosListIdMax int = 50
+)
+
 type ActiveLiveChatParams struct {
 	videoId          string
 	activeLiveChatId string
 	pageToken        string
+	// parameters of watch page for innertube
+	innertubeParams ArchiveLiveChatParams
+}
+
+func NewActiveLiveChatParams(videoId string, activeLiveChatId string, pageToken string) *ActiveLiveChatParams {
+	return &ActiveLiveChatParams{
+		videoId:          videoId,
+		activeLiveChatId: activeLiveChatId,
+		pageToken:        pageToken,
+	}
+}
+
+func (a *ActiveLiveChatParams) GetVideoId() string {
+	return a.videoId
 }
 
 func (a *ActiveLiveChatParams) GetPageToken() string {
 	return a.pageToken
 }
 
+func (a *ActiveLiveChatParams) SetPageToken(pageToken string) {
+	a.pageToken = pageToken
+}
+
+func (a *ActiveLiveChatParams) GetActiveLiveChatId() string {
+	return a.activeLiveChatId
+}
+
 type ActiveLiveChatCollector struct {
-	verbose bool
-	apiKey  string
+	verbose    bool
+	apiKeyPool *ApiKeyPool
 }
 
-func (a *ActiveLiveChatCollector) CreateYoutubeService() (*youtube.Service, error) {
-	ctx := context.Background()
-	youtubeService, err := youtube.NewService(ctx, option.WithAPIKey(a.apiKey))
-	if err != nil {
-		return nil, fmt.Errorf("can not create youtube service: %w", err)
-	}
-	return youtubeService, nil
+func (a *ActiveLiveChatCollector) GetApiKeyUsage() []*ApiKeyUsage {
+	return a.apiKeyPool.Usage()
 }
 
-func (a *ActiveLiveChatCollector) GetVideo(videoId string, youtubeService *youtube.Service) (*youtube.Video, bool, error) {
-	videosListCall := youtubeService.Videos.List([]string{"snippet", "contentDetails", "liveStreamingDetails", "status"})
-	videosListCall.Id(videoId)
-	videoListResponse, err := videosListCall.Do()
+func (a *ActiveLiveChatCollector) GetVideo(videoId string) (*youtube.Video, bool, error) {
+	var videoListResponse *youtube.VideoListResponse
+	err := a.apiKeyPool.Do(VideosListQuotaCost, func(youtubeService *youtube.Service) error {
+		videosListCall := youtubeService.Videos.List([]string{"snippet", "contentDetails", "liveStreamingDetails", "status"})
+		videosListCall.Id(videoId)
+		response, err := videosListCall.Do()
+		if err != nil {
+			return err
+		}
+		videoListResponse = response
+		return nil
+	})
 	if err != nil {
 		return nil, false, fmt.Errorf("can not get videos (videoId = %v): %w", videoId, err)
 	}
@@ -45,38 +73,80 @@ func (a *ActiveLiveChatCollector) GetVideo(videoId string, youtubeService *youtu
 	return videoListResponse.Items[0], true, nil
 }
 
-func (a *ActiveLiveChatCollector) CreateParams(video *youtube.Video) (*ActiveLiveChatParams, error) {
+// GetVideos looks up videos at once, ids which are not found are omitted
+func (a *ActiveLiveChatCollector) GetVideos(videoIds []string) ([]*youtube.Video, error) {
+	videos := make([]*youtube.Video, 0, len(videoIds))
+	for start := 0; start < len(videoIds); start += videosListIdMax {
+		end := start + videosListIdMax
+		if end > len(videoIds) {
+			end = len(videoIds)
+		}
+		var videoListResponse *youtube.VideoListResponse
+		err := a.apiKeyPool.Do(VideosListQuotaCost, func(youtubeService *youtube.Service) error {
+			videosListCall := youtubeService.Videos.List([]string{"snippet", "contentDetails", "liveStreamingDetails", "status"})
+			videosListCall.Id(videoIds[start:end]...)
+			response, err := videosListCall.Do()
+			if err != nil {
+				return err
+			}
+			videoListResponse = response
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("can not get videos (videoIds = %v): %w", videoIds[start:end], err)
+		}
+		videos = append(videos, videoListResponse.Items...)
+	}
+	return videos, nil
+}
+
+func (a *ActiveLiveChatCollector) CreateParams(video *youtube.Video, pageToken string) (*ActiveLiveChatParams, error) {
 	if video.LiveStreamingDetails.ActiveLiveChatId == "" {
 		return nil, fmt.Errorf("not active live chat (videoId = %v)", video.Id)
 	}
 	return &ActiveLiveChatParams{
 		videoId:          video.Id,
 		activeLiveChatId: video.LiveStreamingDetails.ActiveLiveChatId,
-		pageToken:        "",
+		pageToken:        pageToken,
 	}, nil
 }
 
-func (a *ActiveLiveChatCollector) GetActiveLiveChat(params *ActiveLiveChatParams, youtubeService *youtube.Service, max int64) (*youtube.LiveChatMessageListResponse, error) {
-	liveChatMessagesListCall := youtubeService.LiveChatMessages.List(params.activeLiveChatId, []string{"snippet", "authorDetails"})
-	liveChatMessagesListCall.PageToken(params.pageToken)
-	liveChatMessagesListCall.MaxResults(max)
-	liveChatMessageListResponse, err := liveChatMessagesListCall.Do()
+func (a *ActiveLiveChatCollector) GetActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, max int64) (*youtube.LiveChatMessageListResponse, error) {
+	var liveChatMessageListResponse *youtube.LiveChatMessageListResponse
+	err := a.apiKeyPool.Do(LiveChatMessagesListQuotaCost, func(youtubeService *youtube.Service) error {
+		liveChatMessagesListCall := youtubeService.LiveChatMessages.List(params.activeLiveChatId, []string{"snippet", "authorDetails"})
+		liveChatMessagesListCall.PageToken(params.pageToken)
+		liveChatMessagesListCall.MaxResults(max)
+		liveChatMessagesListCall.Context(ctx)
+		response, err := liveChatMessagesListCall.Do()
+		if err != nil {
+			return err
+		}
+		liveChatMessageListResponse = response
+		return nil
+	})
 	if err != nil {
 		return nil, fmt.Errorf("can not get live chat messages (videoId = %v, activeLiveChatId = %v): %w", params.videoId, params.activeLiveChatId, err)
 	}
 	return liveChatMessageListResponse, nil
 }
 
-func (a *ActiveLiveChatCollector) Next(params *ActiveLiveChatParams, liveChatMessageListResponse *youtube.LiveChatMessageListResponse) bool {
+func (a *ActiveLiveChatCollector) Next(ctx context.Context, params *ActiveLiveChatParams, liveChatMessageListResponse *youtube.LiveChatMessageListResponse) bool {
 	if liveChatMessageListResponse.NextPageToken == "" {
 		return false
 	}
 	params.pageToken = liveChatMessageListResponse.NextPageToken
-	time.Sleep(time.Duration(liveChatMessageListResponse.PollingIntervalMillis) * time.Millisecond)
-	return true
+	timer := time.NewTimer(time.Duration(liveChatMessageListResponse.PollingIntervalMillis) * time.Millisecond)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
 }
 
-func NewActiveLiveChatCollector(apiKey string, opts ...Option) *ActiveLiveChatCollector {
+func NewActiveLiveChatCollector(apiKeys []string, opts ...Option) *ActiveLiveChatCollector {
 	baseOpts := defaultOptions()
 	for _, opt := range opts {
 		if opt == nil {
@@ -85,7 +155,7 @@ func NewActiveLiveChatCollector(apiKey string, opts ...Option) *ActiveLiveChatCo
 		opt(baseOpts)
 	}
 	return &ActiveLiveChatCollector{
-		verbose: baseOpts.verbose,
-		apiKey:  apiKey,
+		verbose:    baseOpts.verbose,
+		apiKeyPool: NewApiKeyPool(apiKeys, opts...),
 	}
 }
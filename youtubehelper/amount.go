@@ -0,0 +1,74 @@
+package youtubehelper
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// currencyCodes maps symbols of purchaseAmountText to currency codes,
+// symbols are formatted for english and some currencies are written as currency code (e.g. CHF 5.00)
+var currencyCodes = map[string]string{
+	"$":   "USD",
+	"US$": "USD",
+	"CA$": "CAD",
+	"A$":  "AUD",
+	"NZ$": "NZD",
+	"HK$": "HKD",
+	"NT$": "TWD",
+	"MX$": "MXN",
+	"R$":  "BRL",
+	"¥":   "JPY",
+	"￥":   "JPY",
+	"€":   "EUR",
+	"£":   "GBP",
+	"₩":   "KRW",
+	"₹":   "INR",
+	"₱":   "PHP",
+	"₪":   "ILS",
+	"₫":   "VND",
+	"₺":   "TRY",
+	"₽":   "RUB",
+}
+
+// ParsePurchaseAmountText parses purchaseAmountText of paid message (e.g. ¥1,000 or $5.00) to currency and amount in micros,
+// currency is symbol itself when currency code of symbol is unknown
+func ParsePurchaseAmountText(purchaseAmountText string) (string, int64, bool) {
+	text := strings.TrimSpace(strings.ReplaceAll(purchaseAmountText, "\u00a0", " "))
+	start := strings.IndexFunc(text, unicode.IsDigit)
+	if start < 0 {
+		return "", 0, false
+	}
+	end := strings.LastIndexFunc(text, unicode.IsDigit) + 1
+	symbol := strings.TrimSpace(text[:start] + text[end:])
+	if symbol == "" {
+		return "", 0, false
+	}
+	currency, ok := currencyCodes[symbol]
+	if !ok {
+		currency = symbol
+	}
+	number := text[start:end]
+	// comma followed by two digits is decimal separator of some locales (e.g. 5,00 €)
+	if !strings.Contains(number, ".") {
+		if i := strings.LastIndex(number, ","); i >= 0 && len(number)-i-1 == 2 {
+			number = number[:i] + "." + number[i+1:]
+		}
+	}
+	number = strings.NewReplacer(",", "", " ", "").Replace(number)
+	integerPart := number
+	fractionPart := ""
+	if i := strings.Index(number, "."); i >= 0 {
+		integerPart = number[:i]
+		fractionPart = number[i+1:]
+	}
+	if len(fractionPart) > 6 {
+		fractionPart = fractionPart[:6]
+	}
+	fractionPart += strings.Repeat("0", 6-len(fractionPart))
+	amountMicros, err := strconv.ParseInt(integerPart+fractionPart, 10, 64)
+	if err != nil {
+		return "", 0, false
+	}
+	return currency, amountMicros, true
+}
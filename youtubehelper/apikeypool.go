@@ -0,0 +1,226 @@
+package youtubehelper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	"google.golang.org/api/youtube/v3"
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultQuotaUnitsPerApiKey    int64 = 10000
+	VideosListQuotaCost           int64 = 1
+	LiveChatMessagesListQuotaCost int64 = 5
+)
+
+type ApiKeyUsage struct {
+	Index              int
+	MaskedApiKey       string
+	UsedUnits          int64
+	QuotaUnits         int64
+	Requests           int64
+	QuotaExceededCount int64
+	Exhausted          bool
+	Current            bool
+	LastError          string
+	ResetAt            time.Time
+}
+
+type apiKeyState struct {
+	index              int
+	apiKey             string
+	youtubeService     *youtube.Service
+	usedUnits          int64
+	requests           int64
+	quotaExceededCount int64
+	exhausted          bool
+	lastError          string
+}
+
+type ApiKeyPool struct {
+	verbose     bool
+	quotaUnits  int64
+	apiEndpoint string
+	mutex       *sync.Mutex
+	states      []*apiKeyState
+	current     int
+	resetAt     time.Time
+}
+
+func (a *ApiKeyPool) maskApiKey(apiKey string) string {
+	if len(apiKey) <= 8 {
+		return "****"
+	}
+	return apiKey[:4] + "****" + apiKey[len(apiKey)-4:]
+}
+
+func (a *ApiKeyPool) nextResetTime(now time.Time) time.Time {
+	// youtube data api quota is reset at midnight pacific time
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		loc = time.FixedZone("PST", -8*3600)
+	}
+	pt := now.In(loc)
+	return time.Date(pt.Year(), pt.Month(), pt.Day()+1, 0, 0, 0, 0, loc)
+}
+
+func (a *ApiKeyPool) resetIfNeeded(now time.Time) {
+	if now.Before(a.resetAt) {
+		return
+	}
+	if a.verbose {
+		log.Printf("reset quota usage of api keys (resetAt = %v)", a.resetAt)
+	}
+	for _, state := range a.states {
+		state.usedUnits = 0
+		state.requests = 0
+		state.exhausted = false
+	}
+	a.resetAt = a.nextResetTime(now)
+}
+
+func (a *ApiKeyPool) acquire(cost int64) (*apiKeyState, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.resetIfNeeded(time.Now())
+	for i := 0; i < len(a.states); i += 1 {
+		idx := (a.current + i) % len(a.states)
+		state := a.states[idx]
+		if state.exhausted {
+			continue
+		}
+		if state.usedUnits+cost > a.quotaUnits {
+			if a.verbose {
+				log.Printf("estimated quota of api key is used up (apiKey = %v, usedUnits = %v)", a.maskApiKey(state.apiKey), state.usedUnits)
+			}
+			state.exhausted = true
+			continue
+		}
+		if idx != a.current && a.verbose {
+			log.Printf("rotate api key (from = %v, to = %v)", a.current, idx)
+		}
+		a.current = idx
+		if state.youtubeService == nil {
+			clientOpts := []option.ClientOption{option.WithAPIKey(state.apiKey)}
+			if a.apiEndpoint != "" {
+				clientOpts = append(clientOpts, option.WithEndpoint(a.apiEndpoint))
+			}
+			youtubeService, err := youtube.NewService(context.Background(), clientOpts...)
+			if err != nil {
+				return nil, fmt.Errorf("can not create youtube service: %w", err)
+			}
+			state.youtubeService = youtubeService
+		}
+		return state, nil
+	}
+	return nil, fmt.Errorf("%w (resetAt = %v)", ErrApiKeysExhausted, a.resetAt)
+}
+
+func (a *ApiKeyPool) consume(state *apiKeyState, cost int64, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	state.usedUnits += cost
+	state.requests += 1
+	if err != nil {
+		state.lastError = err.Error()
+	}
+}
+
+func (a *ApiKeyPool) exhaust(state *apiKeyState, err error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.verbose {
+		log.Printf("api key is exhausted (apiKey = %v): %v", a.maskApiKey(state.apiKey), err)
+	}
+	state.exhausted = true
+	state.quotaExceededCount += 1
+	state.lastError = err.Error()
+}
+
+func (a *ApiKeyPool) isQuotaError(err error) bool {
+	var apiErr *googleapi.Error
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code != http.StatusForbidden {
+		return false
+	}
+	for _, errorItem := range apiErr.Errors {
+		switch errorItem.Reason {
+		case "quotaExceeded", "dailyLimitExceeded", "accessNotConfigured", "ipRefererBlocked", "keyExpired":
+			return true
+		}
+	}
+	return false
+}
+
+func (a *ApiKeyPool) Do(cost int64, fn func(youtubeService *youtube.Service) error) error {
+	for {
+		state, err := a.acquire(cost)
+		if err != nil {
+			return err
+		}
+		err = fn(state.youtubeService)
+		a.consume(state, cost, err)
+		if err != nil && a.isQuotaError(err) {
+			a.exhaust(state, err)
+			continue
+		}
+		return err
+	}
+}
+
+func (a *ApiKeyPool) Usage() []*ApiKeyUsage {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.resetIfNeeded(time.Now())
+	usage := make([]*ApiKeyUsage, 0, len(a.states))
+	for _, state := range a.states {
+		usage = append(usage, &ApiKeyUsage{
+			Index:              state.index,
+			MaskedApiKey:       a.maskApiKey(state.apiKey),
+			UsedUnits:          state.usedUnits,
+			QuotaUnits:         a.quotaUnits,
+			Requests:           state.requests,
+			QuotaExceededCount: state.quotaExceededCount,
+			Exhausted:          state.exhausted,
+			Current:            state.index == a.current,
+			LastError:          state.lastError,
+			ResetAt:            a.resetAt,
+		})
+	}
+	return usage
+}
+
+func NewApiKeyPool(apiKeys []string, opts ...Option) *ApiKeyPool {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	states := make([]*apiKeyState, 0, len(apiKeys))
+	for i, apiKey := range apiKeys {
+		states = append(states, &apiKeyState{
+			index:  i,
+			apiKey: apiKey,
+		})
+	}
+	apiKeyPool := &ApiKeyPool{
+		verbose:     baseOpts.verbose,
+		quotaUnits:  baseOpts.quotaUnitsPerApiKey,
+		apiEndpoint: baseOpts.apiEndpoint,
+		mutex:       new(sync.Mutex),
+		states:      states,
+		current:     0,
+	}
+	apiKeyPool.resetAt = apiKeyPool.nextResetTime(time.Now())
+	return apiKeyPool
+}
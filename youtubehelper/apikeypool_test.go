@@ -0,0 +1,172 @@
+package youtubehelper
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/youtube/v3"
+)
+
+func newGoogleApiError(code int, reason string) error {
+	return &googleapi.Error{
+		Code:   code,
+		Errors: []googleapi.ErrorItem{{Reason: reason}},
+	}
+}
+
+func TestApiKeyPoolIsQuotaError(t *testing.T) {
+	a := NewApiKeyPool([]string{"fakeApiKey0000000001"})
+	tests := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("quotaExceeded"), false},
+		{newGoogleApiError(http.StatusForbidden, "quotaExceeded"), true},
+		{newGoogleApiError(http.StatusForbidden, "dailyLimitExceeded"), true},
+		{newGoogleApiError(http.StatusForbidden, "accessNotConfigured"), true},
+		{newGoogleApiError(http.StatusForbidden, "ipRefererBlocked"), true},
+		{newGoogleApiError(http.StatusForbidden, "keyExpired"), true},
+		{fmt.Errorf("can not get videos: %w", newGoogleApiError(http.StatusForbidden, "quotaExceeded")), true},
+		{newGoogleApiError(http.StatusForbidden, "forbidden"), false},
+		{newGoogleApiError(http.StatusForbidden, "liveChatDisabled"), false},
+		{newGoogleApiError(http.StatusTooManyRequests, "rateLimitExceeded"), false},
+		{newGoogleApiError(http.StatusBadRequest, "quotaExceeded"), false},
+	}
+	for _, test := range tests {
+		if got := a.isQuotaError(test.err); got != test.want {
+			t.Errorf("isQuotaError(%v) = %v, want %v", test.err, got, test.want)
+		}
+	}
+}
+
+func checkApiKeyUsage(t *testing.T, a *ApiKeyPool, current int, usedUnits []int64, exhausted []bool) {
+	t.Helper()
+	for i, usage := range a.Usage() {
+		if usage.Current != (i == current) || usage.UsedUnits != usedUnits[i] || usage.Exhausted != exhausted[i] {
+			t.Errorf("usage of api key %v = (current = %v, usedUnits = %v, exhausted = %v), want (%v, %v, %v)",
+				i, usage.Current, usage.UsedUnits, usage.Exhausted, i == current, usedUnits[i], exhausted[i])
+		}
+	}
+}
+
+func TestApiKeyPoolRotation(t *testing.T) {
+	a := NewApiKeyPool([]string{"fakeApiKey0000000001", "fakeApiKey0000000002", "fakeApiKey0000000003"}, QuotaUnitsPerApiKey(10))
+	calls := 0
+	succeed := func(youtubeService *youtube.Service) error {
+		calls += 1
+		return nil
+	}
+	quotaExceeded := newGoogleApiError(http.StatusForbidden, "quotaExceeded")
+
+	// costs of calls are added to the current api key
+	if err := a.Do(VideosListQuotaCost, succeed); err != nil {
+		t.Fatalf("can not call with the first api key: %v", err)
+	}
+	if err := a.Do(LiveChatMessagesListQuotaCost, succeed); err != nil {
+		t.Fatalf("can not call with the first api key: %v", err)
+	}
+	checkApiKeyUsage(t, a, 0, []int64{6, 0, 0}, []bool{false, false, false})
+
+	// api key whose estimated usage exceeds quota is skipped
+	if err := a.Do(LiveChatMessagesListQuotaCost, succeed); err != nil {
+		t.Fatalf("can not call with the second api key: %v", err)
+	}
+	checkApiKeyUsage(t, a, 1, []int64{6, 5, 0}, []bool{true, false, false})
+
+	// error which is not about quota is returned without rotation
+	err := a.Do(VideosListQuotaCost, func(youtubeService *youtube.Service) error {
+		return newGoogleApiError(http.StatusInternalServerError, "backendError")
+	})
+	if err == nil {
+		t.Fatalf("error of call is not returned")
+	}
+	checkApiKeyUsage(t, a, 1, []int64{6, 6, 0}, []bool{true, false, false})
+
+	// quota error rotates to the next api key and the call is retried
+	calls = 0
+	err = a.Do(VideosListQuotaCost, func(youtubeService *youtube.Service) error {
+		calls += 1
+		if calls == 1 {
+			return quotaExceeded
+		}
+		return nil
+	})
+	if err != nil || calls != 2 {
+		t.Fatalf("call is not retried with the next api key (calls = %v): %v", calls, err)
+	}
+	checkApiKeyUsage(t, a, 2, []int64{6, 7, 1}, []bool{true, true, false})
+	if usage := a.Usage()[1]; usage.QuotaExceededCount != 1 || usage.LastError != quotaExceeded.Error() {
+		t.Errorf("quota error of api key is not recorded: %+v", usage)
+	}
+
+	// all api keys are exhausted
+	calls = 0
+	err = a.Do(VideosListQuotaCost, func(youtubeService *youtube.Service) error {
+		calls += 1
+		return quotaExceeded
+	})
+	if !errors.Is(err, ErrApiKeysExhausted) || calls != 1 {
+		t.Errorf("error = %v (calls = %v), want %v", err, calls, ErrApiKeysExhausted)
+	}
+	checkApiKeyUsage(t, a, 2, []int64{6, 7, 2}, []bool{true, true, true})
+}
+
+func TestApiKeyPoolResetsAtPacificMidnight(t *testing.T) {
+	a := NewApiKeyPool([]string{"fakeApiKey0000000001", "fakeApiKey0000000002"})
+	tests := []struct {
+		now  string
+		want string
+	}{
+		// PST
+		{"2022-01-01T07:59:59Z", "2022-01-01T08:00:00Z"},
+		{"2022-01-01T08:00:00Z", "2022-01-02T08:00:00Z"},
+		// PDT
+		{"2022-07-01T06:59:59Z", "2022-07-01T07:00:00Z"},
+		{"2022-07-01T07:00:00Z", "2022-07-02T07:00:00Z"},
+		// the day when PDT starts has 23 hours
+		{"2022-03-13T08:00:00Z", "2022-03-14T07:00:00Z"},
+	}
+	for _, test := range tests {
+		now, err := time.Parse(time.RFC3339, test.now)
+		if err != nil {
+			t.Fatalf("can not parse now: %v", err)
+		}
+		want, err := time.Parse(time.RFC3339, test.want)
+		if err != nil {
+			t.Fatalf("can not parse want: %v", err)
+		}
+		if got := a.nextResetTime(now); !got.Equal(want) {
+			t.Errorf("nextResetTime(%v) = %v, want %v", test.now, got.UTC(), test.want)
+		}
+	}
+
+	resetAt, err := time.Parse(time.RFC3339, "2022-01-01T08:00:00Z")
+	if err != nil {
+		t.Fatalf("can not parse resetAt: %v", err)
+	}
+	a.resetAt = resetAt
+	for _, state := range a.states {
+		state.usedUnits = 100
+		state.requests = 10
+		state.quotaExceededCount = 1
+		state.exhausted = true
+	}
+	a.resetIfNeeded(resetAt.Add(-time.Second))
+	if state := a.states[0]; state.usedUnits != 100 || !state.exhausted || !a.resetAt.Equal(resetAt) {
+		t.Errorf("usage is reset before resetAt: %+v (resetAt = %v)", state, a.resetAt)
+	}
+	a.resetIfNeeded(resetAt)
+	for _, state := range a.states {
+		if state.usedUnits != 0 || state.requests != 0 || state.exhausted || state.quotaExceededCount != 1 {
+			t.Errorf("usage is not reset at resetAt: %+v", state)
+		}
+	}
+	if want := resetAt.Add(24 * time.Hour); !a.resetAt.Equal(want) {
+		t.Errorf("resetAt = %v, want %v", a.resetAt, want)
+	}
+}
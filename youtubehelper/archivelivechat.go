@@ -2,20 +2,24 @@ package youtubehelper
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"log"
 	"net/http"
 	"net/url"
 	"regexp"
+	"strconv"
 )
 
 const (
-	youtubeBaseUrl               string = "https://www.youtube.com/watch?v="
-	youtubeLiveChatReplayBaseUrl string = "https://www.youtube.com/live_chat_replay?continuation="
-	youtubeLiveChatApiBaseUrl    string = "https://www.youtube.com/youtubei/v1/live_chat/get_live_chat_replay?key="
-	userAgent                    string = "Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/89.0.4389.91 Safari/537.36"
+	DefaultBaseUrl            string = "https://www.youtube.com"
+	youtubeWatchPath          string = "/watch?v="
+	youtubeLiveChatReplayPath string = "/live_chat_replay?continuation="
+	youtubeLiveChatApiPath    string = "/youtubei/v1/live_chat/get_live_chat_replay?key="
+	userAgent                 string = "Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/89.0.4389.91 Safari/537.36"
 )
 
 type ArchiveLiveChatParams map[string]string
@@ -25,20 +29,21 @@ func (a ArchiveLiveChatParams) GetContinuation() string {
 }
 
 type ArchiveLiveChatCollector struct {
-	verbose bool
-	res     map[string]*regexp.Regexp
+	verbose    bool
+	res        map[string]*regexp.Regexp
+	httpClient *http.Client
+	baseUrl    string
 }
 
-func (a *ArchiveLiveChatCollector) httpRequest(url string, method string, header map[string]string, reqBody io.Reader) ([]byte, error) {
-	req, err := http.NewRequest(method, url, reqBody)
+func (a *ArchiveLiveChatCollector) httpRequest(ctx context.Context, url string, method string, header map[string]string, reqBody io.Reader) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("can not create http request (url = %v): %w", url, err)
 	}
 	for k, v := range header {
 		req.Header.Set(k, v)
 	}
-	client := new(http.Client)
-	resp, err := client.Do(req)
+	resp, err := a.httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("can not request of http (url = %v): %w", url, err)
 	}
@@ -64,14 +69,19 @@ func (a *ArchiveLiveChatCollector) getParam(re *regexp.Regexp, body []byte) (str
 	return string(v[0][1]), nil
 }
 
-func (a *ArchiveLiveChatCollector) GetParams(videoId string) (ArchiveLiveChatParams, error) {
-	ytUrl := youtubeBaseUrl + videoId
+func (a *ArchiveLiveChatCollector) GetParams(ctx context.Context, videoId string) (ArchiveLiveChatParams, error) {
+	ytUrl := a.baseUrl + youtubeWatchPath + videoId
 	header := make(map[string]string)
 	header["User-Agent"] = userAgent
-	body, err := a.httpRequest(ytUrl, "GET", header, nil)
+	body, err := a.httpRequest(ctx, ytUrl, "GET", header, nil)
 	if err != nil {
 		return nil, fmt.Errorf("can not get video page (url = %v,  heade = %+v): %v", ytUrl, header, err)
 	}
+	return a.ParseParams(body)
+}
+
+// ParseParams extracts parameters for getting archive live chat from video page
+func (a *ArchiveLiveChatCollector) ParseParams(body []byte) (ArchiveLiveChatParams, error) {
 	params := make(map[string]string)
 	params["offsetMs"] = "0"
 	for name, re := range a.res {
@@ -84,7 +94,7 @@ func (a *ArchiveLiveChatCollector) GetParams(videoId string) (ArchiveLiveChatPar
 	return params, nil
 }
 
-func (a *ArchiveLiveChatCollector) buildRequestBody(params ArchiveLiveChatParams) (*bytes.Buffer, error) {
+func (a *ArchiveLiveChatCollector) buildRequestBody(params ArchiveLiveChatParams, liveChatPath string) (*bytes.Buffer, error) {
 	request := &GetLiveChatRequest{}
 	request.Context.Client.Hl = params["hl"]
 	request.Context.Client.Gl = params["gl"]
@@ -97,14 +107,14 @@ func (a *ArchiveLiveChatCollector) buildRequestBody(params ArchiveLiveChatParams
 	request.Context.Client.ClientVersion = params["clientVersion"]
 	request.Context.Client.OsName = params["osName"]
 	request.Context.Client.OsVersion = params["osVersion"]
-	request.Context.Client.OriginalURL = youtubeLiveChatReplayBaseUrl + url.QueryEscape(params["continuation"])
+	request.Context.Client.OriginalURL = a.baseUrl + liveChatPath + url.QueryEscape(params["continuation"])
 	request.Context.Client.Platform = params["platform"]
 	request.Context.Client.ClientFormFactor = params["clientFormFactor"]
 	request.Context.Client.TimeZone = "Asia/Tokyo"
 	request.Context.Client.BrowserName = params["browserName"]
 	request.Context.Client.BrowserVersion = params["browserVersion"]
 	request.Context.Client.UtcOffsetMinutes = 540
-	request.Context.Client.MainAppWebInfo.GraftURL = youtubeLiveChatReplayBaseUrl + url.QueryEscape(params["continuation"])
+	request.Context.Client.MainAppWebInfo.GraftURL = a.baseUrl + liveChatPath + url.QueryEscape(params["continuation"])
 	request.Context.Client.MainAppWebInfo.WebDisplayMode = "WEB_DISPLAY_MODE_BROWSER"
 	request.Context.User.LockedSafetyMode = false
 	request.Context.Request.UseSsl = true
@@ -114,25 +124,32 @@ func (a *ArchiveLiveChatCollector) buildRequestBody(params ArchiveLiveChatParams
 	if err != nil {
 		return nil, fmt.Errorf("can not convert struct to json: %v", err)
 	}
-	fmt.Printf("%v\n", string(requestBytes))
+	if a.verbose {
+		log.Printf("archive live chat request: %v", string(requestBytes))
+	}
 	return bytes.NewBuffer(requestBytes), nil
 }
 
-func (a *ArchiveLiveChatCollector) GetArchiveLiveChat(params ArchiveLiveChatParams) (*GetLiveChatRespose, error) {
-	reqBody, err := a.buildRequestBody(params)
+func (a *ArchiveLiveChatCollector) GetArchiveLiveChat(ctx context.Context, params ArchiveLiveChatParams) (*GetLiveChatRespose, error) {
+	reqBody, err := a.buildRequestBody(params, youtubeLiveChatReplayPath)
 	if err != nil {
 		return nil, fmt.Errorf("can not build request body: %v", err)
 	}
-	ytUrl := youtubeLiveChatApiBaseUrl + params["innertubeApiKey"]
+	ytUrl := a.baseUrl + youtubeLiveChatApiPath + params["innertubeApiKey"]
 	header := make(map[string]string)
 	header["User-Agent"] = userAgent
 	header["Content-Type"] = "application/json"
-	respBody, err := a.httpRequest(ytUrl, "POST", header, reqBody)
+	respBody, err := a.httpRequest(ctx, ytUrl, "POST", header, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("can not get archive live chat (url = %v, header = %+v, request =%v): %v", ytUrl, header, string(reqBody.Bytes()), err)
 	}
+	return a.ParseArchiveLiveChat(respBody)
+}
+
+// ParseArchiveLiveChat converts response body of get_live_chat_replay
+func (a *ArchiveLiveChatCollector) ParseArchiveLiveChat(respBody []byte) (*GetLiveChatRespose, error) {
 	resp := &GetLiveChatRespose{}
-	if err = json.Unmarshal(respBody, resp); err != nil {
+	if err := json.Unmarshal(respBody, resp); err != nil {
 		return nil, fmt.Errorf("can not convert json to struct: %v", err)
 	}
 	return resp, nil
@@ -185,8 +202,10 @@ func NewArchiveLiveChatCollector(opts ...Option) *ArchiveLiveChatCollector {
 	res["platform"] = regexp.MustCompile(`"platform"[ ]*:[ ]*"([^"]+)"`)
 	res["clientFormFactor"] = regexp.MustCompile(`"clientFormFactor"[ ]*:[ ]*"([^"]+)"`)
 	return &ArchiveLiveChatCollector{
-		verbose: baseOpts.verbose,
-		res:     res,
+		verbose:    baseOpts.verbose,
+		res:        res,
+		httpClient: &http.Client{Transport: baseOpts.transport},
+		baseUrl:    baseOpts.baseUrl,
 	}
 }
 
@@ -254,6 +273,7 @@ type GetLiveChatRespose struct {
 							ClientID string `json:"clientId"`
 							Item     struct {
 								LiveChatPaidMessageRenderer struct {
+									AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
 									AuthorExternalChannelID string `json:"authorExternalChannelId"`
 									AuthorName              struct {
 										SimpleText string `json:"simpleText"`
@@ -301,21 +321,7 @@ type GetLiveChatRespose struct {
 									TimestampUsec string `json:"timestampUsec"`
 								} `json:"liveChatPaidMessageRenderer"`
 								LiveChatTextMessageRenderer struct {
-									AuthorBadges []struct {
-										LiveChatAuthorBadgeRenderer struct {
-											Accessibility struct {
-												AccessibilityData struct {
-													Label string `json:"label"`
-												} `json:"accessibilityData"`
-											} `json:"accessibility"`
-											CustomThumbnail struct {
-												Thumbnails []struct {
-													URL string `json:"url"`
-												} `json:"thumbnails"`
-											} `json:"customThumbnail"`
-											Tooltip string `json:"tooltip"`
-										} `json:"liveChatAuthorBadgeRenderer"`
-									} `json:"authorBadges"`
+									AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
 									AuthorExternalChannelID string `json:"authorExternalChannelId"`
 									AuthorName              struct {
 										SimpleText string `json:"simpleText"`
@@ -371,6 +377,10 @@ type GetLiveChatRespose struct {
 									} `json:"timestampText"`
 									TimestampUsec string `json:"timestampUsec"`
 								} `json:"liveChatTextMessageRenderer"`
+								LiveChatPaidStickerRenderer                            LiveChatPaidStickerRenderer                            `json:"liveChatPaidStickerRenderer"`
+								LiveChatMembershipItemRenderer                         LiveChatMembershipItemRenderer                         `json:"liveChatMembershipItemRenderer"`
+								LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer   LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer   `json:"liveChatSponsorshipsGiftPurchaseAnnouncementRenderer"`
+								LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer `json:"liveChatSponsorshipsGiftRedemptionAnnouncementRenderer"`
 							} `json:"item"`
 						} `json:"addChatItemAction"`
 						AddLiveChatTickerItemAction struct {
@@ -492,3 +502,152 @@ type GetLiveChatRespose struct {
 		} `json:"webResponseContextExtensionData"`
 	} `json:"responseContext"`
 }
+
+type LiveChatText struct {
+	SimpleText string `json:"simpleText"`
+	Runs       []struct {
+		Text string `json:"text"`
+	} `json:"runs"`
+}
+
+func (l LiveChatText) String() string {
+	if l.SimpleText != "" {
+		return l.SimpleText
+	}
+	text := ""
+	for _, run := range l.Runs {
+		text += run.Text
+	}
+	return text
+}
+
+type LiveChatAuthorBadge struct {
+	LiveChatAuthorBadgeRenderer struct {
+		Accessibility struct {
+			AccessibilityData struct {
+				Label string `json:"label"`
+			} `json:"accessibilityData"`
+		} `json:"accessibility"`
+		CustomThumbnail struct {
+			Thumbnails []struct {
+				URL string `json:"url"`
+			} `json:"thumbnails"`
+		} `json:"customThumbnail"`
+		Icon struct {
+			IconType string `json:"iconType"`
+		} `json:"icon"`
+		Tooltip string `json:"tooltip"`
+	} `json:"liveChatAuthorBadgeRenderer"`
+}
+
+func (l LiveChatAuthorBadge) ThumbnailURL() string {
+	thumbnails := l.LiveChatAuthorBadgeRenderer.CustomThumbnail.Thumbnails
+	if len(thumbnails) == 0 {
+		return ""
+	}
+	return thumbnails[len(thumbnails)-1].URL
+}
+
+type LiveChatAuthorRole struct {
+	IsChatOwner     bool
+	IsChatModerator bool
+	IsChatSponsor   bool
+	IsVerified      bool
+}
+
+type LiveChatAuthorBadges []LiveChatAuthorBadge
+
+// Role returns author role from badges, member badge has custom thumbnail instead of icon
+func (l LiveChatAuthorBadges) Role() *LiveChatAuthorRole {
+	role := &LiveChatAuthorRole{}
+	for _, badge := range l {
+		switch badge.LiveChatAuthorBadgeRenderer.Icon.IconType {
+		case "OWNER":
+			role.IsChatOwner = true
+		case "MODERATOR":
+			role.IsChatModerator = true
+		case "VERIFIED", "CHECK_CIRCLE_THICK":
+			role.IsVerified = true
+		case "":
+			if badge.ThumbnailURL() != "" {
+				role.IsChatSponsor = true
+			}
+		}
+	}
+	return role
+}
+
+type LiveChatPaidStickerRenderer struct {
+	AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
+	AuthorExternalChannelID string               `json:"authorExternalChannelId"`
+	AuthorName              LiveChatText         `json:"authorName"`
+	ID                      string               `json:"id"`
+	PurchaseAmountText      LiveChatText         `json:"purchaseAmountText"`
+	Sticker                 struct {
+		Accessibility struct {
+			AccessibilityData struct {
+				Label string `json:"label"`
+			} `json:"accessibilityData"`
+		} `json:"accessibility"`
+	} `json:"sticker"`
+	TimestampText LiveChatText `json:"timestampText"`
+	TimestampUsec string       `json:"timestampUsec"`
+}
+
+type LiveChatMembershipItemRenderer struct {
+	AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
+	AuthorExternalChannelID string               `json:"authorExternalChannelId"`
+	AuthorName              LiveChatText         `json:"authorName"`
+	HeaderPrimaryText       LiveChatText         `json:"headerPrimaryText"`
+	HeaderSubtext           LiveChatText         `json:"headerSubtext"`
+	ID                      string               `json:"id"`
+	Message                 LiveChatText         `json:"message"`
+	TimestampText           LiveChatText         `json:"timestampText"`
+	TimestampUsec           string               `json:"timestampUsec"`
+}
+
+// HeaderText returns milestone text (e.g. Member for 12 months) or welcome text of new member
+func (l LiveChatMembershipItemRenderer) HeaderText() string {
+	if primaryText := l.HeaderPrimaryText.String(); primaryText != "" {
+		return primaryText
+	}
+	return l.HeaderSubtext.String()
+}
+
+var giftMembershipsCountRe = regexp.MustCompile(`[0-9]+`)
+
+type LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer struct {
+	AuthorExternalChannelID string `json:"authorExternalChannelId"`
+	Header                  struct {
+		LiveChatSponsorshipsHeaderRenderer struct {
+			AuthorBadges LiveChatAuthorBadges `json:"authorBadges"`
+			AuthorName   LiveChatText         `json:"authorName"`
+			PrimaryText  LiveChatText         `json:"primaryText"`
+		} `json:"liveChatSponsorshipsHeaderRenderer"`
+	} `json:"header"`
+	ID            string `json:"id"`
+	TimestampUsec string `json:"timestampUsec"`
+}
+
+// GiftMembershipsCount returns count of gifted memberships in primary text (e.g. Gifted 5 memberships)
+func (l LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer) GiftMembershipsCount() int64 {
+	v := giftMembershipsCountRe.FindString(l.Header.LiveChatSponsorshipsHeaderRenderer.PrimaryText.String())
+	if v == "" {
+		return 0
+	}
+	count, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+type LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer struct {
+	AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
+	AuthorExternalChannelID string               `json:"authorExternalChannelId"`
+	AuthorName              LiveChatText         `json:"authorName"`
+	ID                      string               `json:"id"`
+	Message                 LiveChatText         `json:"message"`
+	TimestampText           LiveChatText         `json:"timestampText"`
+	TimestampUsec           string               `json:"timestampUsec"`
+}
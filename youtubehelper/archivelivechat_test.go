@@ -0,0 +1,163 @@
+package youtubehelper
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fixtures of testdata/archivelivechat are recorded by RecordTransport and trimmed to a few messages,
+// they are replayed to detect changes of page layout of youtube
+const (
+	archiveLiveChatFixtureDir     = "testdata/archivelivechat"
+	archiveLiveChatFixtureVideoId = "fixtureVid0"
+)
+
+func newReplayArchiveLiveChatCollector(t *testing.T) *ArchiveLiveChatCollector {
+	t.Helper()
+	replayTransport, err := NewReplayTransport(archiveLiveChatFixtureDir)
+	if err != nil {
+		t.Fatalf("can not create replay transport: %v", err)
+	}
+	return NewArchiveLiveChatCollector(Transport(replayTransport))
+}
+
+var archiveLiveChatFixtureParams = map[string]string{
+	"continuation":     "op2w0wRgGlhDaWtxSndvWVZVTm1hWGgwZFhKbFEyaGhibTVsYkVsa01EQVNDMlpwZUhSMWNtVldhV1F3R2hPcXVjRzlBUTBLQzJacGVIUjFjbVZXYVdRd0lBRSUzRCUzRA",
+	"visitorData":      "CgtGaXh0dXJlVmlzaXRvcg%3D%3D",
+	"innertubeApiKey":  "AIzaSyFixtureInnertubeKey",
+	"browserName":      "Chrome",
+	"browserVersion":   "89.0.4389.91",
+	"clientName":       "WEB",
+	"clientVersion":    "2.20220101.00.00",
+	"remoteHost":       "203.0.113.10",
+	"gl":               "JP",
+	"hl":               "ja",
+	"osName":           "Windows",
+	"osVersion":        "10.0",
+	"deviceMake":       "",
+	"deviceModel":      "",
+	"userAgent":        "Mozilla/5.0 (Windows NT 10.0; WOW64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/89.0.4389.91 Safari/537.36,gzip(gfe)",
+	"platform":         "DESKTOP",
+	"clientFormFactor": "UNKNOWN_FORM_FACTOR",
+}
+
+func TestArchiveLiveChatRegexps(t *testing.T) {
+	a := NewArchiveLiveChatCollector()
+	fixturePaths, err := filepath.Glob(filepath.Join(archiveLiveChatFixtureDir, "*.json"))
+	if err != nil {
+		t.Fatalf("can not list fixtures: %v", err)
+	}
+	var watchPage []byte
+	for _, fixturePath := range fixturePaths {
+		fixtureBytes, err := ioutil.ReadFile(fixturePath)
+		if err != nil {
+			t.Fatalf("can not read fixture (path = %v): %v", fixturePath, err)
+		}
+		fixture := &HTTPFixture{}
+		if err := json.Unmarshal(fixtureBytes, fixture); err != nil {
+			t.Fatalf("can not convert json to fixture (path = %v): %v", fixturePath, err)
+		}
+		if fixture.Method == "GET" && strings.HasSuffix(fixture.Url, youtubeWatchPath+archiveLiveChatFixtureVideoId) {
+			watchPage = []byte(fixture.Body)
+		}
+	}
+	if watchPage == nil {
+		t.Fatalf("not found fixture of watch page")
+	}
+	if len(a.res) != len(archiveLiveChatFixtureParams) {
+		t.Errorf("count of regexps = %v, want %v", len(a.res), len(archiveLiveChatFixtureParams))
+	}
+	for name, re := range a.res {
+		want, ok := archiveLiveChatFixtureParams[name]
+		if !ok {
+			t.Errorf("no expected value of regexp (name = %v)", name)
+			continue
+		}
+		v, err := a.getParam(re, watchPage)
+		if err != nil {
+			t.Errorf("regexp does not match watch page (name = %v): %v", name, err)
+			continue
+		}
+		if v != want {
+			t.Errorf("param %v = %q, want %q", name, v, want)
+		}
+	}
+}
+
+func TestArchiveLiveChatGetParams(t *testing.T) {
+	a := newReplayArchiveLiveChatCollector(t)
+	params, err := a.GetParams(context.Background(), archiveLiveChatFixtureVideoId)
+	if err != nil {
+		t.Fatalf("can not get params: %v", err)
+	}
+	for name, want := range archiveLiveChatFixtureParams {
+		if params[name] != want {
+			t.Errorf("param %v = %q, want %q", name, params[name], want)
+		}
+	}
+	if params["offsetMs"] != "0" {
+		t.Errorf("offsetMs = %q, want 0", params["offsetMs"])
+	}
+}
+
+func TestArchiveLiveChatGetArchiveLiveChat(t *testing.T) {
+	a := newReplayArchiveLiveChatCollector(t)
+	params, err := a.GetParams(context.Background(), archiveLiveChatFixtureVideoId)
+	if err != nil {
+		t.Fatalf("can not get params: %v", err)
+	}
+
+	resp, err := a.GetArchiveLiveChat(context.Background(), params)
+	if err != nil {
+		t.Fatalf("can not get first page: %v", err)
+	}
+	actions := resp.ContinuationContents.LiveChatContinuation.Actions
+	if len(actions) != 2 {
+		t.Fatalf("count of actions of first page = %v, want 2", len(actions))
+	}
+	text := actions[0].ReplayChatItemAction.Actions[0].AddChatItemAction.Item.LiveChatTextMessageRenderer
+	if text.ID != "ChwKGkNOX2ZpeHR1cmVfdGV4dF9tZXNzYWdlXzAx" || text.AuthorExternalChannelID != "UCfixtureViewer000000001" ||
+		text.AuthorName.SimpleText != "Fixture Viewer" || text.TimestampUsec != "1640995210000000" {
+		t.Errorf("unexpected text message: %+v", text)
+	}
+	if len(text.Message.Runs) != 2 || text.Message.Runs[0].Text != "こんにちは " || text.Message.Runs[1].Emoji.EmojiID != "👋" {
+		t.Errorf("unexpected runs of text message: %+v", text.Message.Runs)
+	}
+	if !text.AuthorBadges.Role().IsChatSponsor {
+		t.Errorf("author of text message is not sponsor: %+v", text.AuthorBadges)
+	}
+	paid := actions[1].ReplayChatItemAction.Actions[0].AddChatItemAction.Item.LiveChatPaidMessageRenderer
+	if paid.ID != "ChwKGkNOX2ZpeHR1cmVfcGFpZF9tZXNzYWdlXzAx" || paid.PurchaseAmountText.SimpleText != "￥1,000" || paid.Message.Runs[0].Text != "応援しています" {
+		t.Errorf("unexpected paid message: %+v", paid)
+	}
+	if !a.Next(params, resp) {
+		t.Fatalf("no continuation after first page")
+	}
+	if params["offsetMs"] != "20000" {
+		t.Errorf("offsetMs = %q, want 20000", params["offsetMs"])
+	}
+
+	resp, err = a.GetArchiveLiveChat(context.Background(), params)
+	if err != nil {
+		t.Fatalf("can not get second page: %v", err)
+	}
+	actions = resp.ContinuationContents.LiveChatContinuation.Actions
+	if len(actions) != 2 {
+		t.Fatalf("count of actions of second page = %v, want 2", len(actions))
+	}
+	membership := actions[0].ReplayChatItemAction.Actions[0].AddChatItemAction.Item.LiveChatMembershipItemRenderer
+	if membership.AuthorExternalChannelID != "UCfixtureMember000000001" || membership.HeaderText() != "Fixture Channel へようこそ！" {
+		t.Errorf("unexpected membership item: %+v", membership)
+	}
+	moderator := actions[1].ReplayChatItemAction.Actions[0].AddChatItemAction.Item.LiveChatTextMessageRenderer
+	if !moderator.AuthorBadges.Role().IsChatModerator {
+		t.Errorf("author of text message is not moderator: %+v", moderator.AuthorBadges)
+	}
+	if a.Next(params, resp) {
+		t.Errorf("continuation after last page (continuation = %v)", params["continuation"])
+	}
+}
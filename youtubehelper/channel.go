@@ -0,0 +1,77 @@
+package youtubehelper
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/url"
+)
+
+const (
+	youtubeChannelFeedPath string = "/feeds/videos.xml?channel_id="
+)
+
+// ChannelFeed is atom feed of recent videos of channel
+type ChannelFeed struct {
+	Entries []struct {
+		VideoId   string `xml:"videoId"`
+		ChannelId string `xml:"channelId"`
+		Title     string `xml:"title"`
+		Published string `xml:"published"`
+	} `xml:"entry"`
+}
+
+// ChannelCollector discovers videos of channel from channel feed, it does not consume quota of api key
+type ChannelCollector struct {
+	verbose                  bool
+	archiveLiveChatCollector *ArchiveLiveChatCollector
+}
+
+func (c *ChannelCollector) GetChannelFeed(ctx context.Context, channelId string) (*ChannelFeed, error) {
+	ytUrl := c.archiveLiveChatCollector.baseUrl + youtubeChannelFeedPath + url.QueryEscape(channelId)
+	header := make(map[string]string)
+	header["User-Agent"] = userAgent
+	respBody, err := c.archiveLiveChatCollector.httpRequest(ctx, ytUrl, "GET", header, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can not get channel feed (channelId = %v): %w", channelId, err)
+	}
+	return c.ParseChannelFeed(respBody)
+}
+
+// ParseChannelFeed converts response body of channel feed
+func (c *ChannelCollector) ParseChannelFeed(respBody []byte) (*ChannelFeed, error) {
+	channelFeed := &ChannelFeed{}
+	if err := xml.Unmarshal(respBody, channelFeed); err != nil {
+		return nil, fmt.Errorf("can not convert xml to struct: %w", err)
+	}
+	return channelFeed, nil
+}
+
+func (c *ChannelCollector) GetChannelVideoIds(ctx context.Context, channelId string) ([]string, error) {
+	channelFeed, err := c.GetChannelFeed(ctx, channelId)
+	if err != nil {
+		return nil, err
+	}
+	videoIds := make([]string, 0, len(channelFeed.Entries))
+	for _, entry := range channelFeed.Entries {
+		if entry.VideoId == "" {
+			continue
+		}
+		videoIds = append(videoIds, entry.VideoId)
+	}
+	return videoIds, nil
+}
+
+func NewChannelCollector(opts ...Option) *ChannelCollector {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &ChannelCollector{
+		verbose:                  baseOpts.verbose,
+		archiveLiveChatCollector: NewArchiveLiveChatCollector(opts...),
+	}
+}
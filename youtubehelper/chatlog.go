@@ -0,0 +1,175 @@
+package youtubehelper
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// ReadYtDlpLiveChat reads .live_chat.json of yt-dlp, which has an action of get_live_chat_replay per line,
+// and calls cbFunc with response which has at most count actions
+func ReadYtDlpLiveChat(reader io.Reader, count int, cbFunc func(resp *GetLiveChatRespose) error) error {
+	bufReader := bufio.NewReader(reader)
+	lines := make([][]byte, 0, count)
+	lineNumber := 0
+	flush := func() error {
+		if len(lines) == 0 {
+			return nil
+		}
+		resp := &GetLiveChatRespose{}
+		actions := append(append([]byte{'['}, bytes.Join(lines, []byte{','})...), ']')
+		if err := json.Unmarshal(actions, &resp.ContinuationContents.LiveChatContinuation.Actions); err != nil {
+			return fmt.Errorf("can not unmarshal actions before line %v: %w", lineNumber, err)
+		}
+		lines = lines[:0]
+		return cbFunc(resp)
+	}
+	for {
+		line, err := bufReader.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("can not read line %v: %w", lineNumber+1, err)
+		}
+		if len(line) > 0 {
+			lineNumber += 1
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			if !json.Valid(line) {
+				return fmt.Errorf("invalid json at line %v", lineNumber)
+			}
+			lines = append(lines, line)
+			if len(lines) >= count {
+				if err := flush(); err != nil {
+					return err
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+	return flush()
+}
+
+type ChatDownloaderBadge struct {
+	Title    string `json:"title"`
+	IconName string `json:"icon_name"`
+	Icons    []struct {
+		URL string `json:"url"`
+		ID  string `json:"id"`
+	} `json:"icons"`
+}
+
+// ThumbnailURL returns url of the first icon
+func (c ChatDownloaderBadge) ThumbnailURL() string {
+	if len(c.Icons) == 0 {
+		return ""
+	}
+	return c.Icons[0].URL
+}
+
+// ChatDownloaderMessage is a message of chat-downloader (https://github.com/xenova/chat-downloader)
+type ChatDownloaderMessage struct {
+	ActionType    string  `json:"action_type"`
+	MessageID     string  `json:"message_id"`
+	MessageType   string  `json:"message_type"`
+	Message       string  `json:"message"`
+	Timestamp     int64   `json:"timestamp"`
+	TimeInSeconds float64 `json:"time_in_seconds"`
+	TimeText      string  `json:"time_text"`
+	Author        struct {
+		ID     string                `json:"id"`
+		Name   string                `json:"name"`
+		Badges []ChatDownloaderBadge `json:"badges"`
+	} `json:"author"`
+	Money struct {
+		Amount         float64 `json:"amount"`
+		Currency       string  `json:"currency"`
+		CurrencySymbol string  `json:"currency_symbol"`
+		Text           string  `json:"text"`
+	} `json:"money"`
+	HeaderPrimaryText   string `json:"header_primary_text"`
+	HeaderSecondaryText string `json:"header_secondary_text"`
+}
+
+// HeaderText returns milestone text or welcome text of new member like LiveChatMembershipItemRenderer
+func (c *ChatDownloaderMessage) HeaderText() string {
+	if c.HeaderPrimaryText != "" {
+		return c.HeaderPrimaryText
+	}
+	return c.HeaderSecondaryText
+}
+
+// GiftMembershipsCount returns count of gifted memberships in header or message (e.g. Gifted 5 memberships)
+func (c *ChatDownloaderMessage) GiftMembershipsCount() int64 {
+	v := giftMembershipsCountRe.FindString(c.HeaderText())
+	if v == "" {
+		v = giftMembershipsCountRe.FindString(c.Message)
+	}
+	if v == "" {
+		return 0
+	}
+	count, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return count
+}
+
+// ReadChatDownloaderMessages reads json array written by chat-downloader (--output *.json) or json object per line,
+// and calls cbFunc with at most count messages
+func ReadChatDownloaderMessages(reader io.Reader, count int, cbFunc func(messages []*ChatDownloaderMessage) error) error {
+	bufReader := bufio.NewReader(reader)
+	isArray := false
+	for {
+		b, err := bufReader.ReadByte()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("can not read messages: %w", err)
+		}
+		if b == ' ' || b == '\t' || b == '\r' || b == '\n' {
+			continue
+		}
+		if err := bufReader.UnreadByte(); err != nil {
+			return fmt.Errorf("can not read messages: %w", err)
+		}
+		isArray = b == '['
+		break
+	}
+	decoder := json.NewDecoder(bufReader)
+	if isArray {
+		if _, err := decoder.Token(); err != nil {
+			return fmt.Errorf("can not read start of array: %w", err)
+		}
+	}
+	messages := make([]*ChatDownloaderMessage, 0, count)
+	index := 0
+	for decoder.More() {
+		message := &ChatDownloaderMessage{}
+		if err := decoder.Decode(message); err != nil {
+			return fmt.Errorf("can not decode message (index = %v): %w", index, err)
+		}
+		index += 1
+		messages = append(messages, message)
+		if len(messages) >= count {
+			if err := cbFunc(messages); err != nil {
+				return err
+			}
+			messages = make([]*ChatDownloaderMessage, 0, count)
+		}
+	}
+	if isArray {
+		if _, err := decoder.Token(); err != nil {
+			return fmt.Errorf("can not read end of array: %w", err)
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return cbFunc(messages)
+}
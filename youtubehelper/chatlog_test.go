@@ -0,0 +1,97 @@
+package youtubehelper
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fixtures of testdata/chatlog are written by hand in formats of yt-dlp and chat-downloader with a few messages
+const chatLogFixtureDir = "testdata/chatlog"
+
+func openChatLogFixture(t *testing.T, name string) *os.File {
+	t.Helper()
+	file, err := os.Open(filepath.Join(chatLogFixtureDir, name))
+	if err != nil {
+		t.Fatalf("can not open fixture (name = %v): %v", name, err)
+	}
+	t.Cleanup(func() { file.Close() })
+	return file
+}
+
+func TestReadYtDlpLiveChat(t *testing.T) {
+	actionCounts := make([]int, 0)
+	messageIds := make([]string, 0)
+	err := ReadYtDlpLiveChat(openChatLogFixture(t, "ytdlp.live_chat.json"), 2, func(resp *GetLiveChatRespose) error {
+		actionCounts = append(actionCounts, len(resp.ContinuationContents.LiveChatContinuation.Actions))
+		for _, cact := range resp.ContinuationContents.LiveChatContinuation.Actions {
+			for _, iact := range cact.ReplayChatItemAction.Actions {
+				item := iact.AddChatItemAction.Item
+				if item.LiveChatTextMessageRenderer.ID != "" {
+					messageIds = append(messageIds, item.LiveChatTextMessageRenderer.ID)
+				} else if item.LiveChatPaidMessageRenderer.ID != "" {
+					messageIds = append(messageIds, item.LiveChatPaidMessageRenderer.ID)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("can not read yt-dlp live chat: %v", err)
+	}
+	// empty line is skipped
+	if len(actionCounts) != 2 || actionCounts[0] != 2 || actionCounts[1] != 2 {
+		t.Errorf("counts of actions per callback = %v, want [2 2]", actionCounts)
+	}
+	wantMessageIds := []string{"fixtureYtDlpText01", "fixtureYtDlpPaid01", "fixtureYtDlpText02"}
+	if strings.Join(messageIds, ",") != strings.Join(wantMessageIds, ",") {
+		t.Errorf("messageIds = %v, want %v", messageIds, wantMessageIds)
+	}
+
+	err = ReadYtDlpLiveChat(strings.NewReader("{\"replayChatItemAction\":{}}\n{\"replayChatItemAction\":\n"), 10, func(resp *GetLiveChatRespose) error {
+		return nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("error of invalid line = %v, want error at line 2", err)
+	}
+}
+
+func TestReadChatDownloaderMessages(t *testing.T) {
+	messageCounts := make([]int, 0)
+	messages := make([]*ChatDownloaderMessage, 0)
+	err := ReadChatDownloaderMessages(openChatLogFixture(t, "chatdownloader.json"), 3, func(chunk []*ChatDownloaderMessage) error {
+		messageCounts = append(messageCounts, len(chunk))
+		messages = append(messages, chunk...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("can not read chat-downloader messages: %v", err)
+	}
+	if len(messageCounts) != 3 || messageCounts[0] != 3 || messageCounts[1] != 3 || messageCounts[2] != 1 {
+		t.Fatalf("counts of messages per callback = %v, want [3 3 1]", messageCounts)
+	}
+	paid := messages[1]
+	if paid.MessageID != "fixtureChatDownloaderPaid01" || paid.Money.Text != "¥1,000" || paid.Money.Currency != "JPY" ||
+		paid.Author.Badges[0].ThumbnailURL() != "https://yt3.ggpht.com/fixture-member-badge=s16-c-k" {
+		t.Errorf("unexpected paid message: %+v", paid)
+	}
+	if headerText := messages[3].HeaderText(); headerText != "Welcome to Fixture Channel!" {
+		t.Errorf("header text of membership = %q", headerText)
+	}
+	if giftMembershipsCount := messages[4].GiftMembershipsCount(); giftMembershipsCount != 5 {
+		t.Errorf("gift memberships count = %v, want 5", giftMembershipsCount)
+	}
+
+	// json object per line
+	messageIds := make([]string, 0)
+	err = ReadChatDownloaderMessages(strings.NewReader("\n{\"message_id\":\"a\"}\n{\"message_id\":\"b\"}\n"), 10, func(chunk []*ChatDownloaderMessage) error {
+		for _, message := range chunk {
+			messageIds = append(messageIds, message.MessageID)
+		}
+		return nil
+	})
+	if err != nil || strings.Join(messageIds, ",") != "a,b" {
+		t.Errorf("messages of json lines = %v (err = %v), want [a b]", messageIds, err)
+	}
+}
@@ -0,0 +1,100 @@
+package youtubehelper
+
+import (
+	"context"
+	"google.golang.org/api/youtube/v3"
+)
+
+// VideoSource looks up video metadata
+type VideoSource interface {
+	GetVideo(videoId string) (*youtube.Video, bool, error)
+}
+
+// ActiveLiveChatSource fetches pages of active live chat
+type ActiveLiveChatSource interface {
+	CreateActiveLiveChatParams(video *youtube.Video, pageToken string) (*ActiveLiveChatParams, error)
+	GetActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, max int64) (*youtube.LiveChatMessageListResponse, error)
+	// NextActiveLiveChat waits polling interval and updates page token of params, it returns false when live chat is ended
+	NextActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, liveChatMessageListResponse *youtube.LiveChatMessageListResponse) bool
+}
+
+// ArchiveLiveChatSource fetches continuations of archive live chat
+type ArchiveLiveChatSource interface {
+	GetArchiveLiveChatParams(ctx context.Context, videoId string) (ArchiveLiveChatParams, error)
+	GetArchiveLiveChat(ctx context.Context, params ArchiveLiveChatParams) (*GetLiveChatRespose, error)
+	// NextArchiveLiveChat updates continuation of params, it returns false when there is no more continuation
+	NextArchiveLiveChat(params ArchiveLiveChatParams, resp *GetLiveChatRespose) bool
+}
+
+// ChatSource is source of video metadata, active live chat and archive live chat used by collector
+type ChatSource interface {
+	VideoSource
+	ActiveLiveChatSource
+	ArchiveLiveChatSource
+}
+
+// ChannelSource discovers videos of channel, it is implemented by ChatSource which supports watching channel
+type ChannelSource interface {
+	GetChannelVideoIds(ctx context.Context, channelId string) ([]string, error)
+	GetVideos(videoIds []string) ([]*youtube.Video, error)
+}
+
+// ApiKeyUsageSource is implemented by ChatSource which uses api keys
+type ApiKeyUsageSource interface {
+	GetApiKeyUsage() []*ApiKeyUsage
+}
+
+// YoutubeChatSource is ChatSource of youtube data api and archive live chat page
+type YoutubeChatSource struct {
+	activeLiveChatCollector  *ActiveLiveChatCollector
+	archiveLiveChatCollector *ArchiveLiveChatCollector
+	channelCollector         *ChannelCollector
+}
+
+func (y *YoutubeChatSource) GetVideo(videoId string) (*youtube.Video, bool, error) {
+	return y.activeLiveChatCollector.GetVideo(videoId)
+}
+
+func (y *YoutubeChatSource) CreateActiveLiveChatParams(video *youtube.Video, pageToken string) (*ActiveLiveChatParams, error) {
+	return y.activeLiveChatCollector.CreateParams(video, pageToken)
+}
+
+func (y *YoutubeChatSource) GetActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, max int64) (*youtube.LiveChatMessageListResponse, error) {
+	return y.activeLiveChatCollector.GetActiveLiveChat(ctx, params, max)
+}
+
+func (y *YoutubeChatSource) NextActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, liveChatMessageListResponse *youtube.LiveChatMessageListResponse) bool {
+	return y.activeLiveChatCollector.Next(ctx, params, liveChatMessageListResponse)
+}
+
+func (y *YoutubeChatSource) GetArchiveLiveChatParams(ctx context.Context, videoId string) (ArchiveLiveChatParams, error) {
+	return y.archiveLiveChatCollector.GetParams(ctx, videoId)
+}
+
+func (y *YoutubeChatSource) GetArchiveLiveChat(ctx context.Context, params ArchiveLiveChatParams) (*GetLiveChatRespose, error) {
+	return y.archiveLiveChatCollector.GetArchiveLiveChat(ctx, params)
+}
+
+func (y *YoutubeChatSource) NextArchiveLiveChat(params ArchiveLiveChatParams, resp *GetLiveChatRespose) bool {
+	return y.archiveLiveChatCollector.Next(params, resp)
+}
+
+func (y *YoutubeChatSource) GetChannelVideoIds(ctx context.Context, channelId string) ([]string, error) {
+	return y.channelCollector.GetChannelVideoIds(ctx, channelId)
+}
+
+func (y *YoutubeChatSource) GetVideos(videoIds []string) ([]*youtube.Video, error) {
+	return y.activeLiveChatCollector.GetVideos(videoIds)
+}
+
+func (y *YoutubeChatSource) GetApiKeyUsage() []*ApiKeyUsage {
+	return y.activeLiveChatCollector.GetApiKeyUsage()
+}
+
+func NewYoutubeChatSource(apiKeys []string, opts ...Option) *YoutubeChatSource {
+	return &YoutubeChatSource{
+		activeLiveChatCollector:  NewActiveLiveChatCollector(apiKeys, opts...),
+		archiveLiveChatCollector: NewArchiveLiveChatCollector(opts...),
+		channelCollector:         NewChannelCollector(opts...),
+	}
+}
@@ -0,0 +1,31 @@
+package youtubehelper
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var videoDurationRe = regexp.MustCompile(`^P(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// ParseVideoDuration parses ISO 8601 duration of contentDetails.duration (e.g. PT1H2M3S)
+func ParseVideoDuration(duration string) (time.Duration, error) {
+	matches := videoDurationRe.FindStringSubmatch(duration)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid video duration (duration = %v)", duration)
+	}
+	units := []time.Duration{7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.ParseInt(matches[i+1], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("can not parse video duration (duration = %v): %w", duration, err)
+		}
+		d += time.Duration(n) * unit
+	}
+	return d, nil
+}
@@ -0,0 +1,85 @@
+package youtubehelper
+
+import (
+	"errors"
+	"google.golang.org/api/googleapi"
+	"net"
+	"net/http"
+	"net/url"
+)
+
+var ErrApiKeysExhausted = errors.New("all api keys are exhausted")
+
+type ErrorClass int
+
+const (
+	ErrorClassUnknown ErrorClass = iota
+	ErrorClassTransient
+	ErrorClassRateLimited
+	ErrorClassQuota
+	ErrorClassChatEnded
+	ErrorClassForbidden
+)
+
+func (e ErrorClass) String() string {
+	switch e {
+	case ErrorClassTransient:
+		return "transient"
+	case ErrorClassRateLimited:
+		return "rateLimited"
+	case ErrorClassQuota:
+		return "quota"
+	case ErrorClassChatEnded:
+		return "chatEnded"
+	case ErrorClassForbidden:
+		return "forbidden"
+	default:
+		return "unknown"
+	}
+}
+
+func (e ErrorClass) Retryable() bool {
+	return e == ErrorClassTransient || e == ErrorClassRateLimited
+}
+
+func ClassifyError(err error) ErrorClass {
+	if err == nil {
+		return ErrorClassUnknown
+	}
+	if errors.Is(err, ErrApiKeysExhausted) {
+		return ErrorClassQuota
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		for _, errorItem := range apiErr.Errors {
+			switch errorItem.Reason {
+			case "liveChatEnded", "liveChatNotFound", "liveChatDisabled":
+				return ErrorClassChatEnded
+			case "rateLimitExceeded", "userRateLimitExceeded":
+				return ErrorClassRateLimited
+			case "quotaExceeded", "dailyLimitExceeded":
+				return ErrorClassQuota
+			}
+		}
+		switch {
+		case apiErr.Code == http.StatusTooManyRequests:
+			return ErrorClassRateLimited
+		case apiErr.Code >= http.StatusInternalServerError:
+			return ErrorClassTransient
+		case apiErr.Code == http.StatusNotFound:
+			return ErrorClassChatEnded
+		case apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden:
+			return ErrorClassForbidden
+		}
+		return ErrorClassUnknown
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ErrorClassTransient
+	}
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return ErrorClassTransient
+	}
+	return ErrorClassUnknown
+}
@@ -0,0 +1,512 @@
+// Package fakeyoutube is fake server of youtube data api for videos.list and liveChatMessages.list.
+// it also serves channel feed of registered videos.
+// responses are scripted by AddVideo, AddLiveChatPage, EndLiveChat and InjectError.
+package fakeyoutube
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"google.golang.org/api/youtube/v3"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	videosPath                   string = "/youtube/v3/videos"
+	liveChatMessagesPath         string = "/youtube/v3/liveChat/messages"
+	channelFeedPath              string = "/feeds/videos.xml"
+	defaultPollingIntervalMillis int64  = 100
+	pageTokenPrefix              string = "page-"
+)
+
+type options struct {
+	verbose bool
+}
+
+func defaultOptions() *options {
+	return &options{
+		verbose: false,
+	}
+}
+
+type Option func(*options)
+
+func Verbose(verbose bool) Option {
+	return func(opts *options) {
+		opts.verbose = verbose
+	}
+}
+
+// LiveChatPage is one response of liveChatMessages.list
+type LiveChatPage struct {
+	Messages              []*youtube.LiveChatMessage
+	PollingIntervalMillis int64
+}
+
+// ApiError is error response of youtube data api
+type ApiError struct {
+	Code    int
+	Reason  string
+	Message string
+}
+
+func QuotaExceededError() *ApiError {
+	return &ApiError{
+		Code:    http.StatusForbidden,
+		Reason:  "quotaExceeded",
+		Message: "The request cannot be completed because you have exceeded your quota.",
+	}
+}
+
+func RateLimitExceededError() *ApiError {
+	return &ApiError{
+		Code:    http.StatusTooManyRequests,
+		Reason:  "rateLimitExceeded",
+		Message: "The request cannot be completed because you have exceeded your rate limit.",
+	}
+}
+
+func BackendError() *ApiError {
+	return &ApiError{
+		Code:    http.StatusServiceUnavailable,
+		Reason:  "backendError",
+		Message: "Backend Error",
+	}
+}
+
+func LiveChatEndedError() *ApiError {
+	return &ApiError{
+		Code:    http.StatusForbidden,
+		Reason:  "liveChatEnded",
+		Message: "The live chat is no longer live.",
+	}
+}
+
+type liveChat struct {
+	pages []*LiveChatPage
+	ended bool
+}
+
+type Server struct {
+	verbose    bool
+	mutex      *sync.Mutex
+	videos     map[string]*youtube.Video
+	liveChats  map[string]*liveChat
+	errors     []*ApiError
+	requests   map[string]int
+	httpServer *httptest.Server
+}
+
+func (s *Server) writeJson(w http.ResponseWriter, code int, v interface{}) {
+	body, err := json.Marshal(v)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+func (s *Server) writeError(w http.ResponseWriter, apiError *ApiError) {
+	s.writeJson(w, apiError.Code, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    apiError.Code,
+			"message": apiError.Message,
+			"errors": []map[string]string{
+				{
+					"domain":  "youtube.api",
+					"reason":  apiError.Reason,
+					"message": apiError.Message,
+				},
+			},
+		},
+	})
+}
+
+func (s *Server) popError() *ApiError {
+	if len(s.errors) == 0 {
+		return nil
+	}
+	apiError := s.errors[0]
+	s.errors = s.errors[1:]
+	return apiError
+}
+
+func (s *Server) handleVideos(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests[videosPath] += 1
+	if apiError := s.popError(); apiError != nil {
+		s.writeError(w, apiError)
+		return
+	}
+	items := make([]*youtube.Video, 0)
+	for _, ids := range r.URL.Query()["id"] {
+		for _, id := range strings.Split(ids, ",") {
+			video, ok := s.videos[id]
+			if !ok {
+				continue
+			}
+			items = append(items, video)
+		}
+	}
+	s.writeJson(w, http.StatusOK, &youtube.VideoListResponse{
+		Kind:  "youtube#videoListResponse",
+		Items: items,
+		PageInfo: &youtube.PageInfo{
+			TotalResults:   int64(len(items)),
+			ResultsPerPage: int64(len(items)),
+		},
+	})
+}
+
+type feedEntry struct {
+	VideoId   string `xml:"yt:videoId"`
+	ChannelId string `xml:"yt:channelId"`
+	Title     string `xml:"title"`
+	Published string `xml:"published"`
+}
+
+type feed struct {
+	XMLName xml.Name     `xml:"feed"`
+	Xmlns   string       `xml:"xmlns,attr"`
+	XmlnsYt string       `xml:"xmlns:yt,attr"`
+	Entries []*feedEntry `xml:"entry"`
+}
+
+func (s *Server) handleChannelFeed(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests[channelFeedPath] += 1
+	channelId := r.URL.Query().Get("channel_id")
+	f := &feed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		XmlnsYt: "http://www.youtube.com/xml/schemas/2015",
+		Entries: make([]*feedEntry, 0),
+	}
+	for _, video := range s.videos {
+		if video.Snippet == nil || video.Snippet.ChannelId != channelId {
+			continue
+		}
+		f.Entries = append(f.Entries, &feedEntry{
+			VideoId:   video.Id,
+			ChannelId: video.Snippet.ChannelId,
+			Title:     video.Snippet.Title,
+			Published: video.Snippet.PublishedAt,
+		})
+	}
+	body, err := xml.Marshal(f)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/xml; charset=UTF-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
+
+func (s *Server) parsePageToken(pageToken string) (int, error) {
+	if pageToken == "" {
+		return 0, nil
+	}
+	if !strings.HasPrefix(pageToken, pageTokenPrefix) {
+		return 0, fmt.Errorf("invalid page token (pageToken = %v)", pageToken)
+	}
+	pageIdx, err := strconv.Atoi(strings.TrimPrefix(pageToken, pageTokenPrefix))
+	if err != nil {
+		return 0, fmt.Errorf("invalid page token (pageToken = %v): %w", pageToken, err)
+	}
+	return pageIdx, nil
+}
+
+func (s *Server) handleLiveChatMessages(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.requests[liveChatMessagesPath] += 1
+	if apiError := s.popError(); apiError != nil {
+		s.writeError(w, apiError)
+		return
+	}
+	liveChatId := r.URL.Query().Get("liveChatId")
+	lc, ok := s.liveChats[liveChatId]
+	if !ok {
+		s.writeError(w, &ApiError{
+			Code:    http.StatusNotFound,
+			Reason:  "liveChatNotFound",
+			Message: fmt.Sprintf("The live chat that you are trying to retrieve cannot be found. (liveChatId = %v)", liveChatId),
+		})
+		return
+	}
+	pageIdx, err := s.parsePageToken(r.URL.Query().Get("pageToken"))
+	if err != nil {
+		s.writeError(w, &ApiError{
+			Code:    http.StatusBadRequest,
+			Reason:  "pageTokenInvalid",
+			Message: err.Error(),
+		})
+		return
+	}
+	if pageIdx >= len(lc.pages) {
+		if lc.ended {
+			s.writeError(w, LiveChatEndedError())
+			return
+		}
+		// no new messages yet, same page token is returned
+		s.writeJson(w, http.StatusOK, &youtube.LiveChatMessageListResponse{
+			Kind:                  "youtube#liveChatMessageListResponse",
+			Items:                 make([]*youtube.LiveChatMessage, 0),
+			NextPageToken:         fmt.Sprintf("%v%v", pageTokenPrefix, pageIdx),
+			PollingIntervalMillis: defaultPollingIntervalMillis,
+			PageInfo:              &youtube.PageInfo{},
+		})
+		return
+	}
+	page := lc.pages[pageIdx]
+	pollingIntervalMillis := page.PollingIntervalMillis
+	if pollingIntervalMillis <= 0 {
+		pollingIntervalMillis = defaultPollingIntervalMillis
+	}
+	if s.verbose {
+		log.Printf("fake live chat messages (liveChatId = %v, page = %v, count = %v)", liveChatId, pageIdx, len(page.Messages))
+	}
+	s.writeJson(w, http.StatusOK, &youtube.LiveChatMessageListResponse{
+		Kind:                  "youtube#liveChatMessageListResponse",
+		Etag:                  fmt.Sprintf("etag-%v-%v", liveChatId, pageIdx),
+		Items:                 page.Messages,
+		NextPageToken:         fmt.Sprintf("%v%v", pageTokenPrefix, pageIdx+1),
+		PollingIntervalMillis: pollingIntervalMillis,
+		PageInfo: &youtube.PageInfo{
+			TotalResults:   int64(len(page.Messages)),
+			ResultsPerPage: int64(len(page.Messages)),
+		},
+	})
+}
+
+// Handler returns http handler of fake youtube data api
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc(videosPath, s.handleVideos)
+	mux.HandleFunc(liveChatMessagesPath, s.handleLiveChatMessages)
+	mux.HandleFunc(channelFeedPath, s.handleChannelFeed)
+	return mux
+}
+
+// AddVideo registers video returned from videos.list
+func (s *Server) AddVideo(video *youtube.Video) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.videos[video.Id] = video
+	if video.LiveStreamingDetails != nil && video.LiveStreamingDetails.ActiveLiveChatId != "" {
+		if _, ok := s.liveChats[video.LiveStreamingDetails.ActiveLiveChatId]; !ok {
+			s.liveChats[video.LiveStreamingDetails.ActiveLiveChatId] = &liveChat{
+				pages: make([]*LiveChatPage, 0),
+				ended: false,
+			}
+		}
+	}
+}
+
+// AddLiveChatPage appends page returned from liveChatMessages.list
+func (s *Server) AddLiveChatPage(liveChatId string, page *LiveChatPage) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	lc, ok := s.liveChats[liveChatId]
+	if !ok {
+		lc = &liveChat{
+			pages: make([]*LiveChatPage, 0),
+			ended: false,
+		}
+		s.liveChats[liveChatId] = lc
+	}
+	lc.pages = append(lc.pages, page)
+}
+
+// EndLiveChat returns liveChatEnded error after all pages are returned and marks the video as ended
+func (s *Server) EndLiveChat(liveChatId string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	lc, ok := s.liveChats[liveChatId]
+	if !ok {
+		return
+	}
+	lc.ended = true
+	for _, video := range s.videos {
+		if video.LiveStreamingDetails == nil || video.LiveStreamingDetails.ActiveLiveChatId != liveChatId {
+			continue
+		}
+		video.Snippet.LiveBroadcastContent = "none"
+		video.LiveStreamingDetails.ActiveLiveChatId = ""
+		video.LiveStreamingDetails.ActualEndTime = time.Now().UTC().Format(time.RFC3339)
+	}
+}
+
+// InjectError returns apiError to next request instead of normal response
+func (s *Server) InjectError(apiError *ApiError, count int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i := 0; i < count; i += 1 {
+		s.errors = append(s.errors, apiError)
+	}
+}
+
+// Requests returns number of requests of videos.list and liveChatMessages.list
+func (s *Server) Requests() (int, int) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.requests[videosPath], s.requests[liveChatMessagesPath]
+}
+
+// Start starts server on local address
+func (s *Server) Start() {
+	s.httpServer = httptest.NewServer(s.Handler())
+}
+
+// BaseUrl returns base url of channel feed for youtubehelper.BaseUrl or collector.YoutubeBaseUrl
+func (s *Server) BaseUrl() string {
+	if s.httpServer == nil {
+		return ""
+	}
+	return s.httpServer.URL
+}
+
+// Endpoint returns endpoint for youtubehelper.ApiEndpoint or collector.ApiEndpoint
+func (s *Server) Endpoint() string {
+	if s.httpServer == nil {
+		return ""
+	}
+	return s.httpServer.URL + "/"
+}
+
+func (s *Server) Stop() {
+	if s.httpServer == nil {
+		return
+	}
+	s.httpServer.Close()
+	s.httpServer = nil
+}
+
+func NewServer(opts ...Option) *Server {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &Server{
+		verbose:   baseOpts.verbose,
+		mutex:     new(sync.Mutex),
+		videos:    make(map[string]*youtube.Video),
+		liveChats: make(map[string]*liveChat),
+		errors:    make([]*ApiError, 0),
+		requests:  make(map[string]int),
+	}
+}
+
+// NewUpcomingVideo builds video which is scheduled to start live, AddVideo with NewLiveVideo makes it live
+func NewUpcomingVideo(videoId string, channelId string, title string, scheduledStartTime time.Time) *youtube.Video {
+	return &youtube.Video{
+		Kind: "youtube#video",
+		Id:   videoId,
+		Snippet: &youtube.VideoSnippet{
+			ChannelId:            channelId,
+			ChannelTitle:         channelId,
+			Title:                title,
+			PublishedAt:          time.Now().UTC().Format(time.RFC3339),
+			LiveBroadcastContent: "upcoming",
+		},
+		ContentDetails: &youtube.VideoContentDetails{
+			Duration: "P0D",
+		},
+		LiveStreamingDetails: &youtube.VideoLiveStreamingDetails{
+			ScheduledStartTime: scheduledStartTime.UTC().Format(time.RFC3339),
+		},
+		Status: &youtube.VideoStatus{
+			PrivacyStatus: "public",
+		},
+	}
+}
+
+// NewLiveVideo builds video which has active live chat
+func NewLiveVideo(videoId string, channelId string, title string, activeLiveChatId string) *youtube.Video {
+	return &youtube.Video{
+		Kind: "youtube#video",
+		Id:   videoId,
+		Snippet: &youtube.VideoSnippet{
+			ChannelId:            channelId,
+			ChannelTitle:         channelId,
+			Title:                title,
+			PublishedAt:          time.Now().UTC().Format(time.RFC3339),
+			LiveBroadcastContent: "live",
+		},
+		ContentDetails: &youtube.VideoContentDetails{
+			Duration: "P0D",
+		},
+		LiveStreamingDetails: &youtube.VideoLiveStreamingDetails{
+			ActiveLiveChatId:  activeLiveChatId,
+			ActualStartTime:   time.Now().UTC().Format(time.RFC3339),
+			ConcurrentViewers: 1,
+		},
+		Status: &youtube.VideoStatus{
+			PrivacyStatus: "public",
+		},
+	}
+}
+
+func newLiveChatMessage(messageId string, liveChatId string, messageType string, authorChannelId string, authorDisplayName string, displayMessage string) *youtube.LiveChatMessage {
+	return &youtube.LiveChatMessage{
+		Kind: "youtube#liveChatMessage",
+		Etag: "etag-" + messageId,
+		Id:   messageId,
+		Snippet: &youtube.LiveChatMessageSnippet{
+			Type:              messageType,
+			LiveChatId:        liveChatId,
+			AuthorChannelId:   authorChannelId,
+			PublishedAt:       time.Now().UTC().Format(time.RFC3339Nano),
+			HasDisplayContent: true,
+			DisplayMessage:    displayMessage,
+		},
+		AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
+			ChannelId:   authorChannelId,
+			ChannelUrl:  "http://www.youtube.com/channel/" + authorChannelId,
+			DisplayName: authorDisplayName,
+		},
+	}
+}
+
+// NewTextMessage builds textMessageEvent
+func NewTextMessage(messageId string, liveChatId string, authorChannelId string, authorDisplayName string, text string) *youtube.LiveChatMessage {
+	message := newLiveChatMessage(messageId, liveChatId, "textMessageEvent", authorChannelId, authorDisplayName, text)
+	message.Snippet.TextMessageDetails = &youtube.LiveChatTextMessageDetails{
+		MessageText: text,
+	}
+	return message
+}
+
+// NewSuperChatMessage builds superChatEvent
+func NewSuperChatMessage(messageId string, liveChatId string, authorChannelId string, authorDisplayName string, comment string, amountMicros uint64, currency string, amountDisplayString string) *youtube.LiveChatMessage {
+	message := newLiveChatMessage(messageId, liveChatId, "superChatEvent", authorChannelId, authorDisplayName, comment)
+	message.Snippet.SuperChatDetails = &youtube.LiveChatSuperChatDetails{
+		AmountMicros:        amountMicros,
+		Currency:            currency,
+		AmountDisplayString: amountDisplayString,
+		UserComment:         comment,
+		Tier:                1,
+	}
+	return message
+}
+
+// NewChatEndedMessage builds chatEndedEvent
+func NewChatEndedMessage(messageId string, liveChatId string) *youtube.LiveChatMessage {
+	message := newLiveChatMessage(messageId, liveChatId, "chatEndedEvent", "", "", "")
+	message.Snippet.HasDisplayContent = false
+	return message
+}
@@ -0,0 +1,285 @@
+package youtubehelper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"google.golang.org/api/youtube/v3"
+	"strconv"
+	"time"
+)
+
+const (
+	youtubeLiveChatPath            string = "/live_chat?continuation="
+	youtubeLiveChatActiveApiPath   string = "/youtubei/v1/live_chat/get_live_chat?key="
+	defaultInnertubeTimeoutMs      int64  = 5000
+	innertubeWatchPageFetchTimeout        = 30 * time.Second
+)
+
+type LiveChatTextMessageRenderer struct {
+	AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
+	AuthorExternalChannelID string               `json:"authorExternalChannelId"`
+	AuthorName              LiveChatText         `json:"authorName"`
+	ID                      string               `json:"id"`
+	Message                 LiveChatText         `json:"message"`
+	TimestampUsec           string               `json:"timestampUsec"`
+}
+
+type LiveChatPaidMessageRenderer struct {
+	AuthorBadges            LiveChatAuthorBadges `json:"authorBadges"`
+	AuthorExternalChannelID string               `json:"authorExternalChannelId"`
+	AuthorName              LiveChatText         `json:"authorName"`
+	ID                      string               `json:"id"`
+	Message                 LiveChatText         `json:"message"`
+	PurchaseAmountText      LiveChatText         `json:"purchaseAmountText"`
+	TimestampUsec           string               `json:"timestampUsec"`
+}
+
+type LiveChatItem struct {
+	LiveChatTextMessageRenderer                            *LiveChatTextMessageRenderer                            `json:"liveChatTextMessageRenderer"`
+	LiveChatPaidMessageRenderer                            *LiveChatPaidMessageRenderer                            `json:"liveChatPaidMessageRenderer"`
+	LiveChatPaidStickerRenderer                            *LiveChatPaidStickerRenderer                            `json:"liveChatPaidStickerRenderer"`
+	LiveChatMembershipItemRenderer                         *LiveChatMembershipItemRenderer                         `json:"liveChatMembershipItemRenderer"`
+	LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer   *LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer   `json:"liveChatSponsorshipsGiftPurchaseAnnouncementRenderer"`
+	LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer *LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer `json:"liveChatSponsorshipsGiftRedemptionAnnouncementRenderer"`
+}
+
+type LiveChatContinuationData struct {
+	Continuation string `json:"continuation"`
+	TimeoutMs    int64  `json:"timeoutMs"`
+}
+
+// GetActiveLiveChatResponse is response of innertube get_live_chat
+type GetActiveLiveChatResponse struct {
+	ContinuationContents struct {
+		LiveChatContinuation struct {
+			Actions []struct {
+				AddChatItemAction struct {
+					Item LiveChatItem `json:"item"`
+				} `json:"addChatItemAction"`
+				MarkChatItemAsDeletedAction struct {
+					TargetItemID string `json:"targetItemId"`
+				} `json:"markChatItemAsDeletedAction"`
+			} `json:"actions"`
+			Continuations []struct {
+				InvalidationContinuationData LiveChatContinuationData `json:"invalidationContinuationData"`
+				TimedContinuationData        LiveChatContinuationData `json:"timedContinuationData"`
+				ReloadContinuationData       LiveChatContinuationData `json:"reloadContinuationData"`
+			} `json:"continuations"`
+		} `json:"liveChatContinuation"`
+	} `json:"continuationContents"`
+}
+
+// InnertubeLiveChatCollector is ActiveLiveChatSource of innertube get_live_chat, it does not consume quota of api key
+type InnertubeLiveChatCollector struct {
+	verbose                  bool
+	archiveLiveChatCollector *ArchiveLiveChatCollector
+}
+
+func (i *InnertubeLiveChatCollector) CreateActiveLiveChatParams(video *youtube.Video, pageToken string) (*ActiveLiveChatParams, error) {
+	if video.LiveStreamingDetails == nil || video.LiveStreamingDetails.ActiveLiveChatId == "" {
+		return nil, fmt.Errorf("not active live chat (videoId = %v)", video.Id)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), innertubeWatchPageFetchTimeout)
+	defer cancel()
+	innertubeParams, err := i.archiveLiveChatCollector.GetParams(ctx, video.Id)
+	if err != nil {
+		return nil, fmt.Errorf("can not get params of innertube live chat (videoId = %v): %w", video.Id, err)
+	}
+	if pageToken == "" {
+		pageToken = innertubeParams.GetContinuation()
+	}
+	return &ActiveLiveChatParams{
+		videoId:          video.Id,
+		activeLiveChatId: video.LiveStreamingDetails.ActiveLiveChatId,
+		pageToken:        pageToken,
+		innertubeParams:  innertubeParams,
+	}, nil
+}
+
+func (i *InnertubeLiveChatCollector) GetActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, max int64) (*youtube.LiveChatMessageListResponse, error) {
+	if params.innertubeParams == nil {
+		return nil, fmt.Errorf("no innertube params (videoId = %v)", params.videoId)
+	}
+	params.innertubeParams["continuation"] = params.pageToken
+	reqBody, err := i.archiveLiveChatCollector.buildRequestBody(params.innertubeParams, youtubeLiveChatPath)
+	if err != nil {
+		return nil, fmt.Errorf("can not build request body: %w", err)
+	}
+	ytUrl := i.archiveLiveChatCollector.baseUrl + youtubeLiveChatActiveApiPath + params.innertubeParams["innertubeApiKey"]
+	header := make(map[string]string)
+	header["User-Agent"] = userAgent
+	header["Content-Type"] = "application/json"
+	respBody, err := i.archiveLiveChatCollector.httpRequest(ctx, ytUrl, "POST", header, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("can not get innertube live chat (videoId = %v): %w", params.videoId, err)
+	}
+	resp, err := i.ParseActiveLiveChat(respBody)
+	if err != nil {
+		return nil, err
+	}
+	return i.ConvertActiveLiveChat(params.activeLiveChatId, resp), nil
+}
+
+func (i *InnertubeLiveChatCollector) NextActiveLiveChat(ctx context.Context, params *ActiveLiveChatParams, liveChatMessageListResponse *youtube.LiveChatMessageListResponse) bool {
+	if liveChatMessageListResponse.NextPageToken == "" {
+		return false
+	}
+	params.pageToken = liveChatMessageListResponse.NextPageToken
+	timer := time.NewTimer(time.Duration(liveChatMessageListResponse.PollingIntervalMillis) * time.Millisecond)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// ParseActiveLiveChat converts response body of get_live_chat
+func (i *InnertubeLiveChatCollector) ParseActiveLiveChat(respBody []byte) (*GetActiveLiveChatResponse, error) {
+	resp := &GetActiveLiveChatResponse{}
+	if err := json.Unmarshal(respBody, resp); err != nil {
+		return nil, fmt.Errorf("can not convert json to struct: %w", err)
+	}
+	return resp, nil
+}
+
+func (i *InnertubeLiveChatCollector) formatTimestampUsec(timestampUsec string) string {
+	usec, err := strconv.ParseInt(timestampUsec, 10, 64)
+	if err != nil {
+		return ""
+	}
+	return time.Unix(0, usec*int64(time.Microsecond)).UTC().Format(time.RFC3339Nano)
+}
+
+func (i *InnertubeLiveChatCollector) newLiveChatMessage(liveChatId string, id string, messageType string, authorChannelId string, authorName string, badges LiveChatAuthorBadges, timestampUsec string, displayMessage string) *youtube.LiveChatMessage {
+	role := badges.Role()
+	return &youtube.LiveChatMessage{
+		Kind: "youtube#liveChatMessage",
+		Id:   id,
+		Snippet: &youtube.LiveChatMessageSnippet{
+			Type:              messageType,
+			LiveChatId:        liveChatId,
+			AuthorChannelId:   authorChannelId,
+			PublishedAt:       i.formatTimestampUsec(timestampUsec),
+			HasDisplayContent: displayMessage != "",
+			DisplayMessage:    displayMessage,
+		},
+		AuthorDetails: &youtube.LiveChatMessageAuthorDetails{
+			ChannelId:       authorChannelId,
+			ChannelUrl:      "http://www.youtube.com/channel/" + authorChannelId,
+			DisplayName:     authorName,
+			IsChatOwner:     role.IsChatOwner,
+			IsChatModerator: role.IsChatModerator,
+			IsChatSponsor:   role.IsChatSponsor,
+			IsVerified:      role.IsVerified,
+		},
+	}
+}
+
+// ConvertActiveLiveChat maps innertube live chat into response of liveChatMessages.list
+func (i *InnertubeLiveChatCollector) ConvertActiveLiveChat(liveChatId string, resp *GetActiveLiveChatResponse) *youtube.LiveChatMessageListResponse {
+	items := make([]*youtube.LiveChatMessage, 0, len(resp.ContinuationContents.LiveChatContinuation.Actions))
+	for _, action := range resp.ContinuationContents.LiveChatContinuation.Actions {
+		if targetItemId := action.MarkChatItemAsDeletedAction.TargetItemID; targetItemId != "" {
+			item := i.newLiveChatMessage(liveChatId, "deleted."+targetItemId, "messageDeletedEvent", "", "", nil, "", "")
+			item.AuthorDetails = nil
+			item.Snippet.MessageDeletedDetails = &youtube.LiveChatMessageDeletedDetails{
+				DeletedMessageId: targetItemId,
+			}
+			items = append(items, item)
+			continue
+		}
+		liveChatItem := action.AddChatItemAction.Item
+		switch {
+		case liveChatItem.LiveChatTextMessageRenderer != nil:
+			r := liveChatItem.LiveChatTextMessageRenderer
+			item := i.newLiveChatMessage(liveChatId, r.ID, "textMessageEvent", r.AuthorExternalChannelID, r.AuthorName.String(), r.AuthorBadges, r.TimestampUsec, r.Message.String())
+			item.Snippet.TextMessageDetails = &youtube.LiveChatTextMessageDetails{
+				MessageText: r.Message.String(),
+			}
+			items = append(items, item)
+		case liveChatItem.LiveChatPaidMessageRenderer != nil:
+			r := liveChatItem.LiveChatPaidMessageRenderer
+			item := i.newLiveChatMessage(liveChatId, r.ID, "superChatEvent", r.AuthorExternalChannelID, r.AuthorName.String(), r.AuthorBadges, r.TimestampUsec, r.Message.String())
+			item.Snippet.SuperChatDetails = &youtube.LiveChatSuperChatDetails{
+				AmountDisplayString: r.PurchaseAmountText.String(),
+				UserComment:         r.Message.String(),
+			}
+			items = append(items, item)
+		case liveChatItem.LiveChatPaidStickerRenderer != nil:
+			r := liveChatItem.LiveChatPaidStickerRenderer
+			altText := r.Sticker.Accessibility.AccessibilityData.Label
+			item := i.newLiveChatMessage(liveChatId, r.ID, "superStickerEvent", r.AuthorExternalChannelID, r.AuthorName.String(), r.AuthorBadges, r.TimestampUsec, altText)
+			item.Snippet.SuperStickerDetails = &youtube.LiveChatSuperStickerDetails{
+				AmountDisplayString: r.PurchaseAmountText.String(),
+				SuperStickerMetadata: &youtube.SuperStickerMetadata{
+					AltText: altText,
+				},
+			}
+			items = append(items, item)
+		case liveChatItem.LiveChatMembershipItemRenderer != nil:
+			r := liveChatItem.LiveChatMembershipItemRenderer
+			if message := r.Message.String(); message != "" {
+				item := i.newLiveChatMessage(liveChatId, r.ID, "memberMilestoneChatEvent", r.AuthorExternalChannelID, r.AuthorName.String(), r.AuthorBadges, r.TimestampUsec, message)
+				item.Snippet.MemberMilestoneChatDetails = &youtube.LiveChatMemberMilestoneChatDetails{
+					UserComment: message,
+				}
+				item.AuthorDetails.IsChatSponsor = true
+				items = append(items, item)
+			} else {
+				item := i.newLiveChatMessage(liveChatId, r.ID, "newSponsorEvent", r.AuthorExternalChannelID, r.AuthorName.String(), r.AuthorBadges, r.TimestampUsec, r.HeaderText())
+				item.Snippet.NewSponsorDetails = &youtube.LiveChatNewSponsorDetails{}
+				item.AuthorDetails.IsChatSponsor = true
+				items = append(items, item)
+			}
+		case liveChatItem.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer != nil:
+			r := liveChatItem.LiveChatSponsorshipsGiftPurchaseAnnouncementRenderer
+			header := r.Header.LiveChatSponsorshipsHeaderRenderer
+			item := i.newLiveChatMessage(liveChatId, r.ID, "membershipGiftingEvent", r.AuthorExternalChannelID, header.AuthorName.String(), header.AuthorBadges, r.TimestampUsec, header.PrimaryText.String())
+			item.Snippet.MembershipGiftingDetails = &youtube.LiveChatMembershipGiftingDetails{
+				GiftMembershipsCount: r.GiftMembershipsCount(),
+			}
+			items = append(items, item)
+		case liveChatItem.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer != nil:
+			r := liveChatItem.LiveChatSponsorshipsGiftRedemptionAnnouncementRenderer
+			item := i.newLiveChatMessage(liveChatId, r.ID, "giftMembershipReceivedEvent", r.AuthorExternalChannelID, r.AuthorName.String(), r.AuthorBadges, r.TimestampUsec, r.Message.String())
+			item.Snippet.GiftMembershipReceivedDetails = &youtube.LiveChatGiftMembershipReceivedDetails{}
+			items = append(items, item)
+		}
+	}
+	nextPageToken := ""
+	pollingIntervalMillis := defaultInnertubeTimeoutMs
+	for _, c := range resp.ContinuationContents.LiveChatContinuation.Continuations {
+		for _, data := range []LiveChatContinuationData{c.InvalidationContinuationData, c.TimedContinuationData, c.ReloadContinuationData} {
+			if data.Continuation == "" {
+				continue
+			}
+			nextPageToken = data.Continuation
+			if data.TimeoutMs > 0 {
+				pollingIntervalMillis = data.TimeoutMs
+			}
+		}
+	}
+	return &youtube.LiveChatMessageListResponse{
+		Kind:                  "youtube#liveChatMessageListResponse",
+		Items:                 items,
+		NextPageToken:         nextPageToken,
+		PollingIntervalMillis: pollingIntervalMillis,
+	}
+}
+
+func NewInnertubeLiveChatCollector(opts ...Option) *InnertubeLiveChatCollector {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	return &InnertubeLiveChatCollector{
+		verbose:                  baseOpts.verbose,
+		archiveLiveChatCollector: NewArchiveLiveChatCollector(opts...),
+	}
+}
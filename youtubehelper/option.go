@@ -1,12 +1,25 @@
 package youtubehelper
 
+import (
+	"net/http"
+	"strings"
+)
+
 type options struct {
-	verbose bool
+	verbose             bool
+	quotaUnitsPerApiKey int64
+	transport           http.RoundTripper
+	baseUrl             string
+	apiEndpoint         string
 }
 
 func defaultOptions() *options {
 	return &options{
-		verbose: false,
+		verbose:             false,
+		quotaUnitsPerApiKey: DefaultQuotaUnitsPerApiKey,
+		transport:           nil,
+		baseUrl:             DefaultBaseUrl,
+		apiEndpoint:         "",
 	}
 }
 
@@ -17,3 +30,39 @@ func Verbose(verbose bool) Option {
 		opts.verbose = verbose
 	}
 }
+
+func QuotaUnitsPerApiKey(quotaUnitsPerApiKey int64) Option {
+	return func(opts *options) {
+		if quotaUnitsPerApiKey <= 0 {
+			return
+		}
+		opts.quotaUnitsPerApiKey = quotaUnitsPerApiKey
+	}
+}
+
+func Transport(transport http.RoundTripper) Option {
+	return func(opts *options) {
+		opts.transport = transport
+	}
+}
+
+func BaseUrl(baseUrl string) Option {
+	return func(opts *options) {
+		if baseUrl == "" {
+			return
+		}
+		opts.baseUrl = strings.TrimRight(baseUrl, "/")
+	}
+}
+
+func ApiEndpoint(apiEndpoint string) Option {
+	return func(opts *options) {
+		if apiEndpoint == "" {
+			return
+		}
+		if !strings.HasSuffix(apiEndpoint, "/") {
+			apiEndpoint += "/"
+		}
+		opts.apiEndpoint = apiEndpoint
+	}
+}
@@ -0,0 +1,181 @@
+package youtubehelper
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// HTTPFixture is recorded http exchange
+type HTTPFixture struct {
+	Method      string      `json:"method"`
+	Url         string      `json:"url"`
+	RequestBody string      `json:"requestBody"`
+	StatusCode  int         `json:"statusCode"`
+	Header      http.Header `json:"header"`
+	Body        string      `json:"body"`
+}
+
+func httpFixtureKey(method string, url string, requestBody []byte) string {
+	h := sha1.New()
+	h.Write([]byte(method))
+	h.Write([]byte(" "))
+	h.Write([]byte(url))
+	h.Write([]byte("\n"))
+	h.Write(requestBody)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil {
+		return nil, nil
+	}
+	requestBody, err := ioutil.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can not read request body (url = %v): %w", req.URL.String(), err)
+	}
+	req.Body.Close()
+	req.Body = ioutil.NopCloser(bytes.NewReader(requestBody))
+	return requestBody, nil
+}
+
+func buildResponse(req *http.Request, fixture *HTTPFixture) *http.Response {
+	header := fixture.Header
+	if header == nil {
+		header = make(http.Header)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", fixture.StatusCode, http.StatusText(fixture.StatusCode)),
+		StatusCode:    fixture.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(bytes.NewReader([]byte(fixture.Body))),
+		ContentLength: int64(len(fixture.Body)),
+		Request:       req,
+	}
+}
+
+// RecordTransport passes requests to underlying transport and saves responses to fixture directory
+type RecordTransport struct {
+	verbose    bool
+	fixtureDir string
+	transport  http.RoundTripper
+	mutex      *sync.Mutex
+}
+
+func (r *RecordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("can not read response body (url = %v): %w", req.URL.String(), err)
+	}
+	fixture := &HTTPFixture{
+		Method:      req.Method,
+		Url:         req.URL.String(),
+		RequestBody: string(requestBody),
+		StatusCode:  resp.StatusCode,
+		Header:      resp.Header,
+		Body:        string(respBody),
+	}
+	fixtureBytes, err := json.MarshalIndent(fixture, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("can not convert fixture to json (url = %v): %w", req.URL.String(), err)
+	}
+	fixturePath := filepath.Join(r.fixtureDir, httpFixtureKey(req.Method, req.URL.String(), requestBody)+".json")
+	r.mutex.Lock()
+	err = ioutil.WriteFile(fixturePath, fixtureBytes, 0644)
+	r.mutex.Unlock()
+	if err != nil {
+		return nil, fmt.Errorf("can not write fixture (path = %v): %w", fixturePath, err)
+	}
+	if r.verbose {
+		log.Printf("record http fixture (method = %v, url = %v, path = %v)", req.Method, req.URL.String(), fixturePath)
+	}
+	return buildResponse(req, fixture), nil
+}
+
+func NewRecordTransport(fixtureDir string, transport http.RoundTripper, opts ...Option) (*RecordTransport, error) {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	if err := os.MkdirAll(fixtureDir, 0755); err != nil {
+		return nil, fmt.Errorf("can not create fixture directory (path = %v): %w", fixtureDir, err)
+	}
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &RecordTransport{
+		verbose:    baseOpts.verbose,
+		fixtureDir: fixtureDir,
+		transport:  transport,
+		mutex:      new(sync.Mutex),
+	}, nil
+}
+
+// ReplayTransport returns responses from fixture directory without network
+type ReplayTransport struct {
+	verbose    bool
+	fixtureDir string
+}
+
+func (r *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	requestBody, err := readRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	fixturePath := filepath.Join(r.fixtureDir, httpFixtureKey(req.Method, req.URL.String(), requestBody)+".json")
+	fixtureBytes, err := ioutil.ReadFile(fixturePath)
+	if err != nil {
+		return nil, fmt.Errorf("not found fixture (method = %v, url = %v, path = %v): %w", req.Method, req.URL.String(), fixturePath, err)
+	}
+	fixture := &HTTPFixture{}
+	if err := json.Unmarshal(fixtureBytes, fixture); err != nil {
+		return nil, fmt.Errorf("can not convert json to fixture (path = %v): %w", fixturePath, err)
+	}
+	if r.verbose {
+		log.Printf("replay http fixture (method = %v, url = %v, path = %v)", req.Method, req.URL.String(), fixturePath)
+	}
+	return buildResponse(req, fixture), nil
+}
+
+func NewReplayTransport(fixtureDir string, opts ...Option) (*ReplayTransport, error) {
+	baseOpts := defaultOptions()
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		opt(baseOpts)
+	}
+	info, err := os.Stat(fixtureDir)
+	if err != nil {
+		return nil, fmt.Errorf("can not stat fixture directory (path = %v): %w", fixtureDir, err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("fixture path is not directory (path = %v)", fixtureDir)
+	}
+	return &ReplayTransport{
+		verbose:    baseOpts.verbose,
+		fixtureDir: fixtureDir,
+	}, nil
+}